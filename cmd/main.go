@@ -7,17 +7,38 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// Employee time zones must resolve on hosts without a zoneinfo database
+	_ "time/tzdata"
 
+	"github.com/WangWilly/labs-hr-go/controllers/analytics"
 	"github.com/WangWilly/labs-hr-go/controllers/attendance"
+	"github.com/WangWilly/labs-hr-go/controllers/attendancecorrection"
+	"github.com/WangWilly/labs-hr-go/controllers/department"
 	"github.com/WangWilly/labs-hr-go/controllers/employee"
+	"github.com/WangWilly/labs-hr-go/controllers/holiday"
+	"github.com/WangWilly/labs-hr-go/controllers/leave"
+	"github.com/WangWilly/labs-hr-go/controllers/orgchart"
+	"github.com/WangWilly/labs-hr-go/controllers/payroll"
+	"github.com/WangWilly/labs-hr-go/controllers/salaryband"
+	"github.com/WangWilly/labs-hr-go/controllers/shift"
 	"github.com/WangWilly/labs-hr-go/database/migrations"
 	"github.com/WangWilly/labs-hr-go/pkgs/cachemanager"
 	"github.com/WangWilly/labs-hr-go/pkgs/middleware"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/attendancecorrectionrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/departmentrepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeattendancerepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeepositionrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/holidayrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/leaverepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/payrollrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/reportinglinerepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/salarybandrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/shiftrepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/seed"
+	"github.com/WangWilly/labs-hr-go/pkgs/taskmanager"
 	"github.com/WangWilly/labs-hr-go/pkgs/timemodule"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 
 	"github.com/sethvargo/go-envconfig"
@@ -31,12 +52,24 @@ type envConfig struct {
 	Host string `env:"HOST,default=0.0.0.0"`
 
 	// Database configuration
-	DbCfg     utils.DbConfig `env:",prefix="`
-	DbMigrate bool           `env:"DB_MIGRATE,default=true"`
-	DbSeed    bool           `env:"DB_SEED,default=false"`
+	DbCfg        utils.DbConfig    `env:",prefix="`
+	MigrationCfg migrations.Config `env:",prefix="`
+	DbMigrate    bool              `env:"DB_MIGRATE,default=true"`
+	DbSeed       bool              `env:"DB_SEED,default=false"`
 
 	// Redis configuration
 	RedisCfg utils.RedisConfig `env:",prefix="`
+
+	// Task pool configuration
+	TaskPoolCfg taskmanager.Config `env:",prefix="`
+
+	// Controller configuration
+	EmployeeCtrlCfg   employee.Config   `env:",prefix="`
+	AttendanceCtrlCfg attendance.Config `env:",prefix="`
+	HolidayCtrlCfg    holiday.Config    `env:",prefix="`
+	PayrollCtrlCfg    payroll.Config    `env:",prefix="`
+	SalaryBandCtrlCfg salaryband.Config `env:",prefix="`
+	AnalyticsCtrlCfg  analytics.Config  `env:",prefix="`
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -56,6 +89,12 @@ func main() {
 	if err != nil {
 		logger.Fatal().Err(err).Msg("Failed to load environment variables")
 	}
+	if err := cfg.EmployeeCtrlCfg.Validate(); err != nil {
+		logger.Fatal().Err(err).Msg("Invalid employee configuration")
+	}
+	if err := cfg.AttendanceCtrlCfg.Validate(); err != nil {
+		logger.Fatal().Err(err).Msg("Invalid attendance configuration")
+	}
 
 	////////////////////////////////////////////////////////////////////////////
 	// Initialize Gin router
@@ -76,7 +115,7 @@ func main() {
 	}
 
 	if cfg.DbMigrate {
-		if err := migrations.Apply(db); err != nil {
+		if err := migrations.Apply(db, cfg.MigrationCfg); err != nil {
 			logger.Fatal().Err(err).Msg("Failed to apply database migrations")
 		}
 		logger.Info().Msg("Database migrations applied successfully")
@@ -104,36 +143,171 @@ func main() {
 	// Initialize modules
 
 	timeModule := timemodule.New()
+	txManager := txmanager.New(db)
 	employeeInfoRepo := employeeinforepo.New()
 	employeePositionRepo := employeepositionrepo.New()
 	employeeAttendanceRepo := employeeattendancerepo.New()
+	departmentRepo := departmentrepo.New()
+	attendanceCorrectionRepo := attendancecorrectionrepo.New()
+	shiftRepo := shiftrepo.New()
+	leaveRepo := leaverepo.New()
+	holidayRepo := holidayrepo.New()
+	payrollRepo := payrollrepo.New()
+	salaryBandRepo := salarybandrepo.New()
+	reportingLineRepo := reportinglinerepo.New()
 	cacheManager := cachemanager.New(redisClient)
 
+	taskPool := taskmanager.NewTaskPool(cfg.TaskPoolCfg)
+	taskPool.Run()
+
 	////////////////////////////////////////////////////////////////////////////
 	// Initialize the controllers
 
-	employeeCtrlCfg := employee.Config{}
 	employeeCtrl := employee.NewController(
-		employeeCtrlCfg,
+		cfg.EmployeeCtrlCfg,
 		db,
+		txManager,
 		timeModule,
 		employeeInfoRepo,
 		employeePositionRepo,
+		employeeAttendanceRepo,
+		departmentRepo,
+		salaryBandRepo,
 		cacheManager,
+		taskPool,
 	)
 	employeeCtrl.RegisterRoutes(r)
 
-	attendanceCtrlCfg := attendance.Config{}
+	// Resume the activations of future-dated positions
+	if err := employeeCtrl.SchedulePendingActivations(ctx); err != nil {
+		logger.Error().Err(err).Msg("Failed to schedule pending position activations")
+	}
+
 	attendanceCtrl := attendance.NewController(
-		attendanceCtrlCfg,
+		cfg.AttendanceCtrlCfg,
 		db,
+		txManager,
 		timeModule,
+		employeeInfoRepo,
 		employeePositionRepo,
 		employeeAttendanceRepo,
 		cacheManager,
+		taskPool,
 	)
 	attendanceCtrl.RegisterRoutes(r)
 
+	// Close the sessions employees forgot to clock out of
+	attendanceCtrl.ScheduleSweep()
+
+	departmentCtrlCfg := department.Config{}
+	departmentCtrl := department.NewController(
+		departmentCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		departmentRepo,
+		employeeInfoRepo,
+		employeePositionRepo,
+		employeeAttendanceRepo,
+		cacheManager,
+	)
+	departmentCtrl.RegisterRoutes(r)
+
+	attendanceCorrectionCtrlCfg := attendancecorrection.Config{}
+	attendanceCorrectionCtrl := attendancecorrection.NewController(
+		attendanceCorrectionCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		employeeInfoRepo,
+		employeePositionRepo,
+		employeeAttendanceRepo,
+		attendanceCorrectionRepo,
+		departmentRepo,
+		cacheManager,
+	)
+	attendanceCorrectionCtrl.RegisterRoutes(r)
+
+	shiftCtrlCfg := shift.Config{}
+	shiftCtrl := shift.NewController(
+		shiftCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		shiftRepo,
+		departmentRepo,
+		employeeInfoRepo,
+		employeePositionRepo,
+		employeeAttendanceRepo,
+		leaveRepo,
+		holidayRepo,
+	)
+	shiftCtrl.RegisterRoutes(r)
+
+	leaveCtrlCfg := leave.Config{}
+	leaveCtrl := leave.NewController(
+		leaveCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		leaveRepo,
+		employeePositionRepo,
+		departmentRepo,
+	)
+	leaveCtrl.RegisterRoutes(r)
+
+	holidayCtrl := holiday.NewController(
+		cfg.HolidayCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		holidayRepo,
+		employeeInfoRepo,
+		employeePositionRepo,
+		departmentRepo,
+	)
+	holidayCtrl.RegisterRoutes(r)
+
+	payrollCtrl := payroll.NewController(
+		cfg.PayrollCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		payrollRepo,
+		employeeInfoRepo,
+		employeePositionRepo,
+	)
+	payrollCtrl.RegisterRoutes(r)
+
+	salaryBandCtrl := salaryband.NewController(
+		cfg.SalaryBandCtrlCfg,
+		db,
+		txManager,
+		salaryBandRepo,
+	)
+	salaryBandCtrl.RegisterRoutes(r)
+
+	orgChartCtrlCfg := orgchart.Config{}
+	orgChartCtrl := orgchart.NewController(
+		orgChartCtrlCfg,
+		db,
+		txManager,
+		timeModule,
+		reportingLineRepo,
+		employeeInfoRepo,
+		employeePositionRepo,
+	)
+	orgChartCtrl.RegisterRoutes(r)
+
+	analyticsCtrl := analytics.NewController(
+		cfg.AnalyticsCtrlCfg,
+		db,
+		employeeInfoRepo,
+		employeePositionRepo,
+		cacheManager,
+	)
+	analyticsCtrl.RegisterRoutes(r)
+
 	////////////////////////////////////////////////////////////////////////////
 
 	// Set up the server
@@ -165,7 +339,17 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	// Shutdown the server gracefully
+	// Stop accepting requests first, they may still submit background tasks
+	logger.Info().Msg("Shutting down server...")
+	if err := srv.Shutdown(ctx); err != nil {
+		// Handle shutdown error
+		logger.Fatal().Err(err).Msg("Failed to shutdown server")
+	}
+
+	// Stop the background tasks before closing the clients they use
+	taskPool.ShutdownNow()
+	logger.Info().Msg("Task pool stopped successfully!")
+
 	if err := redisClient.Close(); err != nil {
 		logger.Fatal().Err(err).Msg("Failed to close Redis client")
 	}
@@ -177,13 +361,6 @@ func main() {
 	}
 	logger.Info().Msg("Database connection closed successfully!")
 
-	// Gracefully shutdown the server
-	logger.Info().Msg("Shutting down server...")
-	if err := srv.Shutdown(ctx); err != nil {
-		// Handle shutdown error
-		logger.Fatal().Err(err).Msg("Failed to shutdown server")
-	}
-
 	// Wait for tasks to finish or timeout
 	<-ctx.Done()
 	logger.Info().Msg("Server shutdown complete.")
@@ -0,0 +1,49 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+	// MaxMonths bounds the number of months of a report
+	MaxMonths int `env:"ANALYTICS_MAX_MONTHS,default=36"`
+	// CacheTTL is how long a report is served from the cache, the changes made
+	// in the meantime are seen once it expires
+	CacheTTL time.Duration `env:"ANALYTICS_CACHE_TTL,default=1h"`
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	employeeInfoRepo     EmployeeInfoRepo
+	employeePositionRepo EmployeePositionRepo
+	cacheManager         CacheManager
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+	cacheManager CacheManager,
+) *Controller {
+	return &Controller{
+		cfg:                  cfg,
+		db:                   db,
+		employeeInfoRepo:     employeeInfoRepo,
+		employeePositionRepo: employeePositionRepo,
+		cacheManager:         cacheManager,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// workforce
+	r.GET("/analytics/headcount", c.Headcount)
+}
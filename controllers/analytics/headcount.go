@@ -0,0 +1,106 @@
+package analytics
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/analytics"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type HeadcountRequest struct {
+	// From and To are the first and last months of the report, as YYYY-MM
+	From    string `form:"from"     binding:"required"`
+	To      string `form:"to"       binding:"required"`
+	GroupBy string `form:"group_by" binding:"omitempty,oneof=department"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Headcount returns the headcount, hires, leavers and attrition rate of every
+// month of the report. Reports are cached for CacheTTL.
+func (c *Controller) Headcount(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	var req HeadcountRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := c.parseMonths(req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	cached, err := c.cacheManager.GetHeadcountV1(ctx, req.From, req.To, req.GroupBy)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get headcount from cache")
+	}
+	if cached != nil {
+		logger.Info().Msg("Cache hit")
+		ctx.JSON(http.StatusOK, cached)
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	positions, err := c.employeePositionRepo.ListEffectiveBetween(ctx, c.db, analytics.WindowStart(from), to)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+
+	// Terminated employees count until they left
+	employeeIDs := lo.Uniq(lo.Map(positions, func(position *models.EmployeePosition, _ int) int64 {
+		return position.EmployeeID
+	}))
+	employeeInfos, err := c.employeeInfoRepo.ListByIDsWithTerminated(ctx, c.db, employeeIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employees"})
+		return
+	}
+
+	months := analytics.Headcount(from, to, employeeInfos, positions, req.GroupBy)
+	resp := analytics.NewHeadcountV1Response(from, to, req.GroupBy, months)
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if err := c.cacheManager.SetHeadcountV1(ctx, req.From, req.To, req.GroupBy, resp, c.cfg.CacheTTL); err != nil {
+		logger.Error().Err(err).Msg("Failed to set headcount to cache")
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// parseMonths returns the first day of the first month and the last day of
+// the last month of the report.
+func (c *Controller) parseMonths(req HeadcountRequest) (time.Time, time.Time, error) {
+	from, err := time.ParseInLocation(analytics.MonthLayout, req.From, time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid from")
+	}
+	to, err := time.ParseInLocation(analytics.MonthLayout, req.To, time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid to")
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("to must not be before from")
+	}
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+	if months > c.cfg.MaxMonths {
+		return time.Time{}, time.Time{}, fmt.Errorf("report spans more than %d months", c.cfg.MaxMonths)
+	}
+	return from, to.AddDate(0, 1, -1), nil
+}
@@ -0,0 +1,107 @@
+package analytics
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHeadcount(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee hired in February and another leaving in March", t, func() {
+			s.controller.cfg.MaxMonths = 36
+			s.controller.cfg.CacheTTL = time.Hour
+
+			windowStart := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+			positions := []*models.EmployeePosition{
+				{EmployeeID: 1, DepartmentID: 1, Department: "Engineering", StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+				{EmployeeID: 2, DepartmentID: 2, Department: "Sales", StartDate: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)},
+			}
+			employeeInfos := []*models.EmployeeInfo{
+				{ID: 1, TerminatedAt: lo.ToPtr(time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC))},
+				{ID: 2},
+			}
+
+			Convey("When the report is not cached", func(c C) {
+				s.cacheManager.EXPECT().
+					GetHeadcountV1(gomock.Any(), "2025-01", "2025-03", "department").
+					Return(nil, nil)
+				s.employeePositionRepo.EXPECT().
+					ListEffectiveBetween(gomock.Any(), gomock.Any(), windowStart, to).
+					Return(positions, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{1, 2}).
+					Return(employeeInfos, nil)
+				s.cacheManager.EXPECT().
+					SetHeadcountV1(gomock.Any(), "2025-01", "2025-03", "department", gomock.Any(), time.Hour).
+					DoAndReturn(func(_ any, _, _, _ string, data dtos.HeadcountV1Response, _ time.Duration) error {
+						c.So(data.Items, ShouldHaveLength, 3)
+						return nil
+					})
+
+				var resp dtos.HeadcountV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/analytics/headcount?from=2025-01&to=2025-03&group_by=department", nil, &resp, http.StatusOK)
+
+				Convey("Then every month should be reported", func() {
+					So(resp.From, ShouldEqual, "2025-01")
+					So(resp.To, ShouldEqual, "2025-03")
+					So(resp.GroupBy, ShouldEqual, "department")
+					So(resp.Items, ShouldHaveLength, 3)
+
+					So(resp.Items[0].Month, ShouldEqual, "2025-01")
+					So(resp.Items[0].Headcount, ShouldEqual, 1)
+
+					So(resp.Items[1].Hires, ShouldEqual, 1)
+					So(resp.Items[1].Headcount, ShouldEqual, 2)
+					So(resp.Items[1].Groups, ShouldResemble, []dtos.HeadcountGroupV1Response{
+						{DepartmentID: 1, Department: "Engineering", OpeningHeadcount: 1, Headcount: 1},
+						{DepartmentID: 2, Department: "Sales", Headcount: 1, Hires: 1},
+					})
+
+					So(resp.Items[2].Leavers, ShouldEqual, 1)
+					So(resp.Items[2].Headcount, ShouldEqual, 1)
+					So(resp.Items[2].AttritionRate, ShouldEqual, 66.67)
+				})
+			})
+
+			Convey("When the report is cached", func() {
+				cached := &dtos.HeadcountV1Response{From: "2025-01", To: "2025-03", Items: []dtos.HeadcountMonthV1Response{{Month: "2025-01", Headcount: 7}}}
+				s.cacheManager.EXPECT().
+					GetHeadcountV1(gomock.Any(), "2025-01", "2025-03", "").
+					Return(cached, nil)
+
+				var resp dtos.HeadcountV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/analytics/headcount?from=2025-01&to=2025-03", nil, &resp, http.StatusOK)
+
+				Convey("Then the cached report should be returned", func() {
+					So(resp, ShouldResemble, *cached)
+				})
+			})
+
+			Convey("When the months are not in order", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/analytics/headcount?from=2025-03&to=2025-01", nil, nil, http.StatusBadRequest)
+			})
+
+			Convey("When a month is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/analytics/headcount?from=2025-1&to=2025-03", nil, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the report is too long", func() {
+				s.controller.cfg.MaxMonths = 2
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/analytics/headcount?from=2025-01&to=2025-03", nil, nil, http.StatusBadRequest)
+			})
+
+			Convey("When grouping by an unknown field", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/analytics/headcount?from=2025-01&to=2025-03&group_by=position", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,24 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=analytics
+type EmployeeInfoRepo interface {
+	ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	ListEffectiveBetween(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.EmployeePosition, error)
+}
+
+type CacheManager interface {
+	GetHeadcountV1(ctx context.Context, from, to, groupBy string) (*dtos.HeadcountV1Response, error)
+	SetHeadcountV1(ctx context.Context, from, to, groupBy string, data dtos.HeadcountV1Response, expired time.Duration) error
+}
@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=analytics
+//
+
+// Package analytics is a generated GoMock package.
+package analytics
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dtos "github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByIDsWithTerminated mocks base method.
+func (m *MockEmployeeInfoRepo) ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDsWithTerminated", ctx, tx, ids)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDsWithTerminated indicates an expected call of ListByIDsWithTerminated.
+func (mr *MockEmployeeInfoRepoMockRecorder) ListByIDsWithTerminated(ctx, tx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDsWithTerminated", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).ListByIDsWithTerminated), ctx, tx, ids)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListEffectiveBetween mocks base method.
+func (m *MockEmployeePositionRepo) ListEffectiveBetween(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffectiveBetween", ctx, tx, from, to)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffectiveBetween indicates an expected call of ListEffectiveBetween.
+func (mr *MockEmployeePositionRepoMockRecorder) ListEffectiveBetween(ctx, tx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveBetween", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListEffectiveBetween), ctx, tx, from, to)
+}
+
+// MockCacheManager is a mock of CacheManager interface.
+type MockCacheManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCacheManagerMockRecorder is the mock recorder for MockCacheManager.
+type MockCacheManagerMockRecorder struct {
+	mock *MockCacheManager
+}
+
+// NewMockCacheManager creates a new mock instance.
+func NewMockCacheManager(ctrl *gomock.Controller) *MockCacheManager {
+	mock := &MockCacheManager{ctrl: ctrl}
+	mock.recorder = &MockCacheManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheManager) EXPECT() *MockCacheManagerMockRecorder {
+	return m.recorder
+}
+
+// GetHeadcountV1 mocks base method.
+func (m *MockCacheManager) GetHeadcountV1(ctx context.Context, from, to, groupBy string) (*dtos.HeadcountV1Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeadcountV1", ctx, from, to, groupBy)
+	ret0, _ := ret[0].(*dtos.HeadcountV1Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeadcountV1 indicates an expected call of GetHeadcountV1.
+func (mr *MockCacheManagerMockRecorder) GetHeadcountV1(ctx, from, to, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeadcountV1", reflect.TypeOf((*MockCacheManager)(nil).GetHeadcountV1), ctx, from, to, groupBy)
+}
+
+// SetHeadcountV1 mocks base method.
+func (m *MockCacheManager) SetHeadcountV1(ctx context.Context, from, to, groupBy string, data dtos.HeadcountV1Response, expired time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeadcountV1", ctx, from, to, groupBy, data, expired)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeadcountV1 indicates an expected call of SetHeadcountV1.
+func (mr *MockCacheManagerMockRecorder) SetHeadcountV1(ctx, from, to, groupBy, data, expired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeadcountV1", reflect.TypeOf((*MockCacheManager)(nil).SetHeadcountV1), ctx, from, to, groupBy, data, expired)
+}
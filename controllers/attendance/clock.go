@@ -0,0 +1,21 @@
+package attendance
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ClockIn opens a new attendance session, answering 409 when one is already
+// open.
+func (c *Controller) ClockIn(ctx *gin.Context) {
+	c.recordAttendance(ctx, clockIn, http.StatusCreated)
+}
+
+// ClockOut closes the open attendance session, answering 409 when there is
+// none.
+func (c *Controller) ClockOut(ctx *gin.Context) {
+	c.recordAttendance(ctx, clockOut, http.StatusOK)
+}
@@ -0,0 +1,170 @@
+package attendance
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClockInOut(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an active employee", t, func() {
+			employeeID := int64(123)
+			positionID := int64(456)
+			attendanceID := int64(789)
+			nowTime := time.Date(2023, 6, 15, 9, 0, 0, 0, time.UTC)
+			clockInTime := nowTime.Add(-8 * time.Hour)
+
+			employeeInfo := &models.EmployeeInfo{
+				ID:    employeeID,
+				Name:  "John Doe",
+				Email: "john.doe@example.com",
+			}
+			employeePosition := &models.EmployeePosition{
+				ID:         positionID,
+				EmployeeID: employeeID,
+				Position:   "Software Engineer",
+				Department: "Engineering",
+			}
+			openAttendance := &models.EmployeeAttendance{
+				ID:         attendanceID,
+				EmployeeID: employeeID,
+				PositionID: positionID,
+				ClockIn:    clockInTime,
+				Status:     models.AttendanceStatusOpen,
+			}
+			closedAttendance := &models.EmployeeAttendance{
+				ID:         attendanceID,
+				EmployeeID: employeeID,
+				PositionID: positionID,
+				ClockIn:    clockInTime,
+				ClockOut:   &nowTime,
+				Status:     models.AttendanceStatusClosed,
+			}
+
+			req := CreateRequest{
+				EmployeeID: employeeID,
+			}
+
+			// Every call looks up the employee, its position and its last session
+			expectLookups := func(last *models.EmployeeAttendance) {
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.cacheManager.EXPECT().
+					GetEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil, nil)
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
+					Return(employeePosition, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					Last(gomock.Any(), gomock.Any(), employeeID).
+					Return(last, nil)
+			}
+
+			Convey("When clocking in after the last session was closed", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime).Times(2)
+				expectLookups(closedAttendance)
+
+				s.employeeAttendanceRepo.EXPECT().
+					CreateForClockIn(gomock.Any(), gomock.Any(), employeeID, positionID, nowTime).
+					Return(&models.EmployeeAttendance{
+						ID:         attendanceID + 1,
+						EmployeeID: employeeID,
+						PositionID: positionID,
+						ClockIn:    nowTime,
+						Status:     models.AttendanceStatusOpen,
+					}, nil)
+				s.cacheManager.EXPECT().
+					SetAttendanceV1(gomock.Any(), employeeID, gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				var resp dtos.AttendanceV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/clock-in", req, &resp, http.StatusCreated)
+
+				Convey("Then a new session should be opened", func() {
+					So(resp.AttendanceID, ShouldEqual, attendanceID+1)
+					So(resp.ClockInTime, ShouldEqual, "2023-06-15 09:00:00")
+					So(resp.ClockOutTime, ShouldBeEmpty)
+					So(resp.Status, ShouldEqual, models.AttendanceStatusOpen)
+				})
+			})
+
+			Convey("When clocking in twice", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				expectLookups(openAttendance)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/clock-in", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the open session should be left untouched", func() {
+					So(errorResponse["error"], ShouldEqual, "already clocked in")
+				})
+			})
+
+			Convey("When clocking out of an open session", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime).Times(2)
+				expectLookups(openAttendance)
+
+				s.employeeAttendanceRepo.EXPECT().
+					UpdateForClockOut(gomock.Any(), gomock.Any(), attendanceID, nowTime).
+					Return(closedAttendance, nil)
+				s.cacheManager.EXPECT().
+					SetAttendanceV1(gomock.Any(), employeeID, gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				var resp dtos.AttendanceV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/clock-out", req, &resp, http.StatusOK)
+
+				Convey("Then the session should be closed", func() {
+					So(resp.AttendanceID, ShouldEqual, attendanceID)
+					So(resp.ClockOutTime, ShouldEqual, "2023-06-15 09:00:00")
+					So(resp.Status, ShouldEqual, models.AttendanceStatusClosed)
+				})
+			})
+
+			Convey("When clocking out with no open session", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				expectLookups(closedAttendance)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/clock-out", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "not clocked in")
+				})
+			})
+
+			Convey("When clocking out before ever clocking in", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				expectLookups(nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/clock-out", req, nil, http.StatusConflict)
+			})
+
+			Convey("When the employee ID is missing", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/clock-in", map[string]any{}, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -1,6 +1,9 @@
 package attendance
 
 import (
+	"errors"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
@@ -8,33 +11,58 @@ import (
 ////////////////////////////////////////////////////////////////////////////////
 
 type Config struct {
+	// Sessions open for longer than MaxShiftLength are auto-closed by the
+	// sweep running every SweepInterval
+	MaxShiftLength time.Duration `env:"ATTENDANCE_MAX_SHIFT_LENGTH,default=16h"`
+	SweepInterval  time.Duration `env:"ATTENDANCE_SWEEP_INTERVAL,default=15m"`
+}
+
+// Validate rejects the durations the sweep cannot work with: a zero interval
+// would sweep in a tight loop and a zero shift would close every session.
+func (cfg Config) Validate() error {
+	if cfg.MaxShiftLength <= 0 {
+		return errors.New("ATTENDANCE_MAX_SHIFT_LENGTH must be positive")
+	}
+	if cfg.SweepInterval <= 0 {
+		return errors.New("ATTENDANCE_SWEEP_INTERVAL must be positive")
+	}
+	return nil
 }
 
 type Controller struct {
 	cfg Config
 	db  *gorm.DB
 
+	txManager              TxManager
 	timeModule             TimeModule
+	employeeInfoRepo       EmployeeInfoRepo
 	employeePositionRepo   EmployeePositionRepo
 	employeeAttendanceRepo EmployeeAttendanceRepo
 	cacheManager           CacheManager
+	taskPool               TaskPool
 }
 
 func NewController(
 	cfg Config,
 	db *gorm.DB,
+	txManager TxManager,
 	timeModule TimeModule,
+	employeeInfoRepo EmployeeInfoRepo,
 	employeePositionRepo EmployeePositionRepo,
 	employeeAttendanceRepo EmployeeAttendanceRepo,
 	cacheManage CacheManager,
+	taskPool TaskPool,
 ) *Controller {
 	return &Controller{
 		cfg:                    cfg,
 		db:                     db,
+		txManager:              txManager,
 		timeModule:             timeModule,
+		employeeInfoRepo:       employeeInfoRepo,
 		employeePositionRepo:   employeePositionRepo,
 		employeeAttendanceRepo: employeeAttendanceRepo,
 		cacheManager:           cacheManage,
+		taskPool:               taskPool,
 	}
 }
 
@@ -42,5 +70,8 @@ func (c *Controller) RegisterRoutes(r *gin.Engine) {
 	////////////////////////////////////////////////////////////////////////////
 	// attendance management
 	r.POST("/attendance", c.Create)
+	r.POST("/attendance/clock-in", c.ClockIn)
+	r.POST("/attendance/clock-out", c.ClockOut)
 	r.GET("/attendance/:employee_id", c.Get)
+	r.GET("/attendance/:employee_id/history", c.History)
 }
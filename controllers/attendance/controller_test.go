@@ -2,11 +2,14 @@ package attendance
 
 import (
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/sethvargo/go-envconfig"
+	. "github.com/smartystreets/goconvey/convey"
 	gomock "go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 )
@@ -18,9 +21,11 @@ type testSuite struct {
 	mockDB sqlmock.Sqlmock
 
 	timeModule             *MockTimeModule
+	employeeInfoRepo       *MockEmployeeInfoRepo
 	employeePositionRepo   *MockEmployeePositionRepo
 	employeeAttendanceRepo *MockEmployeeAttendanceRepo
 	cacheManager           *MockCacheManager
+	taskPool               *MockTaskPool
 
 	controller *Controller
 	testServer testutils.TestHttpServer
@@ -34,9 +39,11 @@ func testInit(t *testing.T, test func(*testSuite)) {
 	gormDB, mockDB := testutils.GetMockDB(t)
 
 	timeModule := NewMockTimeModule(ctrl)
+	employeeInfoRepo := NewMockEmployeeInfoRepo(ctrl)
 	employeePositionRepo := NewMockEmployeePositionRepo(ctrl)
 	employeeAttendanceRepo := NewMockEmployeeAttendanceRepo(ctrl)
 	cacheManager := NewMockCacheManager(ctrl)
+	taskPool := NewMockTaskPool(ctrl)
 
 	cfg := Config{}
 	if err := envconfig.Process(t.Context(), &cfg); err != nil {
@@ -45,10 +52,13 @@ func testInit(t *testing.T, test func(*testSuite)) {
 	controller := NewController(
 		cfg,
 		gormDB,
+		txmanager.New(gormDB),
 		timeModule,
+		employeeInfoRepo,
 		employeePositionRepo,
 		employeeAttendanceRepo,
 		cacheManager,
+		taskPool,
 	)
 	testServer := testutils.NewTestHttpServer(controller)
 	faker := gofakeit.New(0)
@@ -56,9 +66,11 @@ func testInit(t *testing.T, test func(*testSuite)) {
 		db:                     gormDB,
 		mockDB:                 mockDB,
 		timeModule:             timeModule,
+		employeeInfoRepo:       employeeInfoRepo,
 		employeePositionRepo:   employeePositionRepo,
 		employeeAttendanceRepo: employeeAttendanceRepo,
 		cacheManager:           cacheManager,
+		taskPool:               taskPool,
 		controller:             controller,
 		testServer:             testServer,
 		faker:                  faker,
@@ -66,3 +78,34 @@ func testInit(t *testing.T, test func(*testSuite)) {
 
 	test(suite)
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestConfigValidate(t *testing.T) {
+	Convey("Given the attendance configuration", t, func() {
+		cfg := Config{}
+		if err := envconfig.Process(t.Context(), &cfg); err != nil {
+			t.Fatal(err)
+		}
+
+		Convey("Then the defaults should be valid", func() {
+			So(cfg.Validate(), ShouldBeNil)
+		})
+
+		Convey("When the sweep interval is zero", func() {
+			cfg.SweepInterval = 0
+
+			Convey("Then it should be rejected", func() {
+				So(cfg.Validate(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the max shift length is negative", func() {
+			cfg.MaxShiftLength = -time.Hour
+
+			Convey("Then it should be rejected", func() {
+				So(cfg.Validate(), ShouldNotBeNil)
+			})
+		})
+	})
+}
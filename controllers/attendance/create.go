@@ -6,9 +6,11 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -19,7 +21,24 @@ type CreateRequest struct {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// Create toggles the attendance of the employee, clocking out of an open
+// session or clocking in otherwise. Kept for clients that predate the explicit
+// clock-in and clock-out endpoints.
 func (c *Controller) Create(ctx *gin.Context) {
+	c.recordAttendance(ctx, clockToggle, http.StatusCreated)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type clockAction int
+
+const (
+	clockToggle clockAction = iota
+	clockIn
+	clockOut
+)
+
+func (c *Controller) recordAttendance(ctx *gin.Context, action clockAction, successCode int) {
 	logger := log.Ctx(ctx.Request.Context())
 
 	var req CreateRequest
@@ -30,39 +49,70 @@ func (c *Controller) Create(ctx *gin.Context) {
 
 	////////////////////////////////////////////////////////////////////////////
 
-	// Get the employee's current position
-	positionID, err := c.getEmployeePosition(ctx, req.EmployeeID)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee position"})
-		return
-	}
+	var attendanceResponse dtos.AttendanceV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		// Lock the employee so concurrent clock-ins see each other's writes.
+		// Terminated employees can no longer clock in or out.
+		employeeInfo, err := c.employeeInfoRepo.GetForUpdate(ctx, tx.DB, req.EmployeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee")
+		}
+		if employeeInfo == nil {
+			return utils.NewHttpError(http.StatusNotFound, "employee not found")
+		}
 
-	// Get the employee's current attendance
-	attendance, err := c.getEmployeeAttendance(ctx, req.EmployeeID)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee attendance"})
-		return
-	}
+		// Get the employee's current position
+		positionID, err := c.getEmployeePosition(ctx, tx.DB, req.EmployeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee position")
+		}
 
-	////////////////////////////////////////////////////////////////////////////
+		// Get the employee's current attendance
+		attendance, err := c.getEmployeeAttendance(ctx, tx.DB, req.EmployeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee attendance")
+		}
 
-	// Create or update the attendance record
-	attendanceResponse, err := c.createClockIn(ctx, req.EmployeeID, positionID, attendance)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create/update attendance"})
+		// Explicit actions must not flip the state of a retried request
+		open := attendance != nil && attendance.IsOpen()
+		if action == clockIn && open {
+			return utils.NewHttpError(http.StatusConflict, "already clocked in")
+		}
+		if action == clockOut && !open {
+			return utils.NewHttpError(http.StatusConflict, "not clocked in")
+		}
+
+		// Create or update the attendance record
+		attendance, err = c.createClockIn(ctx, tx.DB, req.EmployeeID, positionID, attendance)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create/update attendance")
+		}
+		attendanceResponse = newAttendanceV1Response(
+			attendance,
+			utils.GetTimeFormatter(ctx).In(employeeInfo.Location()),
+		)
+
+		// Cache the attendance record, always in the V1 format
+		cached := newAttendanceV1Response(attendance, utils.V1TimeFormatter)
+		tx.AfterCommit(func() {
+			if err := c.cacheManager.SetAttendanceV1(ctx, req.EmployeeID, cached, 0); err != nil {
+				logger.Error().Err(err).Msg("Failed to cache attendance")
+			}
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create/update attendance")
 		return
 	}
-	// Cache the attendance record
-	if err := c.cacheManager.SetAttendanceV1(ctx, req.EmployeeID, *attendanceResponse, 0); err != nil {
-		logger.Error().Err(err).Msg("Failed to cache attendance")
-	}
 
-	ctx.JSON(http.StatusCreated, attendanceResponse)
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(successCode, attendanceResponse)
 }
 
 ////////////////////////////////////////////////////////////////////////////////
 
-func (c *Controller) getEmployeePosition(ctx *gin.Context, employeeID int64) (int64, error) {
+func (c *Controller) getEmployeePosition(ctx *gin.Context, tx *gorm.DB, employeeID int64) (int64, error) {
 	logger := log.Ctx(ctx.Request.Context())
 
 	if employeeID <= 0 {
@@ -78,7 +128,7 @@ func (c *Controller) getEmployeePosition(ctx *gin.Context, employeeID int64) (in
 	}
 
 	// Get the current position of the employee
-	dbEmployeePosition, err := c.employeePositionRepo.GetCurrentByEmployeeID(ctx, c.db, employeeID, c.timeModule.Now())
+	dbEmployeePosition, err := c.employeePositionRepo.GetCurrentByEmployeeID(ctx, tx, employeeID, c.timeModule.Now())
 	if err != nil {
 		return 0, fmt.Errorf("failed to get employee position: %w", err)
 	}
@@ -88,13 +138,13 @@ func (c *Controller) getEmployeePosition(ctx *gin.Context, employeeID int64) (in
 	return dbEmployeePosition.ID, nil
 }
 
-func (c *Controller) getEmployeeAttendance(ctx *gin.Context, employeeID int64) (*models.EmployeeAttendance, error) {
+func (c *Controller) getEmployeeAttendance(ctx *gin.Context, tx *gorm.DB, employeeID int64) (*models.EmployeeAttendance, error) {
 	if employeeID <= 0 {
 		return nil, fmt.Errorf("invalid employee ID")
 	}
 
 	// Get the current attendance of the employee
-	dbAttendance, err := c.employeeAttendanceRepo.Last(ctx, c.db, employeeID)
+	dbAttendance, err := c.employeeAttendanceRepo.Last(ctx, tx, employeeID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get employee attendance: %w", err)
 	}
@@ -106,10 +156,11 @@ func (c *Controller) getEmployeeAttendance(ctx *gin.Context, employeeID int64) (
 
 func (c *Controller) createClockIn(
 	ctx *gin.Context,
+	tx *gorm.DB,
 	employeeID int64,
 	positionID int64,
 	currAttendance *models.EmployeeAttendance,
-) (*dtos.AttendanceV1Response, error) {
+) (*models.EmployeeAttendance, error) {
 	if employeeID <= 0 {
 		return nil, fmt.Errorf("invalid employee ID")
 	}
@@ -117,11 +168,11 @@ func (c *Controller) createClockIn(
 		return nil, fmt.Errorf("invalid position ID")
 	}
 
-	if currAttendance == nil || currAttendance.ClockIn != currAttendance.ClockOut {
+	if currAttendance == nil || !currAttendance.IsOpen() {
 		// Create a new attendance record for clock-in
 		newAttendance, err := c.employeeAttendanceRepo.CreateForClockIn(
 			ctx,
-			c.db,
+			tx,
 			employeeID,
 			positionID,
 			c.timeModule.Now(),
@@ -129,29 +180,19 @@ func (c *Controller) createClockIn(
 		if err != nil {
 			return nil, fmt.Errorf("failed to create attendance: %w", err)
 		}
-		return &dtos.AttendanceV1Response{
-			AttendanceID: newAttendance.ID,
-			PositionID:   newAttendance.PositionID,
-			ClockInTime:  utils.FormatedTime(newAttendance.ClockIn),
-			ClockOutTime: "",
-		}, nil
+		return newAttendance, nil
 	}
 
 	////////////////////////////////////////////////////////////////////////////
 
 	currAttendance, err := c.employeeAttendanceRepo.UpdateForClockOut(
 		ctx,
-		c.db,
+		tx,
 		currAttendance.ID,
 		c.timeModule.Now(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update attendance: %w", err)
 	}
-	return &dtos.AttendanceV1Response{
-		AttendanceID: currAttendance.ID,
-		PositionID:   currAttendance.PositionID,
-		ClockInTime:  utils.FormatedTime(currAttendance.ClockIn),
-		ClockOutTime: utils.FormatedTime(currAttendance.ClockOut),
-	}, nil
+	return currAttendance, nil
 }
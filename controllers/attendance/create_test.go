@@ -8,6 +8,7 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
 )
@@ -21,12 +22,18 @@ func TestCreate(t *testing.T) {
 			attendanceID := int64(789)
 			nowTime := time.Date(2023, 6, 15, 9, 0, 0, 0, time.UTC)
 
+			employeeInfo := &models.EmployeeInfo{
+				ID:    employeeID,
+				Name:  "John Doe",
+				Email: "john.doe@example.com",
+			}
+
 			employeePosition := &models.EmployeePosition{
 				ID:         positionID,
 				EmployeeID: employeeID,
 				Position:   "Software Engineer",
 				Department: "Engineering",
-				Salary:     90000.00,
+				Salary:     money.New(9000000, "USD"),
 				StartDate:  nowTime.Add(-30 * 24 * time.Hour), // 30 days ago
 			}
 
@@ -35,13 +42,22 @@ func TestCreate(t *testing.T) {
 			}
 
 			Convey("When clocking in for the first time", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				// New attendance record for clock-in
 				newAttendance := &models.EmployeeAttendance{
 					ID:         attendanceID,
 					EmployeeID: employeeID,
 					PositionID: positionID,
 					ClockIn:    nowTime,
-					// ClockOut is zero time
+					Status:     models.AttendanceStatusOpen,
 				}
 
 				// Set up expectations
@@ -53,15 +69,15 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(employeePosition, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					Last(gomock.Any(), s.db, employeeID).
+					Last(gomock.Any(), gomock.Any(), employeeID).
 					Return(nil, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					CreateForClockIn(gomock.Any(), s.db, employeeID, positionID, nowTime).
+					CreateForClockIn(gomock.Any(), gomock.Any(), employeeID, positionID, nowTime).
 					Return(newAttendance, nil)
 
 				// Expected response for cache
@@ -70,6 +86,7 @@ func TestCreate(t *testing.T) {
 					PositionID:   positionID,
 					ClockInTime:  "2023-06-15 09:00:00",
 					ClockOutTime: "",
+					Status:       models.AttendanceStatusOpen,
 				}
 
 				// Expect cache set call
@@ -97,13 +114,22 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When clocking out after previous clock-in", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				clockInTime := nowTime.Add(-8 * time.Hour) // 8 hours before now
 				existingAttendance := &models.EmployeeAttendance{
 					ID:         attendanceID,
 					EmployeeID: employeeID,
 					PositionID: positionID,
 					ClockIn:    clockInTime,
-					ClockOut:   clockInTime, // Same as ClockIn indicating no clock-out yet
+					Status:     models.AttendanceStatusOpen, // Not clocked out yet
 				}
 
 				updatedAttendance := &models.EmployeeAttendance{
@@ -111,7 +137,8 @@ func TestCreate(t *testing.T) {
 					EmployeeID: employeeID,
 					PositionID: positionID,
 					ClockIn:    clockInTime,
-					ClockOut:   nowTime,
+					ClockOut:   &nowTime,
+					Status:     models.AttendanceStatusClosed,
 				}
 
 				// Set up expectations
@@ -123,15 +150,15 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(employeePosition, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					Last(gomock.Any(), s.db, employeeID).
+					Last(gomock.Any(), gomock.Any(), employeeID).
 					Return(existingAttendance, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					UpdateForClockOut(gomock.Any(), s.db, attendanceID, nowTime).
+					UpdateForClockOut(gomock.Any(), gomock.Any(), attendanceID, nowTime).
 					Return(updatedAttendance, nil)
 
 				// Expected response for cache
@@ -140,6 +167,7 @@ func TestCreate(t *testing.T) {
 					PositionID:   positionID,
 					ClockInTime:  "2023-06-15 01:00:00",
 					ClockOutTime: "2023-06-15 09:00:00",
+					Status:       models.AttendanceStatusClosed,
 				}
 
 				// Expect cache set call
@@ -167,6 +195,15 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When employee position is not found", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				// Set up expectations for failure
 				s.timeModule.EXPECT().Now().Return(nowTime)
 
@@ -175,7 +212,7 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(nil, nil)
 
 				// Make the request and verify error response
@@ -195,6 +232,15 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When getting position fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				// Set up expectations for failure
 				s.cacheManager.EXPECT().
 					GetEmployeeDetailV1(gomock.Any(), employeeID).
@@ -203,7 +249,7 @@ func TestCreate(t *testing.T) {
 				s.timeModule.EXPECT().Now().Return(nowTime)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(nil, errors.New("database error"))
 
 				// Make the request and verify error response
@@ -223,6 +269,15 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When getting last attendance fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				// Set up expectations for failure
 				s.timeModule.EXPECT().Now().Return(nowTime)
 
@@ -231,11 +286,11 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(employeePosition, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					Last(gomock.Any(), s.db, employeeID).
+					Last(gomock.Any(), gomock.Any(), employeeID).
 					Return(nil, errors.New("database error"))
 
 				// Make the request and verify error response
@@ -255,6 +310,15 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When creating a new attendance record fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				// Set up expectations for failure
 				s.timeModule.EXPECT().Now().Return(nowTime).Times(2)
 
@@ -263,15 +327,15 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(employeePosition, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					Last(gomock.Any(), s.db, employeeID).
+					Last(gomock.Any(), gomock.Any(), employeeID).
 					Return(nil, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					CreateForClockIn(gomock.Any(), s.db, employeeID, positionID, nowTime).
+					CreateForClockIn(gomock.Any(), gomock.Any(), employeeID, positionID, nowTime).
 					Return(nil, errors.New("database error"))
 
 				// Make the request and verify error response
@@ -291,13 +355,22 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When updating attendance for clock-out fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				clockInTime := nowTime.Add(-8 * time.Hour)
 				existingAttendance := &models.EmployeeAttendance{
 					ID:         attendanceID,
 					EmployeeID: employeeID,
 					PositionID: positionID,
 					ClockIn:    clockInTime,
-					ClockOut:   clockInTime,
+					Status:     models.AttendanceStatusOpen,
 				}
 
 				// Set up expectations for failure during update
@@ -308,15 +381,15 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(employeePosition, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					Last(gomock.Any(), s.db, employeeID).
+					Last(gomock.Any(), gomock.Any(), employeeID).
 					Return(existingAttendance, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					UpdateForClockOut(gomock.Any(), s.db, attendanceID, nowTime).
+					UpdateForClockOut(gomock.Any(), gomock.Any(), attendanceID, nowTime).
 					Return(nil, errors.New("database error"))
 
 				// Make the request and verify error response
@@ -336,12 +409,22 @@ func TestCreate(t *testing.T) {
 			})
 
 			Convey("When setting attendance to cache fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				// The employee is still active
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
 				// New attendance record for clock-in
 				newAttendance := &models.EmployeeAttendance{
 					ID:         attendanceID,
 					EmployeeID: employeeID,
 					PositionID: positionID,
 					ClockIn:    nowTime,
+					Status:     models.AttendanceStatusOpen,
 				}
 
 				// Set up expectations
@@ -352,15 +435,15 @@ func TestCreate(t *testing.T) {
 					Return(nil, nil)
 
 				s.employeePositionRepo.EXPECT().
-					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
 					Return(employeePosition, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					Last(gomock.Any(), s.db, employeeID).
+					Last(gomock.Any(), gomock.Any(), employeeID).
 					Return(nil, nil)
 
 				s.employeeAttendanceRepo.EXPECT().
-					CreateForClockIn(gomock.Any(), s.db, employeeID, positionID, nowTime).
+					CreateForClockIn(gomock.Any(), gomock.Any(), employeeID, positionID, nowTime).
 					Return(newAttendance, nil)
 
 				expectedResponse := dtos.AttendanceV1Response{
@@ -368,6 +451,7 @@ func TestCreate(t *testing.T) {
 					PositionID:   positionID,
 					ClockInTime:  "2023-06-15 09:00:00",
 					ClockOutTime: "",
+					Status:       models.AttendanceStatusOpen,
 				}
 
 				// Expect cache set to fail but API should still succeed
@@ -392,6 +476,32 @@ func TestCreate(t *testing.T) {
 					So(actualResponse.ClockOutTime, ShouldEqual, expectedResponse.ClockOutTime)
 				})
 			})
+
+			Convey("When the employee has been terminated", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				// Terminated employees are soft deleted and no longer found
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				// Make the request and verify error response
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/attendance",
+					req,
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate employee not found", func() {
+					So(errorResponse["error"], ShouldEqual, "employee not found")
+				})
+			})
 		})
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
@@ -29,14 +30,31 @@ func (c *Controller) Get(ctx *gin.Context) {
 
 	////////////////////////////////////////////////////////////////////////////
 
-	cached, err := c.cacheManager.GetAttendanceV1(ctx, employeeIDInt)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to get attendance from cache")
-	}
-	if cached != nil {
-		logger.Info().Msg("Cache hit")
-		ctx.JSON(http.StatusOK, cached)
-		return
+	// Only the V1 response is cached
+	formatter := utils.GetTimeFormatter(ctx)
+	if formatter.Format() == utils.TimeFormatV1 {
+		cached, err := c.cacheManager.GetAttendanceV1(ctx, employeeIDInt)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to get attendance from cache")
+		}
+		// Entries cached before sessions had a status are treated as a miss
+		if cached != nil && cached.Status != "" {
+			logger.Info().Msg("Cache hit")
+			ctx.JSON(http.StatusOK, cached)
+			return
+		}
+	} else {
+		// Other formats render the times in the zone of the employee
+		employeeInfo, err := c.employeeInfoRepo.Get(ctx, c.db, employeeIDInt)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee"})
+			return
+		}
+		if employeeInfo == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+			return
+		}
+		formatter = formatter.In(employeeInfo.Location())
 	}
 
 	////////////////////////////////////////////////////////////////////////////
@@ -52,19 +70,8 @@ func (c *Controller) Get(ctx *gin.Context) {
 		return
 	}
 
-	clockOutTime := ""
-	if currAttendance.ClockIn != currAttendance.ClockOut {
-		// If the clock-in and clock-out times are the same, it means the employee has not clocked out yet
-		clockOutTime = utils.FormatedTime(currAttendance.ClockOut)
-	}
-
 	////////////////////////////////////////////////////////////////////////////
-	resp := dtos.AttendanceV1Response{
-		AttendanceID: currAttendance.ID,
-		PositionID:   currAttendance.PositionID,
-		ClockInTime:  utils.FormatedTime(currAttendance.ClockIn),
-		ClockOutTime: clockOutTime,
-	}
+	resp := newAttendanceV1Response(currAttendance, utils.V1TimeFormatter)
 
 	// Cache the attendance record
 	if err := c.cacheManager.SetAttendanceV1(ctx, employeeIDInt, resp, 0); err != nil {
@@ -72,5 +79,26 @@ func (c *Controller) Get(ctx *gin.Context) {
 	}
 
 	// Return the attendance record
+	if formatter.Format() != utils.TimeFormatV1 {
+		resp = newAttendanceV1Response(currAttendance, formatter)
+	}
 	ctx.JSON(http.StatusOK, resp)
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newAttendanceV1Response(attendance *models.EmployeeAttendance, formatter utils.TimeFormatter) dtos.AttendanceV1Response {
+	// The clock-out time stays empty while the session is open
+	clockOutTime := ""
+	if attendance.ClockOut != nil {
+		clockOutTime = formatter.Time(*attendance.ClockOut)
+	}
+
+	return dtos.AttendanceV1Response{
+		AttendanceID: attendance.ID,
+		PositionID:   attendance.PositionID,
+		ClockInTime:  formatter.Time(attendance.ClockIn),
+		ClockOutTime: clockOutTime,
+		Status:       attendance.Status,
+	}
+}
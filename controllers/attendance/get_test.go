@@ -28,7 +28,8 @@ func TestGet(t *testing.T) {
 				EmployeeID: employeeID,
 				PositionID: positionID,
 				ClockIn:    nowTime.Add(-4 * time.Hour), // Clocked in 4 hours ago
-				ClockOut:   nowTime,                     // Clocked out now
+				ClockOut:   &nowTime,                    // Clocked out now
+				Status:     models.AttendanceStatusClosed,
 			}
 
 			// Expected response data structure
@@ -36,7 +37,8 @@ func TestGet(t *testing.T) {
 				AttendanceID: attendance.ID,
 				PositionID:   attendance.PositionID,
 				ClockInTime:  utils.FormatedTime(attendance.ClockIn),
-				ClockOutTime: utils.FormatedTime(attendance.ClockOut),
+				ClockOutTime: utils.FormatedTime(nowTime),
+				Status:       models.AttendanceStatusClosed,
 			}
 
 			Convey("When retrieving the attendance by employee ID and cache hits", func() {
@@ -45,7 +47,8 @@ func TestGet(t *testing.T) {
 					AttendanceID: attendance.ID,
 					PositionID:   attendance.PositionID,
 					ClockInTime:  utils.FormatedTime(attendance.ClockIn),
-					ClockOutTime: utils.FormatedTime(attendance.ClockOut),
+					ClockOutTime: utils.FormatedTime(nowTime),
+					Status:       models.AttendanceStatusClosed,
 				}
 
 				s.cacheManager.EXPECT().
@@ -73,6 +76,37 @@ func TestGet(t *testing.T) {
 				})
 			})
 
+			Convey("When the cached entry predates session statuses", func() {
+				s.cacheManager.EXPECT().
+					GetAttendanceV1(gomock.Any(), employeeID).
+					Return(&dtos.AttendanceV1Response{
+						AttendanceID: attendance.ID,
+						PositionID:   attendance.PositionID,
+						ClockInTime:  utils.FormatedTime(attendance.ClockIn),
+					}, nil)
+
+				s.employeeAttendanceRepo.EXPECT().
+					Last(gomock.Any(), s.db, employeeID).
+					Return(attendance, nil)
+				s.cacheManager.EXPECT().
+					SetAttendanceV1(gomock.Any(), employeeID, expectedResponse, gomock.Any()).
+					Return(nil)
+
+				var actualResponse dtos.AttendanceV1Response
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/attendance/123",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then it should be reloaded from the database", func() {
+					So(actualResponse, ShouldResemble, expectedResponse)
+				})
+			})
+
 			Convey("When retrieving the attendance by employee ID and cache misses", func(c C) {
 				// Set up cache miss expectation
 				s.cacheManager.EXPECT().
@@ -92,6 +126,7 @@ func TestGet(t *testing.T) {
 						c.So(resp.PositionID, ShouldEqual, expectedResponse.PositionID)
 						c.So(resp.ClockInTime, ShouldEqual, expectedResponse.ClockInTime)
 						c.So(resp.ClockOutTime, ShouldEqual, expectedResponse.ClockOutTime)
+						c.So(resp.Status, ShouldEqual, expectedResponse.Status)
 						return nil
 					})
 
@@ -114,6 +149,42 @@ func TestGet(t *testing.T) {
 				})
 			})
 
+			Convey("When asking for RFC 3339 times", func() {
+				employeeInfo := &models.EmployeeInfo{
+					ID:       employeeID,
+					TimeZone: "Europe/Berlin",
+				}
+
+				// The cache only holds V1 responses
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), s.db, employeeID).
+					Return(employeeInfo, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					Last(gomock.Any(), s.db, employeeID).
+					Return(attendance, nil)
+				s.cacheManager.EXPECT().
+					SetAttendanceV1(gomock.Any(), employeeID, expectedResponse, gomock.Any()).
+					Return(nil)
+
+				header := http.Header{}
+				header.Set(utils.TimeFormatHeader, string(utils.TimeFormatRFC3339))
+				var actualResponse dtos.AttendanceV1Response
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodGet,
+					"/attendance/123",
+					header,
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the times should carry the offset of the employee's zone", func() {
+					So(actualResponse.ClockInTime, ShouldEqual, "2023-06-15T10:00:00+02:00")
+					So(actualResponse.ClockOutTime, ShouldEqual, "2023-06-15T14:00:00+02:00")
+				})
+			})
+
 			Convey("When retrieving attendance for employee who hasn't clocked out yet", func(c C) {
 				// Create a mock attendance record where employee hasn't clocked out
 				notClockedOutAttendance := &models.EmployeeAttendance{
@@ -121,7 +192,7 @@ func TestGet(t *testing.T) {
 					EmployeeID: employeeID,
 					PositionID: positionID,
 					ClockIn:    nowTime.Add(-4 * time.Hour), // Clocked in 4 hours ago
-					ClockOut:   nowTime.Add(-4 * time.Hour), // Same as clock in (hasn't clocked out)
+					Status:     models.AttendanceStatusOpen, // Hasn't clocked out
 				}
 
 				// Expected response with empty clock out time
@@ -145,6 +216,7 @@ func TestGet(t *testing.T) {
 					SetAttendanceV1(gomock.Any(), employeeID, gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, _ int64, resp dtos.AttendanceV1Response, _ interface{}) error {
 						c.So(resp.ClockOutTime, ShouldEqual, "") // Verify empty clock out time
+						c.So(resp.Status, ShouldEqual, models.AttendanceStatusOpen)
 						return nil
 					})
 
@@ -0,0 +1,193 @@
+package attendance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeattendancerepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	historyDateLayout   = "2006-01-02"
+	defaultHistoryDays  = 30
+	defaultHistoryLimit = 50
+)
+
+type HistoryRequest struct {
+	// From and To are inclusive dates, To defaults to today and From to
+	// 30 days before To
+	From   string `form:"from"`
+	To     string `form:"to"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+type HistoryResponse = dtos.PageV1Response[dtos.AttendanceSessionV1Response]
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) History(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("employee_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid employee id"})
+		return
+	}
+
+	var req HistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cursor, err := utils.DecodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	// The days are the calendar days of the employee's zone
+	employeeInfo, err := c.employeeInfoRepo.Get(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee"})
+		return
+	}
+	if employeeInfo == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+	loc := employeeInfo.Location()
+
+	nowTime := c.timeModule.Now()
+	from, to, err := historyWindow(req, nowTime, loc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	employeePositions, err := c.employeePositionRepo.ListByEmployeeID(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+	positions := lo.KeyBy(employeePositions, func(position *models.EmployeePosition) int64 {
+		return position.ID
+	})
+
+	params := employeeattendancerepo.ListParams{
+		EmployeeID: employeeID,
+		From:       from,
+		To:         to,
+		Cursor:     cursor,
+		// Fetch one extra row to know whether there is a next page
+		Limit: limit + 1,
+	}
+	attendances, err := c.employeeAttendanceRepo.List(ctx, c.db, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list attendances"})
+		return
+	}
+
+	nextCursor := ""
+	if len(attendances) > limit {
+		attendances = attendances[:limit]
+		nextCursor = utils.EncodeCursor(employeeattendancerepo.ListCursor(attendances[limit-1]))
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	formatter := utils.GetTimeFormatter(ctx).In(loc)
+	items := make([]dtos.AttendanceSessionV1Response, 0, len(attendances))
+	for _, attendance := range attendances {
+		items = append(items, newAttendanceSessionV1Response(attendance, positions[attendance.PositionID], nowTime, formatter))
+	}
+
+	ctx.JSON(http.StatusOK, HistoryResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// historyWindow returns the [from, to) window covering the requested days of
+// the zone.
+func historyWindow(req HistoryRequest, nowTime time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	nowTime = nowTime.In(loc)
+	to := time.Date(nowTime.Year(), nowTime.Month(), nowTime.Day(), 0, 0, 0, 0, loc)
+	if req.To != "" {
+		date, err := time.ParseInLocation(historyDateLayout, req.To, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidDate("to")
+		}
+		to = date
+	}
+
+	from := to.AddDate(0, 0, -defaultHistoryDays)
+	if req.From != "" {
+		date, err := time.ParseInLocation(historyDateLayout, req.From, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidDate("from")
+		}
+		from = date
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errors.New("from must not be after to")
+	}
+
+	// The last day is included
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+func errInvalidDate(field string) error {
+	return fmt.Errorf("invalid %s, expected %s", field, historyDateLayout)
+}
+
+func newAttendanceSessionV1Response(
+	attendance *models.EmployeeAttendance,
+	position *models.EmployeePosition,
+	nowTime time.Time,
+	formatter utils.TimeFormatter,
+) dtos.AttendanceSessionV1Response {
+	// An open session runs up to now
+	end := attendance.ClockIn
+	clockOutTime := ""
+	if attendance.ClockOut != nil {
+		end = *attendance.ClockOut
+		clockOutTime = formatter.Time(*attendance.ClockOut)
+	} else if attendance.IsOpen() {
+		end = nowTime
+	}
+
+	session := dtos.AttendanceSessionV1Response{
+		AttendanceID:    attendance.ID,
+		PositionID:      attendance.PositionID,
+		ClockInTime:     formatter.Time(attendance.ClockIn),
+		ClockOutTime:    clockOutTime,
+		Status:          attendance.Status,
+		DurationSeconds: int64(max(end.Sub(attendance.ClockIn), 0) / time.Second),
+		Open:            attendance.IsOpen(),
+	}
+	if position != nil {
+		session.Position = position.Position
+		session.Department = position.Department
+	}
+
+	return session
+}
@@ -0,0 +1,265 @@
+package attendance
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeattendancerepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHistory(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with attendance sessions", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+			employeeInfo := &models.EmployeeInfo{
+				ID:       employeeID,
+				TimeZone: models.DefaultTimeZone,
+			}
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, Position: "Engineer", Department: "Engineering"},
+				{ID: 2, EmployeeID: employeeID, Position: "Senior Engineer", Department: "Platform"},
+			}
+			closed := &models.EmployeeAttendance{
+				ID:         10,
+				EmployeeID: employeeID,
+				PositionID: 1,
+				ClockIn:    time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC),
+				ClockOut:   lo.ToPtr(time.Date(2023, 6, 1, 17, 30, 0, 0, time.UTC)),
+				Status:     models.AttendanceStatusClosed,
+			}
+			open := &models.EmployeeAttendance{
+				ID:         11,
+				EmployeeID: employeeID,
+				PositionID: 2,
+				ClockIn:    nowTime.Add(-3 * time.Hour),
+				Status:     models.AttendanceStatusOpen,
+			}
+
+			Convey("When listing without a window", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+
+				var params employeeattendancerepo.ListParams
+				s.employeeAttendanceRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ any, p employeeattendancerepo.ListParams) ([]*models.EmployeeAttendance, error) {
+						params = p
+						return []*models.EmployeeAttendance{closed, open}, nil
+					})
+
+				var resp HistoryResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/123/history", nil, &resp, http.StatusOK)
+
+				Convey("Then it should cover the last 30 days including today", func() {
+					So(params.EmployeeID, ShouldEqual, employeeID)
+					So(params.From, ShouldEqual, time.Date(2023, 5, 16, 0, 0, 0, 0, time.UTC))
+					So(params.To, ShouldEqual, time.Date(2023, 6, 16, 0, 0, 0, 0, time.UTC))
+					So(params.Cursor, ShouldBeNil)
+					So(params.Limit, ShouldEqual, defaultHistoryLimit+1)
+				})
+
+				Convey("Then closed sessions should report their logged duration", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.NextCursor, ShouldBeEmpty)
+					So(resp.Items[0].AttendanceID, ShouldEqual, closed.ID)
+					So(resp.Items[0].Position, ShouldEqual, "Engineer")
+					So(resp.Items[0].Department, ShouldEqual, "Engineering")
+					So(resp.Items[0].ClockOutTime, ShouldEqual, utils.FormatedTime(*closed.ClockOut))
+					So(resp.Items[0].Status, ShouldEqual, models.AttendanceStatusClosed)
+					So(resp.Items[0].DurationSeconds, ShouldEqual, int64(8*3600+30*60))
+					So(resp.Items[0].Open, ShouldBeFalse)
+				})
+
+				Convey("Then open sessions should run up to now", func() {
+					So(resp.Items[1].AttendanceID, ShouldEqual, open.ID)
+					So(resp.Items[1].Position, ShouldEqual, "Senior Engineer")
+					So(resp.Items[1].ClockOutTime, ShouldBeEmpty)
+					So(resp.Items[1].DurationSeconds, ShouldEqual, int64(3*3600))
+					So(resp.Items[1].Open, ShouldBeTrue)
+				})
+			})
+
+			Convey("When listing an explicit window with a page limit", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+
+				var params employeeattendancerepo.ListParams
+				s.employeeAttendanceRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ any, p employeeattendancerepo.ListParams) ([]*models.EmployeeAttendance, error) {
+						params = p
+						return []*models.EmployeeAttendance{closed, open}, nil
+					})
+
+				var resp HistoryResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/attendance/123/history?from=2023-06-01&to=2023-06-10&limit=1",
+					nil,
+					&resp,
+					http.StatusOK,
+				)
+
+				Convey("Then it should query the inclusive window and return a next cursor", func() {
+					So(params.From, ShouldEqual, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+					So(params.To, ShouldEqual, time.Date(2023, 6, 11, 0, 0, 0, 0, time.UTC))
+					So(params.Limit, ShouldEqual, 2)
+					So(resp.Items, ShouldHaveLength, 1)
+					So(resp.NextCursor, ShouldEqual, utils.EncodeCursor(employeeattendancerepo.ListCursor(closed)))
+				})
+			})
+
+			Convey("When the employee works in another zone", func() {
+				taipei := &models.EmployeeInfo{
+					ID:       employeeID,
+					TimeZone: "Asia/Taipei",
+				}
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(taipei, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+
+				var params employeeattendancerepo.ListParams
+				s.employeeAttendanceRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ any, p employeeattendancerepo.ListParams) ([]*models.EmployeeAttendance, error) {
+						params = p
+						return []*models.EmployeeAttendance{closed}, nil
+					})
+
+				header := http.Header{}
+				header.Set(utils.TimeFormatHeader, string(utils.TimeFormatRFC3339))
+				var resp HistoryResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodGet,
+					"/attendance/123/history?from=2023-06-01&to=2023-06-10",
+					header,
+					nil,
+					&resp,
+					http.StatusOK,
+				)
+
+				Convey("Then the days should be the days of the zone", func() {
+					loc := taipei.Location()
+					So(params.From, ShouldEqual, time.Date(2023, 6, 1, 0, 0, 0, 0, loc))
+					So(params.To, ShouldEqual, time.Date(2023, 6, 11, 0, 0, 0, 0, loc))
+				})
+
+				Convey("Then the times should carry the offset of the zone", func() {
+					So(resp.Items, ShouldHaveLength, 1)
+					So(resp.Items[0].ClockInTime, ShouldEqual, "2023-06-01T17:00:00+08:00")
+					So(resp.Items[0].ClockOutTime, ShouldEqual, "2023-06-02T01:30:00+08:00")
+				})
+			})
+
+			Convey("When following a cursor", func() {
+				cursor := employeeattendancerepo.ListCursor(closed)
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+
+				var params employeeattendancerepo.ListParams
+				s.employeeAttendanceRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ any, p employeeattendancerepo.ListParams) ([]*models.EmployeeAttendance, error) {
+						params = p
+						return []*models.EmployeeAttendance{open}, nil
+					})
+
+				var resp HistoryResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/attendance/123/history?cursor="+utils.EncodeCursor(cursor),
+					nil,
+					&resp,
+					http.StatusOK,
+				)
+
+				Convey("Then the cursor should be passed to the repository", func() {
+					So(params.Cursor, ShouldResemble, &cursor)
+					So(resp.Items, ShouldHaveLength, 1)
+					So(resp.NextCursor, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/123/history", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the attendance repository fails", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/123/history", nil, nil, http.StatusInternalServerError)
+			})
+
+			Convey("When the request is invalid", func() {
+				Convey("With a non-numeric employee ID", func() {
+					s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/abc/history", nil, nil, http.StatusBadRequest)
+				})
+
+				Convey("With a malformed cursor", func() {
+					s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/123/history?cursor=not-a-cursor!", nil, nil, http.StatusBadRequest)
+				})
+
+				Convey("With a malformed date", func() {
+					s.employeeInfoRepo.EXPECT().
+						Get(gomock.Any(), gomock.Any(), employeeID).
+						Return(employeeInfo, nil)
+					s.timeModule.EXPECT().Now().Return(nowTime)
+					s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/123/history?from=06/01/2023", nil, nil, http.StatusBadRequest)
+				})
+
+				Convey("With from after to", func() {
+					s.employeeInfoRepo.EXPECT().
+						Get(gomock.Any(), gomock.Any(), employeeID).
+						Return(employeeInfo, nil)
+					s.timeModule.EXPECT().Now().Return(nowTime)
+					s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/123/history?from=2023-06-10&to=2023-06-01", nil, nil, http.StatusBadRequest)
+				})
+			})
+		})
+	})
+}
@@ -6,26 +6,45 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeattendancerepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"gorm.io/gorm"
 )
 
 //go:generate mockgen -source=interface.go -destination=interface_mock.go -package=attendance
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
 type TimeModule interface {
 	Now() time.Time
 }
 
+type EmployeeInfoRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+	GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+}
+
 type EmployeePositionRepo interface {
 	GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error)
+	ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error)
 }
 
 type EmployeeAttendanceRepo interface {
 	CreateForClockIn(ctx context.Context, tx *gorm.DB, employeeID int64, positionID int64, clockInTime time.Time) (*models.EmployeeAttendance, error)
 	Last(ctx context.Context, tx *gorm.DB, employeeID int64) (*models.EmployeeAttendance, error)
+	List(ctx context.Context, tx *gorm.DB, params employeeattendancerepo.ListParams) ([]*models.EmployeeAttendance, error)
 	UpdateForClockOut(ctx context.Context, tx *gorm.DB, attendanceID int64, clockOutTime time.Time) (*models.EmployeeAttendance, error)
+	AutoCloseOpenBefore(ctx context.Context, tx *gorm.DB, clockInBefore time.Time, maxShift time.Duration) ([]*models.EmployeeAttendance, error)
 }
 
 type CacheManager interface {
 	GetAttendanceV1(ctx context.Context, employeeID int64) (*dtos.AttendanceV1Response, error)
 	GetEmployeeDetailV1(ctx context.Context, employeeID int64) (*dtos.EmployeeV1Response, error)
 	SetAttendanceV1(ctx context.Context, employeeID int64, data dtos.AttendanceV1Response, expired time.Duration) error
+	DeleteAttendanceV1(ctx context.Context, employeeID int64) error
+}
+
+type TaskPool interface {
+	RunEvery(interval time.Duration, fn func(ctx context.Context))
 }
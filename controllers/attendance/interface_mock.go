@@ -16,10 +16,50 @@ import (
 
 	dtos "github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	employeeattendancerepo "github.com/WangWilly/labs-hr-go/pkgs/repos/employeeattendancerepo"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
 
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
 // MockTimeModule is a mock of TimeModule interface.
 type MockTimeModule struct {
 	ctrl     *gomock.Controller
@@ -58,6 +98,60 @@ func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
 }
 
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockEmployeeInfoRepo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockEmployeeInfoRepoMockRecorder) GetForUpdate(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).GetForUpdate), ctx, tx, id)
+}
+
 // MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
 type MockEmployeePositionRepo struct {
 	ctrl     *gomock.Controller
@@ -97,6 +191,21 @@ func (mr *MockEmployeePositionRepoMockRecorder) GetCurrentByEmployeeID(ctx, tx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).GetCurrentByEmployeeID), ctx, tx, employeeID, nowtime)
 }
 
+// ListByEmployeeID mocks base method.
+func (m *MockEmployeePositionRepo) ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeID", ctx, tx, employeeID)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeID indicates an expected call of ListByEmployeeID.
+func (mr *MockEmployeePositionRepoMockRecorder) ListByEmployeeID(ctx, tx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListByEmployeeID), ctx, tx, employeeID)
+}
+
 // MockEmployeeAttendanceRepo is a mock of EmployeeAttendanceRepo interface.
 type MockEmployeeAttendanceRepo struct {
 	ctrl     *gomock.Controller
@@ -121,6 +230,21 @@ func (m *MockEmployeeAttendanceRepo) EXPECT() *MockEmployeeAttendanceRepoMockRec
 	return m.recorder
 }
 
+// AutoCloseOpenBefore mocks base method.
+func (m *MockEmployeeAttendanceRepo) AutoCloseOpenBefore(ctx context.Context, tx *gorm.DB, clockInBefore time.Time, maxShift time.Duration) ([]*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCloseOpenBefore", ctx, tx, clockInBefore, maxShift)
+	ret0, _ := ret[0].([]*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutoCloseOpenBefore indicates an expected call of AutoCloseOpenBefore.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) AutoCloseOpenBefore(ctx, tx, clockInBefore, maxShift any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCloseOpenBefore", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).AutoCloseOpenBefore), ctx, tx, clockInBefore, maxShift)
+}
+
 // CreateForClockIn mocks base method.
 func (m *MockEmployeeAttendanceRepo) CreateForClockIn(ctx context.Context, tx *gorm.DB, employeeID, positionID int64, clockInTime time.Time) (*models.EmployeeAttendance, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +275,21 @@ func (mr *MockEmployeeAttendanceRepoMockRecorder) Last(ctx, tx, employeeID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Last", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).Last), ctx, tx, employeeID)
 }
 
+// List mocks base method.
+func (m *MockEmployeeAttendanceRepo) List(ctx context.Context, tx *gorm.DB, params employeeattendancerepo.ListParams) ([]*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx, params)
+	ret0, _ := ret[0].([]*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) List(ctx, tx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).List), ctx, tx, params)
+}
+
 // UpdateForClockOut mocks base method.
 func (m *MockEmployeeAttendanceRepo) UpdateForClockOut(ctx context.Context, tx *gorm.DB, attendanceID int64, clockOutTime time.Time) (*models.EmployeeAttendance, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +329,20 @@ func (m *MockCacheManager) EXPECT() *MockCacheManagerMockRecorder {
 	return m.recorder
 }
 
+// DeleteAttendanceV1 mocks base method.
+func (m *MockCacheManager) DeleteAttendanceV1(ctx context.Context, employeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendanceV1", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendanceV1 indicates an expected call of DeleteAttendanceV1.
+func (mr *MockCacheManagerMockRecorder) DeleteAttendanceV1(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendanceV1", reflect.TypeOf((*MockCacheManager)(nil).DeleteAttendanceV1), ctx, employeeID)
+}
+
 // GetAttendanceV1 mocks base method.
 func (m *MockCacheManager) GetAttendanceV1(ctx context.Context, employeeID int64) (*dtos.AttendanceV1Response, error) {
 	m.ctrl.T.Helper()
@@ -233,3 +386,39 @@ func (mr *MockCacheManagerMockRecorder) SetAttendanceV1(ctx, employeeID, data, e
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttendanceV1", reflect.TypeOf((*MockCacheManager)(nil).SetAttendanceV1), ctx, employeeID, data, expired)
 }
+
+// MockTaskPool is a mock of TaskPool interface.
+type MockTaskPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskPoolMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskPoolMockRecorder is the mock recorder for MockTaskPool.
+type MockTaskPoolMockRecorder struct {
+	mock *MockTaskPool
+}
+
+// NewMockTaskPool creates a new mock instance.
+func NewMockTaskPool(ctrl *gomock.Controller) *MockTaskPool {
+	mock := &MockTaskPool{ctrl: ctrl}
+	mock.recorder = &MockTaskPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskPool) EXPECT() *MockTaskPoolMockRecorder {
+	return m.recorder
+}
+
+// RunEvery mocks base method.
+func (m *MockTaskPool) RunEvery(interval time.Duration, fn func(context.Context)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunEvery", interval, fn)
+}
+
+// RunEvery indicates an expected call of RunEvery.
+func (mr *MockTaskPoolMockRecorder) RunEvery(interval, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunEvery", reflect.TypeOf((*MockTaskPool)(nil).RunEvery), interval, fn)
+}
@@ -0,0 +1,67 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SweepOpenAttendances auto-closes the sessions open for longer than the
+// maximum shift length and evicts the cached attendance of their employees.
+func (c *Controller) SweepOpenAttendances(ctx context.Context) (int, error) {
+	clockInBefore := c.timeModule.Now().Add(-c.cfg.MaxShiftLength)
+
+	var closed []*models.EmployeeAttendance
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		var err error
+		closed, err = c.employeeAttendanceRepo.AutoCloseOpenBefore(ctx, tx.DB, clockInBefore, c.cfg.MaxShiftLength)
+		if err != nil {
+			return fmt.Errorf("failed to auto-close employee attendance: %w", err)
+		}
+
+		tx.AfterCommit(func() {
+			for _, attendance := range closed {
+				if err := c.cacheManager.DeleteAttendanceV1(ctx, attendance.EmployeeID); err != nil {
+					log.Error().Err(err).Int64("employee_id", attendance.EmployeeID).Msg("Failed to delete attendance from cache")
+				}
+			}
+		})
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	for _, attendance := range closed {
+		log.Info().
+			Int64("employee_id", attendance.EmployeeID).
+			Int64("attendance_id", attendance.ID).
+			Msg("Auto-closed attendance session")
+	}
+
+	return len(closed), nil
+}
+
+// ScheduleSweep sweeps the open sessions at once, then every sweep interval
+// until the task pool shuts down.
+func (c *Controller) ScheduleSweep() {
+	c.taskPool.RunEvery(c.cfg.SweepInterval, func(ctx context.Context) {
+		closed, err := c.SweepOpenAttendances(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Attendance sweep failed")
+			return
+		}
+		if closed > 0 {
+			log.Info().Msgf("Attendance sweep auto-closed %d sessions", closed)
+		}
+	})
+
+	log.Info().
+		Dur("interval", c.cfg.SweepInterval).
+		Dur("max_shift_length", c.cfg.MaxShiftLength).
+		Msg("Scheduled attendance sweep")
+}
@@ -0,0 +1,89 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSweepOpenAttendances(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given sessions left open past the maximum shift length", t, func() {
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+			maxShift := s.controller.cfg.MaxShiftLength
+
+			closed := []*models.EmployeeAttendance{
+				{ID: 1, EmployeeID: 10, ClockIn: nowTime.AddDate(0, 0, -2), Status: models.AttendanceStatusAutoClosed},
+				{ID: 2, EmployeeID: 20, ClockIn: nowTime.AddDate(0, 0, -1), Status: models.AttendanceStatusAutoClosed},
+			}
+
+			Convey("When sweeping", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeAttendanceRepo.EXPECT().
+					AutoCloseOpenBefore(gomock.Any(), gomock.Any(), nowTime.Add(-maxShift), maxShift).
+					Return(closed, nil)
+				s.cacheManager.EXPECT().DeleteAttendanceV1(gomock.Any(), int64(10)).Return(nil)
+				s.cacheManager.EXPECT().DeleteAttendanceV1(gomock.Any(), int64(20)).Return(errors.New("cache error"))
+
+				count, err := s.controller.SweepOpenAttendances(t.Context())
+
+				Convey("Then the sessions should be closed and their cache evicted", func() {
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 2)
+				})
+			})
+
+			Convey("When auto-closing fails", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeAttendanceRepo.EXPECT().
+					AutoCloseOpenBefore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
+
+				count, err := s.controller.SweepOpenAttendances(t.Context())
+
+				Convey("Then no cache should be evicted", func() {
+					So(err, ShouldNotBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When scheduling the sweep", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				// The pool runs the sweep at once
+				s.taskPool.EXPECT().
+					RunEvery(s.controller.cfg.SweepInterval, gomock.Any()).
+					Do(func(_ time.Duration, fn func(ctx context.Context)) {
+						fn(t.Context())
+					})
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				swept := false
+				s.employeeAttendanceRepo.EXPECT().
+					AutoCloseOpenBefore(gomock.Any(), gomock.Any(), nowTime.Add(-maxShift), maxShift).
+					DoAndReturn(func(_, _, _, _ any) ([]*models.EmployeeAttendance, error) {
+						swept = true
+						return nil, nil
+					})
+
+				s.controller.ScheduleSweep()
+
+				Convey("Then the sessions should be swept on the pool", func() {
+					So(swept, ShouldBeTrue)
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,61 @@
+package attendancecorrection
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager                TxManager
+	timeModule               TimeModule
+	employeeInfoRepo         EmployeeInfoRepo
+	employeePositionRepo     EmployeePositionRepo
+	employeeAttendanceRepo   EmployeeAttendanceRepo
+	attendanceCorrectionRepo AttendanceCorrectionRepo
+	departmentRepo           DepartmentRepo
+	cacheManager             CacheManager
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+	employeeAttendanceRepo EmployeeAttendanceRepo,
+	attendanceCorrectionRepo AttendanceCorrectionRepo,
+	departmentRepo DepartmentRepo,
+	cacheManager CacheManager,
+) *Controller {
+	return &Controller{
+		cfg:                      cfg,
+		db:                       db,
+		txManager:                txManager,
+		timeModule:               timeModule,
+		employeeInfoRepo:         employeeInfoRepo,
+		employeePositionRepo:     employeePositionRepo,
+		employeeAttendanceRepo:   employeeAttendanceRepo,
+		attendanceCorrectionRepo: attendanceCorrectionRepo,
+		departmentRepo:           departmentRepo,
+		cacheManager:             cacheManager,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// attendance correction requests
+	r.POST("/attendance/corrections", c.Create)
+	r.GET("/attendance/corrections", c.List)
+	r.GET("/attendance/corrections/:id", c.Get)
+	r.POST("/attendance/corrections/:id/approve", c.Approve)
+	r.POST("/attendance/corrections/:id/reject", c.Reject)
+}
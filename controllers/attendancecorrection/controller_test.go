@@ -0,0 +1,82 @@
+package attendancecorrection
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/sethvargo/go-envconfig"
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type testSuite struct {
+	db     *gorm.DB
+	mockDB sqlmock.Sqlmock
+
+	timeModule               *MockTimeModule
+	employeeInfoRepo         *MockEmployeeInfoRepo
+	employeePositionRepo     *MockEmployeePositionRepo
+	employeeAttendanceRepo   *MockEmployeeAttendanceRepo
+	attendanceCorrectionRepo *MockAttendanceCorrectionRepo
+	departmentRepo           *MockDepartmentRepo
+	cacheManager             *MockCacheManager
+
+	controller *Controller
+	testServer testutils.TestHttpServer
+	faker      *gofakeit.Faker
+}
+
+func testInit(t *testing.T, test func(*testSuite)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gormDB, mockDB := testutils.GetMockDB(t)
+
+	timeModule := NewMockTimeModule(ctrl)
+	employeeInfoRepo := NewMockEmployeeInfoRepo(ctrl)
+	employeePositionRepo := NewMockEmployeePositionRepo(ctrl)
+	employeeAttendanceRepo := NewMockEmployeeAttendanceRepo(ctrl)
+	attendanceCorrectionRepo := NewMockAttendanceCorrectionRepo(ctrl)
+	departmentRepo := NewMockDepartmentRepo(ctrl)
+	cacheManager := NewMockCacheManager(ctrl)
+
+	cfg := Config{}
+	if err := envconfig.Process(t.Context(), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	controller := NewController(
+		cfg,
+		gormDB,
+		txmanager.New(gormDB),
+		timeModule,
+		employeeInfoRepo,
+		employeePositionRepo,
+		employeeAttendanceRepo,
+		attendanceCorrectionRepo,
+		departmentRepo,
+		cacheManager,
+	)
+	testServer := testutils.NewTestHttpServer(controller)
+	faker := gofakeit.New(0)
+	suite := &testSuite{
+		db:                       gormDB,
+		mockDB:                   mockDB,
+		timeModule:               timeModule,
+		employeeInfoRepo:         employeeInfoRepo,
+		employeePositionRepo:     employeePositionRepo,
+		employeeAttendanceRepo:   employeeAttendanceRepo,
+		attendanceCorrectionRepo: attendanceCorrectionRepo,
+		departmentRepo:           departmentRepo,
+		cacheManager:             cacheManager,
+		controller:               controller,
+		testServer:               testServer,
+		faker:                    faker,
+	}
+
+	test(suite)
+}
@@ -0,0 +1,110 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CreateRequest struct {
+	EmployeeID int64 `json:"employee_id" binding:"required"`
+	// AttendanceID is the session to change, omitted to add a missing session
+	AttendanceID *int64 `json:"attendance_id" binding:"omitempty,gt=0"`
+	ClockInTime  string `json:"clock_in_time" binding:"required"`
+	ClockOutTime string `json:"clock_out_time" binding:"required"`
+	Reason       string `json:"reason" binding:"required,max=255"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Create(ctx *gin.Context) {
+	var req CreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	clockIn, err := time.ParseInLocation(timeLayout, req.ClockInTime, time.UTC)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid clock_in_time, expected " + timeLayout})
+		return
+	}
+	clockOut, err := time.ParseInLocation(timeLayout, req.ClockOutTime, time.UTC)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid clock_out_time, expected " + timeLayout})
+		return
+	}
+	if !clockOut.After(clockIn) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "clock_out_time must be after clock_in_time"})
+		return
+	}
+	if clockOut.After(c.timeModule.Now()) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "clock_out_time must not be in the future"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	correction := &models.AttendanceCorrection{
+		EmployeeID:   req.EmployeeID,
+		Kind:         models.AttendanceCorrectionKindAdd,
+		AttendanceID: req.AttendanceID,
+		ClockIn:      clockIn,
+		ClockOut:     clockOut,
+		Reason:       req.Reason,
+		Status:       models.AttendanceCorrectionStatusPending,
+	}
+	if req.AttendanceID != nil {
+		correction.Kind = models.AttendanceCorrectionKindChange
+	}
+
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err := c.employeeInfoRepo.Get(ctx, tx.DB, req.EmployeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee")
+		}
+		if employeeInfo == nil {
+			return utils.NewHttpError(http.StatusNotFound, "employee not found")
+		}
+
+		if req.AttendanceID != nil {
+			attendance, err := c.employeeAttendanceRepo.Get(ctx, tx.DB, *req.AttendanceID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get attendance")
+			}
+			// Employees can only correct their own sessions
+			if attendance == nil || attendance.EmployeeID != req.EmployeeID {
+				return utils.NewHttpError(http.StatusNotFound, "attendance not found")
+			}
+			if attendance.IsOpen() {
+				return utils.NewHttpError(http.StatusConflict, "attendance is still open")
+			}
+
+			pending, err := c.attendanceCorrectionRepo.GetPendingByAttendanceID(ctx, tx.DB, attendance.ID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get pending correction")
+			}
+			if pending != nil {
+				return utils.NewHttpError(http.StatusConflict, "attendance already has a pending correction")
+			}
+		}
+
+		if err := c.attendanceCorrectionRepo.Create(ctx, tx.DB, correction); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create correction")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create correction")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, newAttendanceCorrectionV1Response(correction, nil, utils.GetTimeFormatter(ctx)))
+}
@@ -0,0 +1,172 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with a closed session", t, func() {
+			employeeID := int64(123)
+			attendanceID := int64(789)
+			nowTime := time.Date(2023, 6, 15, 18, 0, 0, 0, time.UTC)
+			clockOutTime := time.Date(2023, 6, 14, 17, 0, 0, 0, time.UTC)
+
+			employeeInfo := &models.EmployeeInfo{
+				ID:   employeeID,
+				Name: "John Doe",
+			}
+			attendance := &models.EmployeeAttendance{
+				ID:         attendanceID,
+				EmployeeID: employeeID,
+				ClockIn:    time.Date(2023, 6, 14, 10, 0, 0, 0, time.UTC),
+				ClockOut:   &clockOutTime,
+				Status:     models.AttendanceStatusClosed,
+			}
+
+			req := CreateRequest{
+				EmployeeID:   employeeID,
+				AttendanceID: lo.ToPtr(attendanceID),
+				ClockInTime:  "2023-06-14 09:00:00",
+				ClockOutTime: "2023-06-14 17:00:00",
+				Reason:       "Forgot to clock in on arrival",
+			}
+
+			Convey("When requesting a change of the session", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), attendanceID).
+					Return(attendance, nil)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetPendingByAttendanceID(gomock.Any(), gomock.Any(), attendanceID).
+					Return(nil, nil)
+				s.attendanceCorrectionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, correction *models.AttendanceCorrection) error {
+						c.So(correction.Kind, ShouldEqual, models.AttendanceCorrectionKindChange)
+						c.So(correction.Status, ShouldEqual, models.AttendanceCorrectionStatusPending)
+						correction.ID = 1
+						return nil
+					})
+
+				var resp dtos.AttendanceCorrectionV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", req, &resp, http.StatusCreated)
+
+				Convey("Then a pending correction should be returned", func() {
+					So(resp.CorrectionID, ShouldEqual, 1)
+					So(resp.Kind, ShouldEqual, models.AttendanceCorrectionKindChange)
+					So(*resp.AttendanceID, ShouldEqual, attendanceID)
+					So(resp.ClockInTime, ShouldEqual, "2023-06-14 09:00:00")
+					So(resp.ClockOutTime, ShouldEqual, "2023-06-14 17:00:00")
+					So(resp.Status, ShouldEqual, models.AttendanceCorrectionStatusPending)
+				})
+			})
+
+			Convey("When requesting a missing session", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.attendanceCorrectionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, correction *models.AttendanceCorrection) error {
+						c.So(correction.Kind, ShouldEqual, models.AttendanceCorrectionKindAdd)
+						c.So(correction.AttendanceID, ShouldBeNil)
+						return nil
+					})
+
+				addReq := req
+				addReq.AttendanceID = nil
+				var resp dtos.AttendanceCorrectionV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", addReq, &resp, http.StatusCreated)
+
+				Convey("Then an add correction should be returned", func() {
+					So(resp.Kind, ShouldEqual, models.AttendanceCorrectionKindAdd)
+					So(resp.AttendanceID, ShouldBeNil)
+				})
+			})
+
+			Convey("When the session already has a pending correction", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), attendanceID).
+					Return(attendance, nil)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetPendingByAttendanceID(gomock.Any(), gomock.Any(), attendanceID).
+					Return(&models.AttendanceCorrection{ID: 1}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "attendance already has a pending correction")
+				})
+			})
+
+			Convey("When the session belongs to another employee", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				other := *attendance
+				other.EmployeeID = employeeID + 1
+				s.employeeAttendanceRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), attendanceID).
+					Return(&other, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", req, nil, http.StatusNotFound)
+			})
+
+			Convey("When clock out is not after clock in", func() {
+				badReq := req
+				badReq.ClockOutTime = badReq.ClockInTime
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", badReq, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "clock_out_time must be after clock_in_time")
+				})
+			})
+
+			Convey("When clock out is in the future", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				badReq := req
+				badReq.ClockOutTime = "2023-06-16 17:00:00"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", badReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the reason is missing", func() {
+				badReq := req
+				badReq.Reason = ""
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Get(ctx *gin.Context) {
+	correctionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	correction, err := c.attendanceCorrectionRepo.Get(ctx, c.db, correctionID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get correction"})
+		return
+	}
+	if correction == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "correction not found"})
+		return
+	}
+
+	// An approved change replaced values that are kept as a revision
+	var revision *models.AttendanceRevision
+	if correction.Kind == models.AttendanceCorrectionKindChange &&
+		correction.Status == models.AttendanceCorrectionStatusApproved {
+		revision, err = c.employeeAttendanceRepo.GetRevisionByCorrectionID(ctx, c.db, correction.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get attendance revision"})
+			return
+		}
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, newAttendanceCorrectionV1Response(correction, revision, utils.GetTimeFormatter(ctx)))
+}
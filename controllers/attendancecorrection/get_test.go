@@ -0,0 +1,69 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an approved change", t, func() {
+			correctionID := int64(1)
+			originalClockOut := time.Date(2023, 6, 14, 17, 0, 0, 0, time.UTC)
+			correction := &models.AttendanceCorrection{
+				ID:           correctionID,
+				EmployeeID:   123,
+				Kind:         models.AttendanceCorrectionKindChange,
+				AttendanceID: lo.ToPtr(int64(789)),
+				ClockIn:      time.Date(2023, 6, 14, 9, 0, 0, 0, time.UTC),
+				ClockOut:     originalClockOut,
+				Status:       models.AttendanceCorrectionStatusApproved,
+				ReviewerID:   lo.ToPtr(int64(7)),
+				ReviewedAt:   lo.ToPtr(time.Date(2023, 6, 15, 9, 0, 0, 0, time.UTC)),
+			}
+
+			Convey("When getting the correction", func() {
+				s.attendanceCorrectionRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), correctionID).
+					Return(correction, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					GetRevisionByCorrectionID(gomock.Any(), gomock.Any(), correctionID).
+					Return(&models.AttendanceRevision{
+						ClockIn:  time.Date(2023, 6, 14, 10, 0, 0, 0, time.UTC),
+						ClockOut: &originalClockOut,
+						Status:   models.AttendanceStatusClosed,
+					}, nil)
+
+				var resp dtos.AttendanceCorrectionV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/corrections/1", nil, &resp, http.StatusOK)
+
+				Convey("Then the original values should be included", func() {
+					So(resp.Status, ShouldEqual, models.AttendanceCorrectionStatusApproved)
+					So(resp.ReviewedAt, ShouldEqual, "2023-06-15 09:00:00")
+					So(resp.Original, ShouldNotBeNil)
+					So(resp.Original.ClockInTime, ShouldEqual, "2023-06-14 10:00:00")
+					So(resp.Original.ClockOutTime, ShouldEqual, "2023-06-14 17:00:00")
+				})
+			})
+
+			Convey("When the correction does not exist", func() {
+				s.attendanceCorrectionRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(2)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/corrections/2", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/corrections/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
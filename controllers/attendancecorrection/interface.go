@@ -0,0 +1,54 @@
+package attendancecorrection
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/attendancecorrectionrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=attendancecorrection
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type EmployeeInfoRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error)
+}
+
+type EmployeeAttendanceRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeAttendance, error)
+	GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeAttendance, error)
+	Create(ctx context.Context, tx *gorm.DB, data *models.EmployeeAttendance) error
+	ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error)
+	Correct(ctx context.Context, tx *gorm.DB, employeeAttendance *models.EmployeeAttendance, correctionID int64, clockInTime time.Time, clockOutTime time.Time) (*models.AttendanceRevision, error)
+	GetRevisionByCorrectionID(ctx context.Context, tx *gorm.DB, correctionID int64) (*models.AttendanceRevision, error)
+}
+
+type AttendanceCorrectionRepo interface {
+	Create(ctx context.Context, tx *gorm.DB, data *models.AttendanceCorrection) error
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.AttendanceCorrection, error)
+	GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.AttendanceCorrection, error)
+	GetPendingByAttendanceID(ctx context.Context, tx *gorm.DB, attendanceID int64) (*models.AttendanceCorrection, error)
+	List(ctx context.Context, tx *gorm.DB, params attendancecorrectionrepo.ListParams) ([]*models.AttendanceCorrection, error)
+	Save(ctx context.Context, tx *gorm.DB, data *models.AttendanceCorrection) error
+}
+
+type DepartmentRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+}
+
+type CacheManager interface {
+	DeleteAttendanceV1(ctx context.Context, employeeID int64) error
+}
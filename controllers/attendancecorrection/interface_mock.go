@@ -0,0 +1,478 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=attendancecorrection
+//
+
+// Package attendancecorrection is a generated GoMock package.
+package attendancecorrection
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	attendancecorrectionrepo "github.com/WangWilly/labs-hr-go/pkgs/repos/attendancecorrectionrepo"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetCurrentByEmployeeID mocks base method.
+func (m *MockEmployeePositionRepo) GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentByEmployeeID", ctx, tx, employeeID, nowtime)
+	ret0, _ := ret[0].(*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentByEmployeeID indicates an expected call of GetCurrentByEmployeeID.
+func (mr *MockEmployeePositionRepoMockRecorder) GetCurrentByEmployeeID(ctx, tx, employeeID, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).GetCurrentByEmployeeID), ctx, tx, employeeID, nowtime)
+}
+
+// MockEmployeeAttendanceRepo is a mock of EmployeeAttendanceRepo interface.
+type MockEmployeeAttendanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeAttendanceRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeAttendanceRepoMockRecorder is the mock recorder for MockEmployeeAttendanceRepo.
+type MockEmployeeAttendanceRepoMockRecorder struct {
+	mock *MockEmployeeAttendanceRepo
+}
+
+// NewMockEmployeeAttendanceRepo creates a new mock instance.
+func NewMockEmployeeAttendanceRepo(ctrl *gomock.Controller) *MockEmployeeAttendanceRepo {
+	mock := &MockEmployeeAttendanceRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeAttendanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeAttendanceRepo) EXPECT() *MockEmployeeAttendanceRepoMockRecorder {
+	return m.recorder
+}
+
+// Correct mocks base method.
+func (m *MockEmployeeAttendanceRepo) Correct(ctx context.Context, tx *gorm.DB, employeeAttendance *models.EmployeeAttendance, correctionID int64, clockInTime, clockOutTime time.Time) (*models.AttendanceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Correct", ctx, tx, employeeAttendance, correctionID, clockInTime, clockOutTime)
+	ret0, _ := ret[0].(*models.AttendanceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Correct indicates an expected call of Correct.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) Correct(ctx, tx, employeeAttendance, correctionID, clockInTime, clockOutTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Correct", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).Correct), ctx, tx, employeeAttendance, correctionID, clockInTime, clockOutTime)
+}
+
+// Create mocks base method.
+func (m *MockEmployeeAttendanceRepo) Create(ctx context.Context, tx *gorm.DB, data *models.EmployeeAttendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) Create(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).Create), ctx, tx, data)
+}
+
+// Get mocks base method.
+func (m *MockEmployeeAttendanceRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockEmployeeAttendanceRepo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) GetForUpdate(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).GetForUpdate), ctx, tx, id)
+}
+
+// GetRevisionByCorrectionID mocks base method.
+func (m *MockEmployeeAttendanceRepo) GetRevisionByCorrectionID(ctx context.Context, tx *gorm.DB, correctionID int64) (*models.AttendanceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionByCorrectionID", ctx, tx, correctionID)
+	ret0, _ := ret[0].(*models.AttendanceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionByCorrectionID indicates an expected call of GetRevisionByCorrectionID.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) GetRevisionByCorrectionID(ctx, tx, correctionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionByCorrectionID", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).GetRevisionByCorrectionID), ctx, tx, correctionID)
+}
+
+// ListByEmployeeIDs mocks base method.
+func (m *MockEmployeeAttendanceRepo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeIDs", ctx, tx, employeeIDs, from, to)
+	ret0, _ := ret[0].([]*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeIDs indicates an expected call of ListByEmployeeIDs.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) ListByEmployeeIDs(ctx, tx, employeeIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeIDs", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).ListByEmployeeIDs), ctx, tx, employeeIDs, from, to)
+}
+
+// MockAttendanceCorrectionRepo is a mock of AttendanceCorrectionRepo interface.
+type MockAttendanceCorrectionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendanceCorrectionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockAttendanceCorrectionRepoMockRecorder is the mock recorder for MockAttendanceCorrectionRepo.
+type MockAttendanceCorrectionRepoMockRecorder struct {
+	mock *MockAttendanceCorrectionRepo
+}
+
+// NewMockAttendanceCorrectionRepo creates a new mock instance.
+func NewMockAttendanceCorrectionRepo(ctrl *gomock.Controller) *MockAttendanceCorrectionRepo {
+	mock := &MockAttendanceCorrectionRepo{ctrl: ctrl}
+	mock.recorder = &MockAttendanceCorrectionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendanceCorrectionRepo) EXPECT() *MockAttendanceCorrectionRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAttendanceCorrectionRepo) Create(ctx context.Context, tx *gorm.DB, data *models.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttendanceCorrectionRepoMockRecorder) Create(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendanceCorrectionRepo)(nil).Create), ctx, tx, data)
+}
+
+// Get mocks base method.
+func (m *MockAttendanceCorrectionRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAttendanceCorrectionRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAttendanceCorrectionRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockAttendanceCorrectionRepo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*models.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockAttendanceCorrectionRepoMockRecorder) GetForUpdate(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockAttendanceCorrectionRepo)(nil).GetForUpdate), ctx, tx, id)
+}
+
+// GetPendingByAttendanceID mocks base method.
+func (m *MockAttendanceCorrectionRepo) GetPendingByAttendanceID(ctx context.Context, tx *gorm.DB, attendanceID int64) (*models.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByAttendanceID", ctx, tx, attendanceID)
+	ret0, _ := ret[0].(*models.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByAttendanceID indicates an expected call of GetPendingByAttendanceID.
+func (mr *MockAttendanceCorrectionRepoMockRecorder) GetPendingByAttendanceID(ctx, tx, attendanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByAttendanceID", reflect.TypeOf((*MockAttendanceCorrectionRepo)(nil).GetPendingByAttendanceID), ctx, tx, attendanceID)
+}
+
+// List mocks base method.
+func (m *MockAttendanceCorrectionRepo) List(ctx context.Context, tx *gorm.DB, params attendancecorrectionrepo.ListParams) ([]*models.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx, params)
+	ret0, _ := ret[0].([]*models.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttendanceCorrectionRepoMockRecorder) List(ctx, tx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttendanceCorrectionRepo)(nil).List), ctx, tx, params)
+}
+
+// Save mocks base method.
+func (m *MockAttendanceCorrectionRepo) Save(ctx context.Context, tx *gorm.DB, data *models.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAttendanceCorrectionRepoMockRecorder) Save(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAttendanceCorrectionRepo)(nil).Save), ctx, tx, data)
+}
+
+// MockDepartmentRepo is a mock of DepartmentRepo interface.
+type MockDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepoMockRecorder is the mock recorder for MockDepartmentRepo.
+type MockDepartmentRepoMockRecorder struct {
+	mock *MockDepartmentRepo
+}
+
+// NewMockDepartmentRepo creates a new mock instance.
+func NewMockDepartmentRepo(ctrl *gomock.Controller) *MockDepartmentRepo {
+	mock := &MockDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepo) EXPECT() *MockDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDepartmentRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepartmentRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepartmentRepo)(nil).Get), ctx, tx, id)
+}
+
+// MockCacheManager is a mock of CacheManager interface.
+type MockCacheManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCacheManagerMockRecorder is the mock recorder for MockCacheManager.
+type MockCacheManagerMockRecorder struct {
+	mock *MockCacheManager
+}
+
+// NewMockCacheManager creates a new mock instance.
+func NewMockCacheManager(ctrl *gomock.Controller) *MockCacheManager {
+	mock := &MockCacheManager{ctrl: ctrl}
+	mock.recorder = &MockCacheManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheManager) EXPECT() *MockCacheManagerMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttendanceV1 mocks base method.
+func (m *MockCacheManager) DeleteAttendanceV1(ctx context.Context, employeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendanceV1", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendanceV1 indicates an expected call of DeleteAttendanceV1.
+func (mr *MockCacheManagerMockRecorder) DeleteAttendanceV1(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendanceV1", reflect.TypeOf((*MockCacheManager)(nil).DeleteAttendanceV1), ctx, employeeID)
+}
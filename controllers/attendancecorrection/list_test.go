@@ -0,0 +1,53 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/attendancecorrectionrepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given pending corrections", t, func() {
+			corrections := []*models.AttendanceCorrection{
+				models.DummyAttendanceCorrection(s.faker),
+				models.DummyAttendanceCorrection(s.faker),
+				models.DummyAttendanceCorrection(s.faker),
+			}
+			for i, correction := range corrections {
+				correction.ID = int64(i + 1)
+			}
+
+			Convey("When listing a page smaller than the results", func(c C) {
+				s.attendanceCorrectionRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, params attendancecorrectionrepo.ListParams) ([]*models.AttendanceCorrection, error) {
+						c.So(params.Status, ShouldEqual, models.AttendanceCorrectionStatusPending)
+						c.So(params.Limit, ShouldEqual, 3)
+						return corrections, nil
+					})
+
+				var resp ListResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/corrections?status=pending&limit=2", nil, &resp, http.StatusOK)
+
+				Convey("Then a cursor to the next page should be returned", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.NextCursor, ShouldEqual, utils.EncodeCursor(utils.Cursor{ID: 2}))
+				})
+			})
+
+			Convey("When the status is unknown", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/corrections?status=done", nil, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the cursor is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/attendance/corrections?cursor=!!", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
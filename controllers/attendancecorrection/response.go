@@ -0,0 +1,50 @@
+package attendancecorrection
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// timeLayout is the layout of the requested times, the one of the responses
+const timeLayout = "2006-01-02 15:04:05"
+
+func newAttendanceCorrectionV1Response(
+	correction *models.AttendanceCorrection,
+	revision *models.AttendanceRevision,
+	formatter utils.TimeFormatter,
+) dtos.AttendanceCorrectionV1Response {
+	resp := dtos.AttendanceCorrectionV1Response{
+		CorrectionID: correction.ID,
+		EmployeeID:   correction.EmployeeID,
+		Kind:         correction.Kind,
+		AttendanceID: correction.AttendanceID,
+		ClockInTime:  formatter.Time(correction.ClockIn),
+		ClockOutTime: formatter.Time(correction.ClockOut),
+		Reason:       correction.Reason,
+		Status:       correction.Status,
+		ReviewerID:   correction.ReviewerID,
+		ReviewNote:   correction.ReviewNote,
+		CreatedAt:    formatter.Time(correction.CreatedAt),
+	}
+	if correction.ReviewedAt != nil {
+		resp.ReviewedAt = formatter.Time(*correction.ReviewedAt)
+	}
+
+	if revision != nil {
+		clockOutTime := ""
+		if revision.ClockOut != nil {
+			clockOutTime = formatter.Time(*revision.ClockOut)
+		}
+		resp.Original = &dtos.AttendanceRevisionV1Response{
+			ClockInTime:  formatter.Time(revision.ClockIn),
+			ClockOutTime: clockOutTime,
+			Status:       revision.Status,
+			RevisedAt:    formatter.Time(revision.CreatedAt),
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,194 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/approval"
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ReviewRequest struct {
+	ReviewerID int64  `json:"reviewer_id" binding:"required"`
+	Note       string `json:"note" binding:"max=255"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Approve applies the requested times to the attendance, keeping the replaced
+// values as a revision.
+func (c *Controller) Approve(ctx *gin.Context) {
+	c.review(ctx, models.AttendanceCorrectionStatusApproved)
+}
+
+// Reject closes the correction without touching the attendance.
+func (c *Controller) Reject(ctx *gin.Context) {
+	c.review(ctx, models.AttendanceCorrectionStatusRejected)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) review(ctx *gin.Context, status string) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	correctionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ReviewRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var resp dtos.AttendanceCorrectionV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		// Lock the correction so it is reviewed only once
+		correction, err := c.attendanceCorrectionRepo.GetForUpdate(ctx, tx.DB, correctionID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get correction")
+		}
+		if correction == nil {
+			return utils.NewHttpError(http.StatusNotFound, "correction not found")
+		}
+		if correction.Status != models.AttendanceCorrectionStatusPending {
+			return utils.NewHttpError(http.StatusConflict, "correction already reviewed")
+		}
+
+		nowTime := c.timeModule.Now()
+		if err := c.checkReviewer(ctx, tx.DB, correction.EmployeeID, req.ReviewerID, nowTime); err != nil {
+			return err
+		}
+
+		var revision *models.AttendanceRevision
+		if status == models.AttendanceCorrectionStatusApproved {
+			revision, err = c.applyCorrection(ctx, tx.DB, correction)
+			if err != nil {
+				return err
+			}
+
+			tx.AfterCommit(func() {
+				if err := c.cacheManager.DeleteAttendanceV1(ctx, correction.EmployeeID); err != nil {
+					logger.Error().Err(err).Msg("Failed to delete attendance from cache")
+				}
+			})
+		}
+
+		correction.Status = status
+		correction.ReviewerID = &req.ReviewerID
+		correction.ReviewNote = req.Note
+		correction.ReviewedAt = &nowTime
+		if err := c.attendanceCorrectionRepo.Save(ctx, tx.DB, correction); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save correction")
+		}
+
+		resp = newAttendanceCorrectionV1Response(correction, revision, utils.GetTimeFormatter(ctx))
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to review correction")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// checkReviewer allows the heads of the employee's current department and of
+// its ancestors to review, never the employee themself.
+func (c *Controller) checkReviewer(ctx *gin.Context, tx *gorm.DB, employeeID int64, reviewerID int64, nowTime time.Time) error {
+	if reviewerID == employeeID {
+		return utils.NewHttpError(http.StatusForbidden, "employees cannot review their own corrections")
+	}
+
+	isManager, err := approval.IsManager(ctx, tx, c.employeePositionRepo, c.departmentRepo, employeeID, reviewerID, nowTime)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to check reviewer")
+	}
+	if !isManager {
+		return utils.NewHttpError(http.StatusForbidden, "reviewer is not a manager of the employee")
+	}
+
+	return nil
+}
+
+// applyCorrection writes the requested times to the attendance. A change
+// returns the revision holding the replaced values.
+func (c *Controller) applyCorrection(ctx *gin.Context, tx *gorm.DB, correction *models.AttendanceCorrection) (*models.AttendanceRevision, error) {
+	// Sessions must not overlap, whatever was recorded since the request
+	attendances, err := c.employeeAttendanceRepo.ListByEmployeeIDs(
+		ctx,
+		tx,
+		[]int64{correction.EmployeeID},
+		correction.ClockIn,
+		correction.ClockOut,
+	)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to list attendances")
+	}
+	for _, attendance := range attendances {
+		if attendance.Status == models.AttendanceStatusVoided {
+			continue
+		}
+		if correction.AttendanceID != nil && attendance.ID == *correction.AttendanceID {
+			continue
+		}
+		return nil, utils.NewHttpError(http.StatusConflict, "correction overlaps another attendance")
+	}
+
+	if correction.Kind == models.AttendanceCorrectionKindChange {
+		attendance, err := c.employeeAttendanceRepo.GetForUpdate(ctx, tx, *correction.AttendanceID)
+		if err != nil {
+			return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to get attendance")
+		}
+		if attendance == nil {
+			return nil, utils.NewHttpError(http.StatusConflict, "attendance no longer exists")
+		}
+		revision, err := c.employeeAttendanceRepo.Correct(ctx, tx, attendance, correction.ID, correction.ClockIn, correction.ClockOut)
+		if err != nil {
+			return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to correct attendance")
+		}
+		return revision, nil
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	// A missing session belongs to the position held when it started
+	position, err := c.employeePositionRepo.GetCurrentByEmployeeID(ctx, tx, correction.EmployeeID, correction.ClockIn)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to get employee position")
+	}
+	if position == nil {
+		return nil, utils.NewHttpError(http.StatusConflict, "employee had no position at clock_in_time")
+	}
+
+	clockOut := correction.ClockOut
+	attendance := &models.EmployeeAttendance{
+		EmployeeID: correction.EmployeeID,
+		PositionID: position.ID,
+		ClockIn:    correction.ClockIn,
+		ClockOut:   &clockOut,
+		Status:     models.AttendanceStatusClosed,
+	}
+	if err := c.employeeAttendanceRepo.Create(ctx, tx, attendance); err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to create attendance")
+	}
+	correction.AttendanceID = &attendance.ID
+
+	return nil, nil
+}
@@ -0,0 +1,273 @@
+package attendancecorrection
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReview(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a pending correction", t, func() {
+			employeeID := int64(123)
+			headID := int64(7)
+			directorID := int64(8)
+			attendanceID := int64(789)
+			nowTime := time.Date(2023, 6, 15, 9, 0, 0, 0, time.UTC)
+			clockInTime := time.Date(2023, 6, 14, 9, 0, 0, 0, time.UTC)
+			clockOutTime := time.Date(2023, 6, 14, 17, 0, 0, 0, time.UTC)
+
+			newCorrection := func(kind string) *models.AttendanceCorrection {
+				correction := &models.AttendanceCorrection{
+					ID:         1,
+					EmployeeID: employeeID,
+					Kind:       kind,
+					ClockIn:    clockInTime,
+					ClockOut:   clockOutTime,
+					Reason:     "Forgot to clock in on arrival",
+					Status:     models.AttendanceCorrectionStatusPending,
+				}
+				if kind == models.AttendanceCorrectionKindChange {
+					correction.AttendanceID = lo.ToPtr(attendanceID)
+				}
+				return correction
+			}
+			position := &models.EmployeePosition{
+				ID:           456,
+				EmployeeID:   employeeID,
+				DepartmentID: 10,
+			}
+			team := &models.Department{ID: 10, ParentID: lo.ToPtr(int64(1)), HeadEmployeeID: lo.ToPtr(headID)}
+			division := &models.Department{ID: 1, HeadEmployeeID: lo.ToPtr(directorID)}
+
+			expectManagers := func() {
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
+					Return(position, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(10)).
+					Return(team, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(division, nil).
+					AnyTimes()
+			}
+
+			Convey("When the head of a parent department approves a change", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				correction := newCorrection(models.AttendanceCorrectionKindChange)
+				attendance := &models.EmployeeAttendance{
+					ID:         attendanceID,
+					EmployeeID: employeeID,
+					ClockIn:    clockInTime.Add(time.Hour),
+					ClockOut:   &clockOutTime,
+					Status:     models.AttendanceStatusClosed,
+				}
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(correction, nil)
+				expectManagers()
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}, clockInTime, clockOutTime).
+					Return([]*models.EmployeeAttendance{attendance}, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), attendanceID).
+					Return(attendance, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					Correct(gomock.Any(), gomock.Any(), attendance, int64(1), clockInTime, clockOutTime).
+					Return(&models.AttendanceRevision{
+						AttendanceID: attendanceID,
+						CorrectionID: 1,
+						ClockIn:      attendance.ClockIn,
+						ClockOut:     &clockOutTime,
+						Status:       models.AttendanceStatusClosed,
+						CreatedAt:    nowTime,
+					}, nil)
+				s.attendanceCorrectionRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, saved *models.AttendanceCorrection) error {
+						c.So(saved.Status, ShouldEqual, models.AttendanceCorrectionStatusApproved)
+						c.So(*saved.ReviewerID, ShouldEqual, directorID)
+						return nil
+					})
+				s.cacheManager.EXPECT().
+					DeleteAttendanceV1(gomock.Any(), employeeID).
+					Return(nil)
+
+				var resp dtos.AttendanceCorrectionV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", ReviewRequest{
+					ReviewerID: directorID,
+					Note:       "ok",
+				}, &resp, http.StatusOK)
+
+				Convey("Then the correction should be approved with the original values", func() {
+					So(resp.Status, ShouldEqual, models.AttendanceCorrectionStatusApproved)
+					So(resp.ReviewNote, ShouldEqual, "ok")
+					So(resp.ReviewedAt, ShouldEqual, "2023-06-15 09:00:00")
+					So(resp.Original, ShouldNotBeNil)
+					So(resp.Original.ClockInTime, ShouldEqual, "2023-06-14 10:00:00")
+				})
+			})
+
+			Convey("When the department head approves a missing session", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				correction := newCorrection(models.AttendanceCorrectionKindAdd)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(correction, nil)
+				expectManagers()
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}, clockInTime, clockOutTime).
+					Return(nil, nil)
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, clockInTime).
+					Return(position, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, attendance *models.EmployeeAttendance) error {
+						attendance.ID = attendanceID
+						return nil
+					})
+				s.attendanceCorrectionRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				s.cacheManager.EXPECT().
+					DeleteAttendanceV1(gomock.Any(), employeeID).
+					Return(nil)
+
+				var resp dtos.AttendanceCorrectionV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", ReviewRequest{
+					ReviewerID: headID,
+				}, &resp, http.StatusOK)
+
+				Convey("Then the created session should be linked", func() {
+					So(*resp.AttendanceID, ShouldEqual, attendanceID)
+					So(resp.Original, ShouldBeNil)
+				})
+			})
+
+			Convey("When the missing session overlaps another one", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(newCorrection(models.AttendanceCorrectionKindAdd), nil)
+				expectManagers()
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}, clockInTime, clockOutTime).
+					Return([]*models.EmployeeAttendance{{ID: attendanceID, Status: models.AttendanceStatusClosed}}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", ReviewRequest{
+					ReviewerID: headID,
+				}, &errorResponse, http.StatusConflict)
+
+				Convey("Then the approval should be refused", func() {
+					So(errorResponse["error"], ShouldEqual, "correction overlaps another attendance")
+				})
+			})
+
+			Convey("When the department head rejects it", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(newCorrection(models.AttendanceCorrectionKindChange), nil)
+				expectManagers()
+				s.attendanceCorrectionRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, saved *models.AttendanceCorrection) error {
+						c.So(saved.Status, ShouldEqual, models.AttendanceCorrectionStatusRejected)
+						return nil
+					})
+
+				var resp dtos.AttendanceCorrectionV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/reject", ReviewRequest{
+					ReviewerID: headID,
+					Note:       "The badge log shows 10:00",
+				}, &resp, http.StatusOK)
+
+				Convey("Then the attendance should be left untouched", func() {
+					So(resp.Status, ShouldEqual, models.AttendanceCorrectionStatusRejected)
+					So(resp.ReviewNote, ShouldEqual, "The badge log shows 10:00")
+				})
+			})
+
+			Convey("When the employee reviews their own correction", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(newCorrection(models.AttendanceCorrectionKindChange), nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", ReviewRequest{
+					ReviewerID: employeeID,
+				}, nil, http.StatusForbidden)
+			})
+
+			Convey("When the reviewer does not manage the employee", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(newCorrection(models.AttendanceCorrectionKindChange), nil)
+				expectManagers()
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", ReviewRequest{
+					ReviewerID: 999,
+				}, &errorResponse, http.StatusForbidden)
+
+				Convey("Then the review should be refused", func() {
+					So(errorResponse["error"], ShouldEqual, "reviewer is not a manager of the employee")
+				})
+			})
+
+			Convey("When the correction was already reviewed", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				correction := newCorrection(models.AttendanceCorrectionKindChange)
+				correction.Status = models.AttendanceCorrectionStatusRejected
+				s.attendanceCorrectionRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(correction, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", ReviewRequest{
+					ReviewerID: headID,
+				}, &errorResponse, http.StatusConflict)
+
+				Convey("Then the review should be refused", func() {
+					So(errorResponse["error"], ShouldEqual, "correction already reviewed")
+				})
+			})
+
+			Convey("When the reviewer is missing", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/attendance/corrections/1/approve", map[string]any{}, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package department
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager              TxManager
+	timeModule             TimeModule
+	departmentRepo         DepartmentRepo
+	employeeInfoRepo       EmployeeInfoRepo
+	employeePositionRepo   EmployeePositionRepo
+	employeeAttendanceRepo EmployeeAttendanceRepo
+	cacheManager           CacheManager
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	departmentRepo DepartmentRepo,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+	employeeAttendanceRepo EmployeeAttendanceRepo,
+	cacheManager CacheManager,
+) *Controller {
+	return &Controller{
+		cfg:                    cfg,
+		db:                     db,
+		txManager:              txManager,
+		timeModule:             timeModule,
+		departmentRepo:         departmentRepo,
+		employeeInfoRepo:       employeeInfoRepo,
+		employeePositionRepo:   employeePositionRepo,
+		employeeAttendanceRepo: employeeAttendanceRepo,
+		cacheManager:           cacheManager,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// department management
+	r.POST("/department", c.Create)
+	r.GET("/department", c.List)
+	r.GET("/department/:id", c.Get)
+	r.PUT("/department/:id", c.Update)
+	r.DELETE("/department/:id", c.Delete)
+
+	////////////////////////////////////////////////////////////////////////////
+	// attendance reporting
+	r.GET("/department/:id/timesheet", c.Timesheet)
+}
@@ -0,0 +1,120 @@
+package department
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CreateRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+	// ParentID is omitted for a top level department
+	ParentID       *int64 `json:"parent_id"        binding:"omitempty,gt=0"`
+	HeadEmployeeID *int64 `json:"head_employee_id" binding:"omitempty,gt=0"`
+}
+
+type CreateResponse = GetResponse
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Create(ctx *gin.Context) {
+	var req CreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+
+	////////////////////////////////////////////////////////////////////////////
+
+	department := &models.Department{
+		Name:           req.Name,
+		ParentID:       req.ParentID,
+		HeadEmployeeID: req.HeadEmployeeID,
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if err := c.checkDepartment(ctx, tx.DB, department); err != nil {
+			return err
+		}
+
+		if err := c.departmentRepo.Create(ctx, tx.DB, department); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create department")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create department")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, newGetResponse(department, nil, utils.GetTimeFormatter(ctx)))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// checkDepartment validates the name, parent and head of a department about
+// to be written. The returned error is an HttpError meant for the client.
+func (c *Controller) checkDepartment(ctx context.Context, tx *gorm.DB, department *models.Department) error {
+	// Names are unique regardless of case
+	existing, err := c.departmentRepo.GetByName(ctx, tx, department.Name)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
+	}
+	if existing != nil && existing.ID != department.ID {
+		return utils.NewHttpError(http.StatusConflict, "department already exists")
+	}
+
+	if department.ParentID != nil {
+		if err := c.checkParent(ctx, tx, department.ID, *department.ParentID); err != nil {
+			return err
+		}
+	}
+
+	if department.HeadEmployeeID != nil {
+		head, err := c.employeeInfoRepo.Get(ctx, tx, *department.HeadEmployeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get head employee")
+		}
+		if head == nil {
+			return utils.NewHttpError(http.StatusBadRequest, "head employee not found")
+		}
+	}
+
+	return nil
+}
+
+// checkParent walks up from the parent to make sure the hierarchy stays a
+// tree. departmentID is zero for a department that does not exist yet.
+func (c *Controller) checkParent(ctx context.Context, tx *gorm.DB, departmentID int64, parentID int64) error {
+	visited := map[int64]bool{}
+	for ancestorID := &parentID; ancestorID != nil; {
+		if *ancestorID == departmentID {
+			return utils.NewHttpError(http.StatusBadRequest, "department cannot be nested under itself")
+		}
+		if visited[*ancestorID] {
+			// An existing cycle, refuse to attach anything to it
+			return utils.NewHttpError(http.StatusConflict, "department hierarchy contains a cycle")
+		}
+		visited[*ancestorID] = true
+
+		ancestor, err := c.departmentRepo.Get(ctx, tx, *ancestorID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get parent department")
+		}
+		if ancestor == nil {
+			return utils.NewHttpError(http.StatusBadRequest, "parent department not found")
+		}
+		ancestorID = ancestor.ParentID
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package department
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a create department request", t, func() {
+			req := CreateRequest{
+				Name:           " Platform ",
+				ParentID:       lo.ToPtr(int64(1)),
+				HeadEmployeeID: lo.ToPtr(int64(7)),
+			}
+
+			Convey("When creating a department under an existing parent", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.departmentRepo.EXPECT().
+					GetByName(gomock.Any(), gomock.Any(), "Platform").
+					Return(nil, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&models.Department{ID: 1, Name: "Engineering"}, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(7)).
+					Return(&models.EmployeeInfo{ID: 7}, nil)
+				s.departmentRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, department *models.Department) error {
+						department.ID = 2
+						return nil
+					})
+
+				var actualResponse CreateResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/department",
+					req,
+					&actualResponse,
+					http.StatusCreated,
+				)
+
+				Convey("Then the response should contain the department", func() {
+					So(actualResponse.DepartmentID, ShouldEqual, 2)
+					So(actualResponse.Name, ShouldEqual, "Platform")
+					So(*actualResponse.ParentID, ShouldEqual, 1)
+					So(*actualResponse.HeadEmployeeID, ShouldEqual, 7)
+					So(actualResponse.Children, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the name is already taken", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().
+					GetByName(gomock.Any(), gomock.Any(), "Platform").
+					Return(&models.Department{ID: 3, Name: "platform"}, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/department",
+					req,
+					&actualResponse,
+					http.StatusConflict,
+				)
+
+				Convey("Then the response should indicate a conflict", func() {
+					So(actualResponse["error"], ShouldEqual, "department already exists")
+				})
+			})
+
+			Convey("When the parent does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().
+					GetByName(gomock.Any(), gomock.Any(), "Platform").
+					Return(nil, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(nil, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/department",
+					req,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate the missing parent", func() {
+					So(actualResponse["error"], ShouldEqual, "parent department not found")
+				})
+			})
+
+			Convey("When the head employee does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().
+					GetByName(gomock.Any(), gomock.Any(), "Platform").
+					Return(nil, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&models.Department{ID: 1, Name: "Engineering"}, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(7)).
+					Return(nil, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/department",
+					req,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate the missing head", func() {
+					So(actualResponse["error"], ShouldEqual, "head employee not found")
+				})
+			})
+
+			Convey("When the name is missing", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/department",
+					CreateRequest{},
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate a validation error", func() {
+					So(actualResponse["error"], ShouldNotBeEmpty)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,71 @@
+package department
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type DeleteResponse struct {
+	DepartmentID int64 `json:"department_id"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	// Convert id to int64
+	departmentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		department, err := c.departmentRepo.Get(ctx, tx.DB, departmentID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
+		}
+		if department == nil {
+			return utils.NewHttpError(http.StatusNotFound, "department not found")
+		}
+
+		// Positions keep referencing their department for the history, so
+		// only a department nobody ever worked in can be deleted
+		children, err := c.departmentRepo.ListByParentID(ctx, tx.DB, departmentID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list child departments")
+		}
+		if len(children) > 0 {
+			return utils.NewHttpError(http.StatusConflict, "department has child departments")
+		}
+		employeeIDs, err := c.employeePositionRepo.ListEmployeeIDsByDepartmentID(ctx, tx.DB, departmentID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list department employees")
+		}
+		if len(employeeIDs) > 0 {
+			return utils.NewHttpError(http.StatusConflict, "department has employee positions")
+		}
+
+		if _, err := c.departmentRepo.Delete(ctx, tx.DB, departmentID); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to delete department")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to delete department")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, DeleteResponse{
+		DepartmentID: departmentID,
+	})
+}
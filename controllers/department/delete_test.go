@@ -0,0 +1,82 @@
+package department
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDelete(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an existing department", t, func() {
+			department := &models.Department{ID: 2, Name: "Platform"}
+
+			Convey("When deleting an unused department", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(2)).Return(department, nil)
+				s.departmentRepo.EXPECT().ListByParentID(gomock.Any(), gomock.Any(), int64(2)).Return(nil, nil)
+				s.employeePositionRepo.EXPECT().ListEmployeeIDsByDepartmentID(gomock.Any(), gomock.Any(), int64(2)).Return(nil, nil)
+				s.departmentRepo.EXPECT().Delete(gomock.Any(), gomock.Any(), int64(2)).Return(true, nil)
+
+				var actualResponse DeleteResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/department/2", nil, &actualResponse, http.StatusOK)
+
+				Convey("Then the response should contain the department ID", func() {
+					So(actualResponse.DepartmentID, ShouldEqual, 2)
+				})
+			})
+
+			Convey("When the department has sub-departments", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(2)).Return(department, nil)
+				s.departmentRepo.EXPECT().
+					ListByParentID(gomock.Any(), gomock.Any(), int64(2)).
+					Return([]*models.Department{{ID: 3, Name: "SRE"}}, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/department/2", nil, &actualResponse, http.StatusConflict)
+
+				Convey("Then the response should indicate a conflict", func() {
+					So(actualResponse["error"], ShouldEqual, "department has child departments")
+				})
+			})
+
+			Convey("When employees held positions in the department", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(2)).Return(department, nil)
+				s.departmentRepo.EXPECT().ListByParentID(gomock.Any(), gomock.Any(), int64(2)).Return(nil, nil)
+				s.employeePositionRepo.EXPECT().ListEmployeeIDsByDepartmentID(gomock.Any(), gomock.Any(), int64(2)).Return([]int64{4}, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/department/2", nil, &actualResponse, http.StatusConflict)
+
+				Convey("Then the response should indicate a conflict", func() {
+					So(actualResponse["error"], ShouldEqual, "department has employee positions")
+				})
+			})
+
+			Convey("When the department does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(9)).Return(nil, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/department/9", nil, &actualResponse, http.StatusNotFound)
+
+				Convey("Then the response should indicate not found", func() {
+					So(actualResponse["error"], ShouldEqual, "department not found")
+				})
+			})
+		})
+	})
+}
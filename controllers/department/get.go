@@ -0,0 +1,52 @@
+package department
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type GetResponse struct {
+	dtos.DepartmentV1Response
+	// Children are the direct sub-departments
+	Children []dtos.DepartmentV1Response `json:"children"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Get(ctx *gin.Context) {
+	id := ctx.Param("id")
+	// Convert id to int64
+	departmentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	department, err := c.departmentRepo.Get(ctx, c.db, departmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get department"})
+		return
+	}
+	if department == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "department not found"})
+		return
+	}
+
+	children, err := c.departmentRepo.ListByParentID(ctx, c.db, departmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list child departments"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, newGetResponse(department, children, utils.GetTimeFormatter(ctx)))
+}
@@ -0,0 +1,72 @@
+package department
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a department with sub-departments", t, func() {
+			department := &models.Department{ID: 1, Name: "Engineering", HeadEmployeeID: lo.ToPtr(int64(7))}
+			children := []*models.Department{
+				{ID: 2, Name: "Platform", ParentID: lo.ToPtr(int64(1))},
+				{ID: 3, Name: "Mobile", ParentID: lo.ToPtr(int64(1))},
+			}
+
+			Convey("When getting the department", func() {
+				s.departmentRepo.EXPECT().Get(gomock.Any(), s.db, int64(1)).Return(department, nil)
+				s.departmentRepo.EXPECT().ListByParentID(gomock.Any(), s.db, int64(1)).Return(children, nil)
+
+				var actualResponse GetResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/1", nil, &actualResponse, http.StatusOK)
+
+				Convey("Then the response should contain the department and its children", func() {
+					So(actualResponse.DepartmentID, ShouldEqual, 1)
+					So(actualResponse.Name, ShouldEqual, "Engineering")
+					So(actualResponse.ParentID, ShouldBeNil)
+					So(*actualResponse.HeadEmployeeID, ShouldEqual, 7)
+					So(actualResponse.Children, ShouldHaveLength, 2)
+					So(actualResponse.Children[1].Name, ShouldEqual, "Mobile")
+				})
+			})
+
+			Convey("When the department does not exist", func() {
+				s.departmentRepo.EXPECT().Get(gomock.Any(), s.db, int64(9)).Return(nil, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/9", nil, &actualResponse, http.StatusNotFound)
+
+				Convey("Then the response should indicate not found", func() {
+					So(actualResponse["error"], ShouldEqual, "department not found")
+				})
+			})
+
+			Convey("When the database fails", func() {
+				s.departmentRepo.EXPECT().Get(gomock.Any(), s.db, int64(1)).Return(nil, errors.New("database error"))
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/1", nil, &actualResponse, http.StatusInternalServerError)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(actualResponse["error"], ShouldEqual, "failed to get department")
+				})
+			})
+
+			Convey("When the id is invalid", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/abc", nil, &actualResponse, http.StatusBadRequest)
+
+				Convey("Then the response should indicate an invalid id", func() {
+					So(actualResponse["error"], ShouldEqual, "invalid id")
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package department
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=department
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type DepartmentRepo interface {
+	Create(ctx context.Context, tx *gorm.DB, data *models.Department) error
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+	GetByName(ctx context.Context, tx *gorm.DB, name string) (*models.Department, error)
+	List(ctx context.Context, tx *gorm.DB) ([]*models.Department, error)
+	ListByParentID(ctx context.Context, tx *gorm.DB, parentID int64) ([]*models.Department, error)
+	Save(ctx context.Context, tx *gorm.DB, data *models.Department) error
+	Delete(ctx context.Context, tx *gorm.DB, id int64) (bool, error)
+}
+
+type EmployeeInfoRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+	ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	ListEmployeeIDsByDepartmentID(ctx context.Context, tx *gorm.DB, departmentID int64) ([]int64, error)
+	RenameDepartment(ctx context.Context, tx *gorm.DB, departmentID int64, name string) error
+	ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error)
+}
+
+type EmployeeAttendanceRepo interface {
+	ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error)
+}
+
+type CacheManager interface {
+	DeleteEmployeeDetailV1(ctx context.Context, employeeID int64) error
+}
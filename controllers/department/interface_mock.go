@@ -0,0 +1,423 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=department
+//
+
+// Package department is a generated GoMock package.
+package department
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockDepartmentRepo is a mock of DepartmentRepo interface.
+type MockDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepoMockRecorder is the mock recorder for MockDepartmentRepo.
+type MockDepartmentRepoMockRecorder struct {
+	mock *MockDepartmentRepo
+}
+
+// NewMockDepartmentRepo creates a new mock instance.
+func NewMockDepartmentRepo(ctrl *gomock.Controller) *MockDepartmentRepo {
+	mock := &MockDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepo) EXPECT() *MockDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDepartmentRepo) Create(ctx context.Context, tx *gorm.DB, data *models.Department) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDepartmentRepoMockRecorder) Create(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDepartmentRepo)(nil).Create), ctx, tx, data)
+}
+
+// Delete mocks base method.
+func (m *MockDepartmentRepo) Delete(ctx context.Context, tx *gorm.DB, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDepartmentRepoMockRecorder) Delete(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDepartmentRepo)(nil).Delete), ctx, tx, id)
+}
+
+// Get mocks base method.
+func (m *MockDepartmentRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepartmentRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepartmentRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetByName mocks base method.
+func (m *MockDepartmentRepo) GetByName(ctx context.Context, tx *gorm.DB, name string) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, tx, name)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockDepartmentRepoMockRecorder) GetByName(ctx, tx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockDepartmentRepo)(nil).GetByName), ctx, tx, name)
+}
+
+// List mocks base method.
+func (m *MockDepartmentRepo) List(ctx context.Context, tx *gorm.DB) ([]*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx)
+	ret0, _ := ret[0].([]*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDepartmentRepoMockRecorder) List(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDepartmentRepo)(nil).List), ctx, tx)
+}
+
+// ListByParentID mocks base method.
+func (m *MockDepartmentRepo) ListByParentID(ctx context.Context, tx *gorm.DB, parentID int64) ([]*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByParentID", ctx, tx, parentID)
+	ret0, _ := ret[0].([]*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByParentID indicates an expected call of ListByParentID.
+func (mr *MockDepartmentRepoMockRecorder) ListByParentID(ctx, tx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByParentID", reflect.TypeOf((*MockDepartmentRepo)(nil).ListByParentID), ctx, tx, parentID)
+}
+
+// Save mocks base method.
+func (m *MockDepartmentRepo) Save(ctx context.Context, tx *gorm.DB, data *models.Department) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDepartmentRepoMockRecorder) Save(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDepartmentRepo)(nil).Save), ctx, tx, data)
+}
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// ListByIDs mocks base method.
+func (m *MockEmployeeInfoRepo) ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, tx, ids)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockEmployeeInfoRepoMockRecorder) ListByIDs(ctx, tx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).ListByIDs), ctx, tx, ids)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListCurrentByEmployeeIDs mocks base method.
+func (m *MockEmployeePositionRepo) ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrentByEmployeeIDs", ctx, tx, employeeIDs, nowtime)
+	ret0, _ := ret[0].(map[int64]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrentByEmployeeIDs indicates an expected call of ListCurrentByEmployeeIDs.
+func (mr *MockEmployeePositionRepoMockRecorder) ListCurrentByEmployeeIDs(ctx, tx, employeeIDs, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrentByEmployeeIDs", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListCurrentByEmployeeIDs), ctx, tx, employeeIDs, nowtime)
+}
+
+// ListEmployeeIDsByDepartmentID mocks base method.
+func (m *MockEmployeePositionRepo) ListEmployeeIDsByDepartmentID(ctx context.Context, tx *gorm.DB, departmentID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmployeeIDsByDepartmentID", ctx, tx, departmentID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmployeeIDsByDepartmentID indicates an expected call of ListEmployeeIDsByDepartmentID.
+func (mr *MockEmployeePositionRepoMockRecorder) ListEmployeeIDsByDepartmentID(ctx, tx, departmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployeeIDsByDepartmentID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListEmployeeIDsByDepartmentID), ctx, tx, departmentID)
+}
+
+// RenameDepartment mocks base method.
+func (m *MockEmployeePositionRepo) RenameDepartment(ctx context.Context, tx *gorm.DB, departmentID int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDepartment", ctx, tx, departmentID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDepartment indicates an expected call of RenameDepartment.
+func (mr *MockEmployeePositionRepoMockRecorder) RenameDepartment(ctx, tx, departmentID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDepartment", reflect.TypeOf((*MockEmployeePositionRepo)(nil).RenameDepartment), ctx, tx, departmentID, name)
+}
+
+// MockEmployeeAttendanceRepo is a mock of EmployeeAttendanceRepo interface.
+type MockEmployeeAttendanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeAttendanceRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeAttendanceRepoMockRecorder is the mock recorder for MockEmployeeAttendanceRepo.
+type MockEmployeeAttendanceRepoMockRecorder struct {
+	mock *MockEmployeeAttendanceRepo
+}
+
+// NewMockEmployeeAttendanceRepo creates a new mock instance.
+func NewMockEmployeeAttendanceRepo(ctrl *gomock.Controller) *MockEmployeeAttendanceRepo {
+	mock := &MockEmployeeAttendanceRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeAttendanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeAttendanceRepo) EXPECT() *MockEmployeeAttendanceRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByEmployeeIDs mocks base method.
+func (m *MockEmployeeAttendanceRepo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeIDs", ctx, tx, employeeIDs, from, to)
+	ret0, _ := ret[0].([]*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeIDs indicates an expected call of ListByEmployeeIDs.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) ListByEmployeeIDs(ctx, tx, employeeIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeIDs", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).ListByEmployeeIDs), ctx, tx, employeeIDs, from, to)
+}
+
+// MockCacheManager is a mock of CacheManager interface.
+type MockCacheManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCacheManagerMockRecorder is the mock recorder for MockCacheManager.
+type MockCacheManagerMockRecorder struct {
+	mock *MockCacheManager
+}
+
+// NewMockCacheManager creates a new mock instance.
+func NewMockCacheManager(ctrl *gomock.Controller) *MockCacheManager {
+	mock := &MockCacheManager{ctrl: ctrl}
+	mock.recorder = &MockCacheManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheManager) EXPECT() *MockCacheManagerMockRecorder {
+	return m.recorder
+}
+
+// DeleteEmployeeDetailV1 mocks base method.
+func (m *MockCacheManager) DeleteEmployeeDetailV1(ctx context.Context, employeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmployeeDetailV1", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmployeeDetailV1 indicates an expected call of DeleteEmployeeDetailV1.
+func (mr *MockCacheManagerMockRecorder) DeleteEmployeeDetailV1(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployeeDetailV1", reflect.TypeOf((*MockCacheManager)(nil).DeleteEmployeeDetailV1), ctx, employeeID)
+}
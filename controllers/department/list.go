@@ -0,0 +1,34 @@
+package department
+
+import (
+	"net/http"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListResponse struct {
+	Items []dtos.DepartmentV1Response `json:"items"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) List(ctx *gin.Context) {
+	departments, err := c.departmentRepo.List(ctx, c.db)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list departments"})
+		return
+	}
+
+	formatter := utils.GetTimeFormatter(ctx)
+	ctx.JSON(http.StatusOK, ListResponse{
+		Items: lo.Map(departments, func(department *models.Department, _ int) dtos.DepartmentV1Response {
+			return newDepartmentV1Response(department, formatter)
+		}),
+	})
+}
@@ -0,0 +1,35 @@
+package department
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given departments exist in the system", t, func() {
+			departments := []*models.Department{
+				{ID: 1, Name: "Engineering"},
+				{ID: 2, Name: "Platform", ParentID: lo.ToPtr(int64(1))},
+			}
+
+			Convey("When listing the departments", func() {
+				s.departmentRepo.EXPECT().List(gomock.Any(), s.db).Return(departments, nil)
+
+				var actualResponse ListResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department", nil, &actualResponse, http.StatusOK)
+
+				Convey("Then the response should contain every department", func() {
+					So(actualResponse.Items, ShouldHaveLength, 2)
+					So(actualResponse.Items[0].ParentID, ShouldBeNil)
+					So(*actualResponse.Items[1].ParentID, ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,30 @@
+package department
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newDepartmentV1Response(department *models.Department, formatter utils.TimeFormatter) dtos.DepartmentV1Response {
+	return dtos.DepartmentV1Response{
+		DepartmentID:   department.ID,
+		Name:           department.Name,
+		ParentID:       department.ParentID,
+		HeadEmployeeID: department.HeadEmployeeID,
+		CreatedAt:      formatter.Time(department.CreatedAt),
+		UpdatedAt:      formatter.Time(department.UpdatedAt),
+	}
+}
+
+func newGetResponse(department *models.Department, children []*models.Department, formatter utils.TimeFormatter) GetResponse {
+	return GetResponse{
+		DepartmentV1Response: newDepartmentV1Response(department, formatter),
+		Children: lo.Map(children, func(child *models.Department, _ int) dtos.DepartmentV1Response {
+			return newDepartmentV1Response(child, formatter)
+		}),
+	}
+}
@@ -0,0 +1,122 @@
+package department
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/timesheet"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type TimesheetRequest struct {
+	// Period is one of day, week or month, defaults to week
+	Period string `form:"period"`
+	// Start is the first day of the period, defaults to the current period
+	Start string `form:"start"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Timesheet aggregates the timesheets of the employees currently holding a
+// position in the department. The days of the period are resolved in UTC, and
+// every employee works them in their own time zone.
+func (c *Controller) Timesheet(ctx *gin.Context) {
+	id := ctx.Param("id")
+	// Convert id to int64
+	departmentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req TimesheetRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	nowTime := c.timeModule.Now()
+	period, from, to, err := timesheet.ParseWindow(req.Period, req.Start, nowTime, time.UTC)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	department, err := c.departmentRepo.Get(ctx, c.db, departmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get department"})
+		return
+	}
+	if department == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "department not found"})
+		return
+	}
+
+	// Former members still have positions in the department, keep the
+	// employees whose current position is in it
+	employeeIDs, err := c.employeePositionRepo.ListEmployeeIDsByDepartmentID(ctx, c.db, departmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list department employees"})
+		return
+	}
+	currentPositions, err := c.employeePositionRepo.ListCurrentByEmployeeIDs(ctx, c.db, employeeIDs, nowTime)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list department employees"})
+		return
+	}
+	memberIDs := lo.Filter(employeeIDs, func(employeeID int64, _ int) bool {
+		position, ok := currentPositions[employeeID]
+		return ok && position.DepartmentID == departmentID
+	})
+
+	memberInfos, err := c.employeeInfoRepo.ListByIDs(ctx, c.db, memberIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list department employees"})
+		return
+	}
+	locations := lo.SliceToMap(memberInfos, func(employeeInfo *models.EmployeeInfo) (int64, *time.Location) {
+		return employeeInfo.ID, employeeInfo.Location()
+	})
+
+	// Fetch the sessions of the days in every zone at once
+	windows := map[int64][2]time.Time{}
+	queryFrom, queryTo := from, to
+	for _, employeeID := range memberIDs {
+		memberFrom, memberTo := timesheet.WindowIn(from, to, lo.ValueOr(locations, employeeID, time.UTC))
+		windows[employeeID] = [2]time.Time{memberFrom, memberTo}
+		if memberFrom.Before(queryFrom) {
+			queryFrom = memberFrom
+		}
+		if memberTo.After(queryTo) {
+			queryTo = memberTo
+		}
+	}
+
+	attendances, err := c.employeeAttendanceRepo.ListByEmployeeIDs(ctx, c.db, memberIDs, queryFrom, queryTo)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list attendance"})
+		return
+	}
+	attendancesByEmployee := lo.GroupBy(attendances, func(attendance *models.EmployeeAttendance) int64 {
+		return attendance.EmployeeID
+	})
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employees := make([]dtos.TimesheetV1Response, 0, len(memberIDs))
+	for _, employeeID := range memberIDs {
+		window := windows[employeeID]
+		sheet := timesheet.Aggregate(attendancesByEmployee[employeeID], window[0], window[1], nowTime)
+		employees = append(employees, timesheet.NewV1Response(employeeID, period, sheet))
+	}
+
+	ctx.JSON(http.StatusOK, timesheet.NewDepartmentV1Response(departmentID, period, from, to, employees))
+}
@@ -0,0 +1,109 @@
+package department
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTimesheet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a department with current and former members", t, func() {
+			departmentID := int64(7)
+			nowTime := time.Date(2025, 5, 8, 12, 0, 0, 0, time.UTC)
+			day := time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC)
+
+			department := &models.Department{ID: departmentID, Name: "Engineering"}
+			currentPositions := map[int64]*models.EmployeePosition{
+				1: {ID: 11, EmployeeID: 1, DepartmentID: departmentID},
+				2: {ID: 12, EmployeeID: 2, DepartmentID: departmentID},
+				// Moved to another department
+				3: {ID: 13, EmployeeID: 3, DepartmentID: departmentID + 1},
+			}
+			// The first member works from Taipei
+			memberInfos := []*models.EmployeeInfo{
+				{ID: 1, TimeZone: "Asia/Taipei"},
+				{ID: 2, TimeZone: models.DefaultTimeZone},
+			}
+			taipeiDay := time.Date(2025, 5, 8, 0, 0, 0, 0, memberInfos[0].Location())
+			attendances := []*models.EmployeeAttendance{
+				{EmployeeID: 1, ClockIn: day.Add(1 * time.Hour), ClockOut: lo.ToPtr(day.Add(5 * time.Hour)), Status: models.AttendanceStatusClosed},
+				{EmployeeID: 2, ClockIn: day.Add(9 * time.Hour), Status: models.AttendanceStatusOpen},
+			}
+
+			Convey("When getting the timesheet of a day", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, departmentID).
+					Return(department, nil)
+				s.employeePositionRepo.EXPECT().
+					ListEmployeeIDsByDepartmentID(gomock.Any(), s.db, departmentID).
+					Return([]int64{1, 2, 3}, nil)
+				s.employeePositionRepo.EXPECT().
+					ListCurrentByEmployeeIDs(gomock.Any(), s.db, []int64{1, 2, 3}, nowTime).
+					Return(currentPositions, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByIDs(gomock.Any(), s.db, []int64{1, 2}).
+					Return(memberInfos, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{1, 2}, taipeiDay, day.AddDate(0, 0, 1)).
+					Return(attendances, nil)
+
+				var resp dtos.DepartmentTimesheetV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/7/timesheet?period=day", nil, &resp, http.StatusOK)
+
+				Convey("Then it should hold a timesheet per current member", func() {
+					So(resp.DepartmentID, ShouldEqual, departmentID)
+					So(resp.Start, ShouldEqual, "2025-05-08")
+					So(resp.End, ShouldEqual, "2025-05-08")
+					So(resp.Employees, ShouldHaveLength, 2)
+					So(resp.Employees[0].EmployeeID, ShouldEqual, 1)
+					So(resp.Employees[0].Start, ShouldEqual, "2025-05-08")
+					So(resp.Employees[0].WorkedHours, ShouldEqual, 4)
+					So(resp.Employees[1].EmployeeID, ShouldEqual, 2)
+					So(resp.Employees[1].WorkedHours, ShouldEqual, 3)
+					So(resp.Employees[1].Open, ShouldBeTrue)
+				})
+
+				Convey("Then the department totals should sum the members", func() {
+					So(resp.WorkedSeconds, ShouldEqual, int64(7*3600))
+					So(resp.WorkedHours, ShouldEqual, 7)
+					So(resp.Open, ShouldBeTrue)
+				})
+			})
+
+			Convey("When the department does not exist", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, departmentID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/7/timesheet", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When listing the members fails", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, departmentID).
+					Return(department, nil)
+				s.employeePositionRepo.EXPECT().
+					ListEmployeeIDsByDepartmentID(gomock.Any(), s.db, departmentID).
+					Return(nil, errors.New("database error"))
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/7/timesheet", nil, nil, http.StatusInternalServerError)
+			})
+
+			Convey("When the start is malformed", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/department/7/timesheet?start=yesterday", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
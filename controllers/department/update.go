@@ -0,0 +1,97 @@
+package department
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// UpdateRequest replaces the department, an omitted parent or head is removed
+type UpdateRequest = CreateRequest
+
+type UpdateResponse = GetResponse
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Update(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	id := ctx.Param("id")
+	// Convert id to int64
+	departmentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req UpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var response UpdateResponse
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		department, err := c.departmentRepo.Get(ctx, tx.DB, departmentID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
+		}
+		if department == nil {
+			return utils.NewHttpError(http.StatusNotFound, "department not found")
+		}
+
+		renamed := department.Name != req.Name
+		department.Name = req.Name
+		department.ParentID = req.ParentID
+		department.HeadEmployeeID = req.HeadEmployeeID
+		if err := c.checkDepartment(ctx, tx.DB, department); err != nil {
+			return err
+		}
+
+		if err := c.departmentRepo.Save(ctx, tx.DB, department); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to update department")
+		}
+
+		if renamed {
+			// The positions carry the department name, so do the cached employees
+			if err := c.employeePositionRepo.RenameDepartment(ctx, tx.DB, departmentID, department.Name); err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to rename department")
+			}
+			employeeIDs, err := c.employeePositionRepo.ListEmployeeIDsByDepartmentID(ctx, tx.DB, departmentID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to list department employees")
+			}
+			tx.AfterCommit(func() {
+				for _, employeeID := range employeeIDs {
+					if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
+						logger.Error().Err(err).Int64("employee_id", employeeID).Msg("Failed to delete employee detail cache")
+					}
+				}
+			})
+		}
+
+		children, err := c.departmentRepo.ListByParentID(ctx, tx.DB, departmentID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list child departments")
+		}
+		response = newGetResponse(department, children, utils.GetTimeFormatter(ctx))
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to update department")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, response)
+}
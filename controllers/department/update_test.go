@@ -0,0 +1,105 @@
+package department
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an existing department", t, func() {
+			department := func() *models.Department {
+				return &models.Department{ID: 2, Name: "Platform", ParentID: lo.ToPtr(int64(1))}
+			}
+
+			Convey("When renaming the department", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(2)).Return(department(), nil)
+				s.departmentRepo.EXPECT().GetByName(gomock.Any(), gomock.Any(), "Infrastructure").Return(nil, nil)
+				s.departmentRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, department *models.Department) error {
+						c.So(department.Name, ShouldEqual, "Infrastructure")
+						c.So(department.ParentID, ShouldBeNil)
+						return nil
+					})
+				s.employeePositionRepo.EXPECT().RenameDepartment(gomock.Any(), gomock.Any(), int64(2), "Infrastructure").Return(nil)
+				s.employeePositionRepo.EXPECT().ListEmployeeIDsByDepartmentID(gomock.Any(), gomock.Any(), int64(2)).Return([]int64{4, 5}, nil)
+				s.departmentRepo.EXPECT().ListByParentID(gomock.Any(), gomock.Any(), int64(2)).Return(nil, nil)
+
+				// The cached employees carry the old name
+				s.cacheManager.EXPECT().DeleteEmployeeDetailV1(gomock.Any(), int64(4)).Return(nil)
+				s.cacheManager.EXPECT().DeleteEmployeeDetailV1(gomock.Any(), int64(5)).Return(nil)
+
+				var actualResponse UpdateResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPut,
+					"/department/2",
+					UpdateRequest{Name: "Infrastructure"},
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should contain the updated department", func() {
+					So(actualResponse.DepartmentID, ShouldEqual, 2)
+					So(actualResponse.Name, ShouldEqual, "Infrastructure")
+					So(actualResponse.ParentID, ShouldBeNil)
+				})
+			})
+
+			Convey("When moving the department under one of its descendants", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(2)).Return(department(), nil)
+				s.departmentRepo.EXPECT().GetByName(gomock.Any(), gomock.Any(), "Platform").Return(department(), nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(3)).
+					Return(&models.Department{ID: 3, Name: "SRE", ParentID: lo.ToPtr(int64(2))}, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPut,
+					"/department/2",
+					UpdateRequest{Name: "Platform", ParentID: lo.ToPtr(int64(3))},
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should refuse the cycle", func() {
+					So(actualResponse["error"], ShouldEqual, "department cannot be nested under itself")
+				})
+			})
+
+			Convey("When the department does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.departmentRepo.EXPECT().Get(gomock.Any(), gomock.Any(), int64(9)).Return(nil, nil)
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPut,
+					"/department/9",
+					UpdateRequest{Name: "Platform"},
+					&actualResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate not found", func() {
+					So(actualResponse["error"], ShouldEqual, "department not found")
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,111 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/tasks"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ActivatePosition rebuilds the employee detail cache once a future-dated
+// position takes effect. It implements tasks.PositionActivator.
+func (c *Controller) ActivatePosition(ctx context.Context, employeeID int64, positionID int64) error {
+	var employeeDetail *dtos.EmployeeV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err := c.employeeInfoRepo.Get(ctx, tx.DB, employeeID)
+		if err != nil {
+			return fmt.Errorf("failed to get employee info: %w", err)
+		}
+		if employeeInfo == nil {
+			// Terminated in the meantime, nothing to rebuild
+			return nil
+		}
+
+		// The current position is part of the employee representation
+		if err := c.employeeInfoRepo.Save(ctx, tx.DB, employeeInfo); err != nil {
+			return fmt.Errorf("failed to bump employee info version: %w", err)
+		}
+
+		employeePosition, err := c.employeePositionRepo.GetCurrentByEmployeeID(ctx, tx.DB, employeeID, c.timeModule.Now())
+		if err != nil {
+			return fmt.Errorf("failed to get current employee position: %w", err)
+		}
+		if employeePosition == nil {
+			return fmt.Errorf("employee position not found")
+		}
+
+		response := newEmployeeV1Response(employeeInfo, employeePosition, utils.V1TimeFormatter)
+		employeeDetail = &response
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Drop the stale detail even when the employee is gone
+	if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
+		return fmt.Errorf("failed to delete employee detail cache: %w", err)
+	}
+	if employeeDetail == nil {
+		return nil
+	}
+	if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeID, *employeeDetail, 0); err != nil {
+		return fmt.Errorf("failed to cache employee detail: %w", err)
+	}
+
+	return nil
+}
+
+// SchedulePendingActivations schedules the activation of every future-dated
+// position, e.g. the ones persisted before a restart.
+func (c *Controller) SchedulePendingActivations(ctx context.Context) error {
+	employeePositions, err := c.employeePositionRepo.ListPending(ctx, c.db, c.timeModule.Now())
+	if err != nil {
+		return fmt.Errorf("failed to list pending employee positions: %w", err)
+	}
+
+	for _, employeePosition := range employeePositions {
+		c.scheduleActivation(employeePosition)
+	}
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) scheduleActivation(employeePosition *models.EmployeePosition) {
+	task := tasks.NewPositionActivationTaskWithCtx(
+		c.taskPool.GetCtx(),
+		c.timeModule,
+		c,
+		employeePosition.EmployeeID,
+		employeePosition.ID,
+		employeePosition.StartDate,
+		c.cfg.ActivationRetryDelay,
+		c.cfg.ActivationMaxRetries,
+	)
+	c.taskPool.SubmitTask(task)
+
+	log.Info().
+		Int64("employee_id", employeePosition.EmployeeID).
+		Int64("position_id", employeePosition.ID).
+		Time("effective_at", employeePosition.StartDate).
+		Msg("Scheduled position activation")
+}
+
+func (c *Controller) cancelActivation(ctx *gin.Context, positionID int64) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	if err := c.taskPool.CancelTask(tasks.PositionActivationTaskID(positionID)); err != nil {
+		logger.Warn().Err(err).Int64("position_id", positionID).Msg("No scheduled position activation to cancel")
+	}
+}
+
+var _ tasks.PositionActivator = (*Controller)(nil)
@@ -0,0 +1,105 @@
+package employee
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestActivatePosition(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a future-dated position that just took effect", t, func() {
+			// Setup test data
+			employeeID := int64(123)
+			positionID := int64(3)
+			nowTime := time.Date(2023, 7, 1, 0, 0, 1, 0, time.UTC)
+
+			employeeInfo := &models.EmployeeInfo{
+				ID:    employeeID,
+				Name:  "John Doe",
+				Email: "john@example.com",
+			}
+			employeePosition := &models.EmployeePosition{
+				ID:         positionID,
+				EmployeeID: employeeID,
+				Position:   "Lead Developer",
+				Department: "Engineering",
+				Salary:     money.New(800000, "USD"),
+				StartDate:  time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			}
+
+			Convey("When activating the position", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
+					Return(employeePosition, nil)
+				s.cacheManager.EXPECT().
+					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil)
+				s.cacheManager.EXPECT().
+					SetEmployeeDetailV1(gomock.Any(), employeeID, newEmployeeV1Response(employeeInfo, employeePosition, utils.V1TimeFormatter), gomock.Any()).
+					Return(nil)
+
+				err := s.controller.ActivatePosition(t.Context(), employeeID, positionID)
+
+				Convey("Then the cache should be rebuilt without error", func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey("When the employee was terminated in the meantime", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+				s.cacheManager.EXPECT().
+					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil)
+
+				err := s.controller.ActivatePosition(t.Context(), employeeID, positionID)
+
+				Convey("Then only the stale detail should be dropped", func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey("When the employee is modified concurrently", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(errors.New("version conflict"))
+
+				err := s.controller.ActivatePosition(t.Context(), employeeID, positionID)
+
+				Convey("Then the error should be returned for a retry", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+	})
+}
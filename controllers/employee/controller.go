@@ -1,6 +1,11 @@
 package employee
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
@@ -8,33 +13,86 @@ import (
 ////////////////////////////////////////////////////////////////////////////////
 
 type Config struct {
+	ActivationRetryDelay time.Duration `env:"POSITION_ACTIVATION_RETRY_DELAY,default=1m"`
+	ActivationMaxRetries int           `env:"POSITION_ACTIVATION_MAX_RETRIES,default=5"`
+
+	ImportBatchSize int   `env:"EMPLOYEE_IMPORT_BATCH_SIZE,default=100"`
+	ImportMaxRows   int   `env:"EMPLOYEE_IMPORT_MAX_ROWS,default=5000"`
+	ImportMaxBytes  int64 `env:"EMPLOYEE_IMPORT_MAX_BYTES,default=5242880"`
+	ExportPageSize  int   `env:"EMPLOYEE_EXPORT_PAGE_SIZE,default=500"`
+
+	// DefaultCurrency is the currency of the salaries sent without one
+	DefaultCurrency string `env:"DEFAULT_CURRENCY,default=USD"`
+	// SalaryBandPolicy is off, flag or reject, see pkgs/salaryband
+	SalaryBandPolicy string `env:"SALARY_BAND_POLICY,default=flag"`
+}
+
+// Validate rejects the import and export limits that would make a request
+// panic or refuse every file, and an unknown salary band policy, which would
+// otherwise act as flag.
+func (cfg Config) Validate() error {
+	if cfg.ImportBatchSize <= 0 {
+		return errors.New("EMPLOYEE_IMPORT_BATCH_SIZE must be positive")
+	}
+	if cfg.ImportMaxRows <= 0 {
+		return errors.New("EMPLOYEE_IMPORT_MAX_ROWS must be positive")
+	}
+	if cfg.ImportMaxBytes <= 0 {
+		return errors.New("EMPLOYEE_IMPORT_MAX_BYTES must be positive")
+	}
+	if cfg.ExportPageSize <= 0 {
+		return errors.New("EMPLOYEE_EXPORT_PAGE_SIZE must be positive")
+	}
+
+	switch cfg.SalaryBandPolicy {
+	case salaryband.PolicyOff, salaryband.PolicyFlag, salaryband.PolicyReject:
+		return nil
+	default:
+		return fmt.Errorf("SALARY_BAND_POLICY must be %s, %s or %s, got %q",
+			salaryband.PolicyOff, salaryband.PolicyFlag, salaryband.PolicyReject, cfg.SalaryBandPolicy)
+	}
 }
 
 type Controller struct {
 	cfg Config
 	db  *gorm.DB
 
-	timeModule           TimeModule
-	employeeInfoRepo     EmployeeInfoRepo
-	employeePositionRepo EmployeePositionRepo
-	cacheManager         CacheManager
+	txManager              TxManager
+	timeModule             TimeModule
+	employeeInfoRepo       EmployeeInfoRepo
+	employeePositionRepo   EmployeePositionRepo
+	employeeAttendanceRepo EmployeeAttendanceRepo
+	departmentRepo         DepartmentRepo
+	salaryBandRepo         SalaryBandRepo
+	cacheManager           CacheManager
+	taskPool               TaskPool
 }
 
 func NewController(
 	cfg Config,
 	db *gorm.DB,
+	txManager TxManager,
 	timeModule TimeModule,
 	employeeInfoRepo EmployeeInfoRepo,
 	employeePositionRepo EmployeePositionRepo,
+	employeeAttendanceRepo EmployeeAttendanceRepo,
+	departmentRepo DepartmentRepo,
+	salaryBandRepo SalaryBandRepo,
 	cacheManager CacheManager,
+	taskPool TaskPool,
 ) *Controller {
 	return &Controller{
-		cfg:                  cfg,
-		db:                   db,
-		timeModule:           timeModule,
-		employeeInfoRepo:     employeeInfoRepo,
-		employeePositionRepo: employeePositionRepo,
-		cacheManager:         cacheManager,
+		cfg:                    cfg,
+		db:                     db,
+		txManager:              txManager,
+		timeModule:             timeModule,
+		employeeInfoRepo:       employeeInfoRepo,
+		employeePositionRepo:   employeePositionRepo,
+		employeeAttendanceRepo: employeeAttendanceRepo,
+		departmentRepo:         departmentRepo,
+		salaryBandRepo:         salaryBandRepo,
+		cacheManager:           cacheManager,
+		taskPool:               taskPool,
 	}
 }
 
@@ -42,12 +100,23 @@ func (c *Controller) RegisterRoutes(r *gin.Engine) {
 	////////////////////////////////////////////////////////////////////////////
 	// employee management
 	r.POST("/employee", c.Create)
+	r.POST("/employee/import", c.Import)
+	r.GET("/employee/export", c.Export)
 	r.GET("/employee/:id", c.Get)
-	// r.GET("/employee", c.List)
+	r.GET("/employee", c.List)
 	r.PUT("/employee/:id", c.Update)
-	// r.DELETE("/employee/:id", c.Delete)
+	r.PATCH("/employee/:id", c.Patch)
+	r.DELETE("/employee/:id", c.Delete)
+	r.POST("/employee/:id/reinstate", c.Reinstate)
 
 	////////////////////////////////////////////////////////////////////////////
 	// position management
 	r.POST("/promote/:id", c.Promote)
+	r.GET("/employee/:id/positions", c.ListPositions)
+	r.GET("/employee/:id/positions/pending", c.ListPendingPositions)
+	r.DELETE("/employee/:id/positions/:position_id", c.CancelPendingPosition)
+
+	////////////////////////////////////////////////////////////////////////////
+	// attendance reporting
+	r.GET("/employee/:id/timesheet", c.Timesheet)
 }
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
 	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/sethvargo/go-envconfig"
+	. "github.com/smartystreets/goconvey/convey"
 	gomock "go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 )
@@ -17,10 +20,14 @@ type testSuite struct {
 	db     *gorm.DB
 	mockDB sqlmock.Sqlmock
 
-	timeModule           *MockTimeModule
-	employeeInfoRepo     *MockEmployeeInfoRepo
-	employeePositionRepo *MockEmployeePositionRepo
-	cacheManager         *MockCacheManager
+	timeModule             *MockTimeModule
+	employeeInfoRepo       *MockEmployeeInfoRepo
+	employeePositionRepo   *MockEmployeePositionRepo
+	employeeAttendanceRepo *MockEmployeeAttendanceRepo
+	departmentRepo         *MockDepartmentRepo
+	salaryBandRepo         *MockSalaryBandRepo
+	cacheManager           *MockCacheManager
+	taskPool               *MockTaskPool
 
 	controller *Controller
 	testServer testutils.TestHttpServer
@@ -36,7 +43,11 @@ func testInit(t *testing.T, test func(*testSuite)) {
 	timeModule := NewMockTimeModule(ctrl)
 	employeeInfoRepo := NewMockEmployeeInfoRepo(ctrl)
 	employeePositionRepo := NewMockEmployeePositionRepo(ctrl)
+	employeeAttendanceRepo := NewMockEmployeeAttendanceRepo(ctrl)
+	departmentRepo := NewMockDepartmentRepo(ctrl)
+	salaryBandRepo := NewMockSalaryBandRepo(ctrl)
 	cacheManager := NewMockCacheManager(ctrl)
+	taskPool := NewMockTaskPool(ctrl)
 
 	cfg := Config{}
 	if err := envconfig.Process(t.Context(), &cfg); err != nil {
@@ -45,24 +56,84 @@ func testInit(t *testing.T, test func(*testSuite)) {
 	controller := NewController(
 		cfg,
 		gormDB,
+		txmanager.New(gormDB),
 		timeModule,
 		employeeInfoRepo,
 		employeePositionRepo,
+		employeeAttendanceRepo,
+		departmentRepo,
+		salaryBandRepo,
 		cacheManager,
+		taskPool,
 	)
 	testServer := testutils.NewTestHttpServer(controller)
 	faker := gofakeit.New(0)
 	suite := &testSuite{
-		db:                   gormDB,
-		mockDB:               mockDB,
-		timeModule:           timeModule,
-		employeeInfoRepo:     employeeInfoRepo,
-		employeePositionRepo: employeePositionRepo,
-		cacheManager:         cacheManager,
-		controller:           controller,
-		testServer:           testServer,
-		faker:                faker,
+		db:                     gormDB,
+		mockDB:                 mockDB,
+		timeModule:             timeModule,
+		employeeInfoRepo:       employeeInfoRepo,
+		employeePositionRepo:   employeePositionRepo,
+		employeeAttendanceRepo: employeeAttendanceRepo,
+		departmentRepo:         departmentRepo,
+		salaryBandRepo:         salaryBandRepo,
+		cacheManager:           cacheManager,
+		taskPool:               taskPool,
+		controller:             controller,
+		testServer:             testServer,
+		faker:                  faker,
 	}
 
 	test(suite)
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestConfigValidate(t *testing.T) {
+	Convey("Given the employee configuration", t, func() {
+		cfg := Config{}
+		if err := envconfig.Process(t.Context(), &cfg); err != nil {
+			t.Fatal(err)
+		}
+
+		Convey("Then the defaults should be valid", func() {
+			So(cfg.Validate(), ShouldBeNil)
+		})
+
+		Convey("Then every salary band policy should be valid", func() {
+			for _, policy := range []string{salaryband.PolicyOff, salaryband.PolicyFlag, salaryband.PolicyReject} {
+				cfg.SalaryBandPolicy = policy
+				So(cfg.Validate(), ShouldBeNil)
+			}
+		})
+
+		Convey("When an import limit is not positive", func() {
+			invalid := []Config{cfg, cfg, cfg}
+			invalid[0].ImportBatchSize = 0
+			invalid[1].ImportMaxRows = -1
+			invalid[2].ImportMaxBytes = 0
+
+			Convey("Then it should be rejected", func() {
+				for _, invalidCfg := range invalid {
+					So(invalidCfg.Validate(), ShouldNotBeNil)
+				}
+			})
+		})
+
+		Convey("When the export page size is not positive", func() {
+			cfg.ExportPageSize = 0
+
+			Convey("Then it should be rejected", func() {
+				So(cfg.Validate(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the salary band policy is unknown", func() {
+			cfg.SalaryBandPolicy = "Reject"
+
+			Convey("Then it should be rejected", func() {
+				So(cfg.Validate(), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -1,14 +1,21 @@
 package employee
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -19,16 +26,21 @@ type CreateRequest struct {
 	Address string `json:"address" binding:"required"`
 	Phone   string `json:"phone"   binding:"required"`
 	Email   string `json:"email"   binding:"required"`
-
-	Position   string  `json:"position"   binding:"required"`
-	Department string  `json:"department" binding:"required"`
-	Salary     float64 `json:"salary"     binding:"required"`
-	StartDate  int64   `json:"start_date" binding:"required"`
+	// TimeZone is an IANA zone name, UTC when left out
+	TimeZone string `json:"time_zone" binding:"omitempty,timezone"`
+
+	Position     string `json:"position"      binding:"required"`
+	DepartmentID int64  `json:"department_id" binding:"required"`
+	// Salary is monthly, a bare number is in the default currency
+	Salary    money.Money `json:"salary"`
+	StartDate int64       `json:"start_date"    binding:"required"`
 }
 
 type CreateResponse struct {
 	EmployeeID int64 `json:"employee_id"`
 	PositionID int64 `json:"position_id"`
+	// SalaryBand is null when no band applies or the policy is off
+	SalaryBand *dtos.SalaryBandCheckV1Response `json:"salary_band"`
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -41,61 +53,125 @@ func (c *Controller) Create(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	salary, err := resolveSalary(req.Salary, c.cfg.DefaultCurrency)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.Salary = salary
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employeeInfo, employeePosition := newEmployeeFromCreateRequest(req)
+	nowTime := c.timeModule.Now()
+
+	// Both records are created or none of them
+	var salaryBand *dtos.SalaryBandCheckV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		var err error
+		salaryBand, err = c.createEmployee(ctx, tx.DB, employeeInfo, employeePosition, nowTime)
+		if err != nil {
+			return err
+		}
+
+		// Cache the employee detail
+		tx.AfterCommit(func() {
+			employeeDetail := newEmployeeV1Response(employeeInfo, employeePosition, utils.V1TimeFormatter)
+			if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeInfo.ID, employeeDetail, 0); err != nil {
+				logger.Error().Err(err).Msg("Failed to cache employee detail")
+			}
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create employee")
+		return
+	}
 
 	////////////////////////////////////////////////////////////////////////////
 
-	// Create the employee info
+	ctx.JSON(http.StatusCreated, CreateResponse{
+		EmployeeID: employeeInfo.ID,
+		PositionID: employeePosition.ID,
+		SalaryBand: salaryBand,
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newEmployeeFromCreateRequest(req CreateRequest) (*models.EmployeeInfo, *models.EmployeePosition) {
 	employeeInfo := &models.EmployeeInfo{
 		Name:    req.Name,
 		Age:     req.Age,
 		Address: req.Address,
 		Phone:   req.Phone,
 		Email:   req.Email,
+		// Clients predating time zones leave it out
+		TimeZone: lo.CoalesceOrEmpty(req.TimeZone, models.DefaultTimeZone),
 	}
-	if err := c.employeeInfoRepo.Create(ctx, c.db, employeeInfo); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create employee info"})
-		return
+	employeePosition := &models.EmployeePosition{
+		Position:     req.Position,
+		DepartmentID: req.DepartmentID,
+		Salary:       req.Salary,
+		StartDate:    time.Unix(req.StartDate, 0),
 	}
 
-	// Create the employee position
-	employeePosition := &models.EmployeePosition{
-		EmployeeID: employeeInfo.ID,
-		Position:   req.Position,
-		Department: req.Department,
-		Salary:     req.Salary,
-		StartDate:  time.Unix(req.StartDate, 0),
+	return employeeInfo, employeePosition
+}
+
+// resolveSalary gives the default currency to a salary sent as a bare
+// number. A salary must be positive.
+func resolveSalary(salary money.Money, defaultCurrency string) (money.Money, error) {
+	salary, err := salary.WithDefaultCurrency(defaultCurrency)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("invalid salary: %w", err)
 	}
-	nowTime := c.timeModule.Now()
-	if err := c.employeePositionRepo.Create(ctx, c.db, employeePosition, nowTime); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create employee position"})
-		return
+	if salary.Amount <= 0 {
+		return money.Money{}, errors.New("salary must be positive")
 	}
+	return salary, nil
+}
 
-	////////////////////////////////////////////////////////////////////////////
+// createEmployee creates the employee info and its initial position, and
+// returns where its salary falls in its band. The returned error is an
+// HttpError meant for the client.
+func (c *Controller) createEmployee(
+	ctx context.Context,
+	tx *gorm.DB,
+	employeeInfo *models.EmployeeInfo,
+	employeePosition *models.EmployeePosition,
+	nowTime time.Time,
+) (*dtos.SalaryBandCheckV1Response, error) {
+	if err := c.setPositionDepartment(ctx, tx, employeePosition); err != nil {
+		return nil, err
+	}
+	salaryBand, err := c.checkSalaryBand(ctx, tx, employeePosition)
+	if err != nil {
+		return nil, err
+	}
 
-	// Cache the employee detail
-	employeeDetail := dtos.EmployeeV1Response{
-		EmployeeID: employeeInfo.ID,
-		Name:       employeeInfo.Name,
-		Age:        employeeInfo.Age,
-		Phone:      employeeInfo.Phone,
-		Email:      employeeInfo.Email,
-		Address:    employeeInfo.Address,
-		CreatedAt:  utils.FormatedTime(employeeInfo.CreatedAt),
-		UpdatedAt:  utils.FormatedTime(employeeInfo.UpdatedAt),
+	if err := c.employeeInfoRepo.Create(ctx, tx, employeeInfo); err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to create employee info")
+	}
 
-		PositionID: employeePosition.ID,
-		Position:   employeePosition.Position,
-		Department: employeePosition.Department,
-		Salary:     employeePosition.Salary,
-		StartDate:  utils.FormatedTime(employeePosition.StartDate),
+	employeePosition.EmployeeID = employeeInfo.ID
+	if err := c.employeePositionRepo.Create(ctx, tx, employeePosition, nowTime); err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to create employee position")
+	}
+
+	return salaryBand, nil
+}
+
+// setPositionDepartment copies the name of the referenced department onto the
+// position. The returned error is an HttpError meant for the client.
+func (c *Controller) setPositionDepartment(ctx context.Context, tx *gorm.DB, employeePosition *models.EmployeePosition) error {
+	department, err := c.departmentRepo.Get(ctx, tx, employeePosition.DepartmentID)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
 	}
-	if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeInfo.ID, employeeDetail, 0); err != nil {
-		logger.Error().Err(err).Msg("Failed to cache employee detail")
+	if department == nil {
+		return utils.NewHttpError(http.StatusBadRequest, "department not found")
 	}
 
-	ctx.JSON(http.StatusCreated, CreateResponse{
-		EmployeeID: employeeInfo.ID,
-		PositionID: employeePosition.ID,
-	})
+	employeePosition.Department = department.Name
+	return nil
 }
@@ -1,12 +1,15 @@
 package employee
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
@@ -23,46 +26,72 @@ func TestCreate(t *testing.T) {
 				Address: "123 Main St",
 				Phone:   "555-1234",
 				Email:   "john.doe@example.com",
+				// Left out of the request, so the default zone
+				TimeZone: models.DefaultTimeZone,
 			}
 
 			startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
 			nowTime := time.Date(2023, 1, 15, 12, 0, 0, 0, time.UTC)
 
 			employeePosition := &models.EmployeePosition{
-				ID:         2,
-				EmployeeID: employeeInfo.ID,
-				Position:   "Developer",
-				Department: "Engineering",
-				Salary:     75000,
-				StartDate:  time.Unix(startDate, 0),
+				ID:           2,
+				EmployeeID:   employeeInfo.ID,
+				Position:     "Developer",
+				DepartmentID: 3,
+				Department:   "Engineering",
+				Salary:       money.New(7500000, "USD"),
+				StartDate:    time.Unix(startDate, 0),
 			}
 
 			// Create request payload
 			req := CreateRequest{
-				Name:       employeeInfo.Name,
-				Age:        employeeInfo.Age,
-				Address:    employeeInfo.Address,
-				Phone:      employeeInfo.Phone,
-				Email:      employeeInfo.Email,
-				Position:   employeePosition.Position,
-				Department: employeePosition.Department,
-				Salary:     employeePosition.Salary,
-				StartDate:  startDate,
+				Name:         employeeInfo.Name,
+				Age:          employeeInfo.Age,
+				Address:      employeeInfo.Address,
+				Phone:        employeeInfo.Phone,
+				Email:        employeeInfo.Email,
+				Position:     employeePosition.Position,
+				DepartmentID: employeePosition.DepartmentID,
+				Salary:       employeePosition.Salary,
+				StartDate:    startDate,
 			}
 
+			band := &models.SalaryBand{
+				ID:            4,
+				Position:      "Developer",
+				Currency:      "USD",
+				EffectiveDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				Min:           6000000,
+				Mid:           7000000,
+				Max:           8000000,
+			}
+			s.controller.cfg.SalaryBandPolicy = salaryband.PolicyFlag
+
 			Convey("When creating a new employee", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
 				// Set up expectations
 				s.timeModule.EXPECT().Now().Return(nowTime)
 
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeePosition.DepartmentID).
+					Return(&models.Department{ID: employeePosition.DepartmentID, Name: employeePosition.Department}, nil)
+
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), "Developer", "USD", employeePosition.StartDate).
+					Return(band, nil)
+
 				s.employeeInfoRepo.EXPECT().
-					Create(gomock.Any(), s.db, gomock.Any()).
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
 						info.ID = employeeInfo.ID
 						return nil
 					})
 
 				s.employeePositionRepo.EXPECT().
-					Create(gomock.Any(), s.db, gomock.Any(), nowTime).
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), nowTime).
 					DoAndReturn(func(_ interface{}, _ interface{}, pos *models.EmployeePosition, _ time.Time) error {
 						pos.ID = employeePosition.ID
 						return nil
@@ -70,19 +99,21 @@ func TestCreate(t *testing.T) {
 
 					// Expect cache manager to be called with the correct employee details
 				expectedCache := dtos.EmployeeV1Response{
-					EmployeeID: employeeInfo.ID,
-					Name:       employeeInfo.Name,
-					Age:        employeeInfo.Age,
-					Phone:      employeeInfo.Phone,
-					Email:      employeeInfo.Email,
-					Address:    employeeInfo.Address,
-					CreatedAt:  utils.FormatedTime(employeeInfo.CreatedAt),
-					UpdatedAt:  utils.FormatedTime(employeeInfo.UpdatedAt),
-					PositionID: employeePosition.ID,
-					Position:   employeePosition.Position,
-					Department: employeePosition.Department,
-					Salary:     employeePosition.Salary,
-					StartDate:  utils.FormatedTime(employeePosition.StartDate),
+					EmployeeID:   employeeInfo.ID,
+					Name:         employeeInfo.Name,
+					Age:          employeeInfo.Age,
+					Phone:        employeeInfo.Phone,
+					Email:        employeeInfo.Email,
+					Address:      employeeInfo.Address,
+					TimeZone:     employeeInfo.TimeZone,
+					CreatedAt:    utils.FormatedTime(employeeInfo.CreatedAt),
+					UpdatedAt:    utils.FormatedTime(employeeInfo.UpdatedAt),
+					PositionID:   employeePosition.ID,
+					Position:     employeePosition.Position,
+					DepartmentID: employeePosition.DepartmentID,
+					Department:   employeePosition.Department,
+					Salary:       employeePosition.Salary,
+					StartDate:    utils.FormatedTime(employeePosition.StartDate),
 				}
 				s.cacheManager.EXPECT().
 					SetEmployeeDetailV1(gomock.Any(), employeeInfo.ID, gomock.Eq(expectedCache), time.Duration(0)).
@@ -109,6 +140,157 @@ func TestCreate(t *testing.T) {
 					So(actualResponse.EmployeeID, ShouldEqual, expectedResponse.EmployeeID)
 					So(actualResponse.PositionID, ShouldEqual, expectedResponse.PositionID)
 				})
+
+				Convey("Then the response should place the salary in its band", func() {
+					So(actualResponse.SalaryBand, ShouldNotBeNil)
+					So(actualResponse.SalaryBand.SalaryBandID, ShouldEqual, band.ID)
+					So(actualResponse.SalaryBand.Status, ShouldEqual, salaryband.StatusWithin)
+					So(actualResponse.SalaryBand.CompaRatio, ShouldEqual, 1.07)
+				})
+			})
+
+			Convey("When the salary is above its band under the reject policy", func() {
+				s.controller.cfg.SalaryBandPolicy = salaryband.PolicyReject
+				band.Max = 7000000
+
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeePosition.DepartmentID).
+					Return(&models.Department{ID: employeePosition.DepartmentID, Name: employeePosition.Department}, nil)
+
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), "Developer", "USD", employeePosition.StartDate).
+					Return(band, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					req,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then no employee should be created", func() {
+					So(errorResponse["error"], ShouldEqual, "salary is above the salary band maximum of 70000.00 USD")
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
+			})
+
+			Convey("When the position only has bands in other currencies under the reject policy", func() {
+				s.controller.cfg.SalaryBandPolicy = salaryband.PolicyReject
+				eurBand := *band
+				eurBand.Currency = "EUR"
+
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeePosition.DepartmentID).
+					Return(&models.Department{ID: employeePosition.DepartmentID, Name: employeePosition.Department}, nil)
+
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), "Developer", "USD", employeePosition.StartDate).
+					Return(nil, nil)
+				// A band taking effect later does not apply yet
+				futureBand := eurBand
+				futureBand.Currency = "TWD"
+				futureBand.EffectiveDate = employeePosition.StartDate.AddDate(0, 0, 1)
+				s.salaryBandRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), "Developer").
+					Return([]*models.SalaryBand{&eurBand, &futureBand}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					req,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then no employee should be created", func() {
+					So(errorResponse["error"], ShouldEqual, "no salary band of the position in USD, its bands are in EUR")
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
+			})
+
+			Convey("When creating the position fails after the employee info", func() {
+				// The employee info must not be left behind without a position
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeePosition.DepartmentID).
+					Return(&models.Department{ID: employeePosition.DepartmentID, Name: employeePosition.Department}, nil)
+
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), "Developer", "USD", employeePosition.StartDate).
+					Return(nil, nil)
+
+				s.employeeInfoRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
+						info.ID = employeeInfo.ID
+						return nil
+					})
+
+				s.employeePositionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), nowTime).
+					Return(errors.New("database error"))
+
+				// No cache write is expected once the transaction rolled back
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					req,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the transaction should be rolled back", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to create employee position")
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
+			})
+
+			Convey("When the department does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeePosition.DepartmentID).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					req,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then no employee should be created", func() {
+					So(errorResponse["error"], ShouldEqual, "department not found")
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
 			})
 
 			Convey("When creating an employee with invalid data", func() {
@@ -136,6 +318,72 @@ func TestCreate(t *testing.T) {
 					So(actualResponse["error"], ShouldNotBeEmpty)
 				})
 			})
+
+			Convey("When creating an employee with an unknown time zone", func() {
+				reqInvalid := req
+				reqInvalid.TimeZone = "Mars/Olympus_Mons"
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					reqInvalid,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the time zone should be rejected", func() {
+					So(actualResponse["error"], ShouldContainSubstring, "TimeZone")
+				})
+			})
+
+			Convey("When creating an employee with an unknown currency", func() {
+				reqInvalid := map[string]any{
+					"name":          req.Name,
+					"age":           req.Age,
+					"address":       req.Address,
+					"phone":         req.Phone,
+					"email":         req.Email,
+					"position":      req.Position,
+					"department_id": req.DepartmentID,
+					"salary":        map[string]string{"amount": "75000", "currency": "XYZ"},
+					"start_date":    req.StartDate,
+				}
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					reqInvalid,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the currency should be rejected", func() {
+					So(actualResponse["error"], ShouldContainSubstring, "unknown currency")
+				})
+			})
+
+			Convey("When creating an employee without a salary", func() {
+				reqInvalid := req
+				reqInvalid.Salary = money.Money{}
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee",
+					reqInvalid,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the salary should be rejected", func() {
+					So(actualResponse["error"], ShouldEqual, "salary must be positive")
+				})
+			})
 		})
 	})
 }
@@ -0,0 +1,106 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type DeleteRequest struct {
+	// TerminationDate defaults to now when omitted
+	TerminationDate int64  `json:"termination_date"`
+	Reason          string `json:"reason"           binding:"required,max=255"`
+}
+
+type DeleteResponse struct {
+	EmployeeID         int64  `json:"employee_id"`
+	TerminationDate    string `json:"termination_date"`
+	Reason             string `json:"reason"`
+	ClosedAttendanceID int64  `json:"closed_attendance_id,omitempty"`
+}
+
+func (c *Controller) Delete(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	id := ctx.Param("id")
+	// Convert id to int64
+	employeeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req DeleteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	nowTime := c.timeModule.Now()
+	terminatedAt := nowTime
+	if req.TerminationDate != 0 {
+		terminatedAt = time.Unix(req.TerminationDate, 0)
+	}
+	if terminatedAt.After(nowTime) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "termination_date cannot be in the future"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var closedAttendance *models.EmployeeAttendance
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		// Lock the employee so no clock-in slips in before the termination
+		employeeInfo, err := c.employeeInfoRepo.GetForUpdate(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee")
+		}
+		if employeeInfo == nil {
+			return utils.NewHttpError(http.StatusNotFound, "employee not found")
+		}
+
+		// Close the session of an employee who is still clocked in, at the
+		// termination rather than now when it is back-dated
+		closedAttendance, err = c.employeeAttendanceRepo.CloseOpenByEmployeeID(ctx, tx.DB, employeeID, terminatedAt)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to close open attendance")
+		}
+
+		if err := c.employeeInfoRepo.Terminate(ctx, tx.DB, employeeID, terminatedAt, req.Reason); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to terminate employee")
+		}
+
+		tx.AfterCommit(func() {
+			if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
+				logger.Error().Err(err).Msg("Failed to delete employee detail cache")
+			}
+			if err := c.cacheManager.DeleteAttendanceV1(ctx, employeeID); err != nil {
+				logger.Error().Err(err).Msg("Failed to delete attendance cache")
+			}
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to terminate employee")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	response := DeleteResponse{
+		EmployeeID:      employeeID,
+		TerminationDate: utils.GetTimeFormatter(ctx).Time(terminatedAt),
+		Reason:          req.Reason,
+	}
+	if closedAttendance != nil {
+		response.ClosedAttendanceID = closedAttendance.ID
+	}
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,215 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDelete(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee exists in the system", t, func() {
+			// Setup test data
+			employeeID := int64(123)
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+			terminationDate := time.Date(2023, 6, 14, 0, 0, 0, 0, time.UTC)
+
+			employeeInfo := &models.EmployeeInfo{
+				ID:    employeeID,
+				Name:  "Jane Smith",
+				Email: "jane.smith@example.com",
+			}
+
+			req := DeleteRequest{
+				TerminationDate: terminationDate.Unix(),
+				Reason:          "resigned",
+			}
+
+			Convey("When terminating an employee who is still clocked in", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
+				// Clocked out at the back-dated termination, not now
+				s.employeeAttendanceRepo.EXPECT().
+					CloseOpenByEmployeeID(gomock.Any(), gomock.Any(), employeeID, time.Unix(req.TerminationDate, 0)).
+					Return(&models.EmployeeAttendance{ID: 789, EmployeeID: employeeID}, nil)
+
+				s.employeeInfoRepo.EXPECT().
+					Terminate(gomock.Any(), gomock.Any(), employeeID, time.Unix(req.TerminationDate, 0), req.Reason).
+					Return(nil)
+
+				// Both caches of the employee are evicted
+				s.cacheManager.EXPECT().
+					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil)
+				s.cacheManager.EXPECT().
+					DeleteAttendanceV1(gomock.Any(), employeeID).
+					Return(nil)
+
+				var actualResponse DeleteResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123",
+					req,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should contain the termination details", func() {
+					So(actualResponse.EmployeeID, ShouldEqual, employeeID)
+					So(actualResponse.TerminationDate, ShouldEqual, "2023-06-14 00:00:00")
+					So(actualResponse.Reason, ShouldEqual, req.Reason)
+					So(actualResponse.ClosedAttendanceID, ShouldEqual, 789)
+				})
+			})
+
+			Convey("When terminating without a termination date", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
+				s.employeeAttendanceRepo.EXPECT().
+					CloseOpenByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
+					Return(nil, nil)
+
+				s.employeeInfoRepo.EXPECT().
+					Terminate(gomock.Any(), gomock.Any(), employeeID, nowTime, req.Reason).
+					Return(nil)
+
+				s.cacheManager.EXPECT().
+					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil)
+				s.cacheManager.EXPECT().
+					DeleteAttendanceV1(gomock.Any(), employeeID).
+					Return(errors.New("redis error"))
+
+				var actualResponse DeleteResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123",
+					DeleteRequest{Reason: req.Reason},
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the termination date should default to now", func() {
+					So(actualResponse.TerminationDate, ShouldEqual, "2023-06-15 12:00:00")
+					So(actualResponse.ClosedAttendanceID, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the termination date is in the future", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123",
+					DeleteRequest{
+						TerminationDate: nowTime.Add(24 * time.Hour).Unix(),
+						Reason:          req.Reason,
+					},
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should reject the date", func() {
+					So(errorResponse["error"], ShouldEqual, "termination_date cannot be in the future")
+				})
+			})
+
+			Convey("When the reason is missing", func() {
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123",
+					map[string]any{},
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate a validation error", func() {
+					So(errorResponse["error"], ShouldNotBeEmpty)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123",
+					req,
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate employee not found", func() {
+					So(errorResponse["error"], ShouldEqual, "employee not found")
+				})
+			})
+
+			Convey("When closing the open attendance fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					GetForUpdate(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
+				s.employeeAttendanceRepo.EXPECT().
+					CloseOpenByEmployeeID(gomock.Any(), gomock.Any(), employeeID, time.Unix(req.TerminationDate, 0)).
+					Return(nil, errors.New("database error"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123",
+					req,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to close open attendance")
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,31 @@
+package employee
+
+import (
+	"net/http"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// employeeETag derives the entity tag of an employee from its version and the
+// formatted update time, so it can be computed from the cached detail as well.
+func employeeETag(version int64, updatedAt string) string {
+	return utils.NewETag(version, updatedAt)
+}
+
+func employeeInfoETag(employeeInfo *models.EmployeeInfo) string {
+	return employeeETag(employeeInfo.Version, utils.FormatedTime(employeeInfo.UpdatedAt))
+}
+
+// errPreconditionFailed is returned when the employee was modified since the
+// client read it.
+var errPreconditionFailed = utils.NewHttpError(http.StatusPreconditionFailed, "employee has been modified")
+
+// matchIfMatch reports whether the If-Match header matches the current
+// employee info.
+func matchIfMatch(ctx *gin.Context, employeeInfo *models.EmployeeInfo) bool {
+	return utils.MatchIfMatch(ctx.GetHeader(utils.IfMatchHeader), employeeInfoETag(employeeInfo))
+}
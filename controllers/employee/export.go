@@ -0,0 +1,236 @@
+package employee
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/WangWilly/labs-hr-go/pkgs/xlsx"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatXLSX = "xlsx"
+	exportDateLayout = "2006-01-02"
+	exportSheetName  = "Employees"
+)
+
+type ExportRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	// AsOf is the date of the snapshot, taken at the end of the day, now when
+	// empty
+	AsOf string `form:"as_of"`
+}
+
+// exportColumns are the json names of the dtos.EmployeeV1Response fields, so
+// the export always carries the same fields as the employee API.
+var exportColumns = lo.Times(reflect.TypeOf(dtos.EmployeeV1Response{}).NumField(), func(i int) string {
+	return strings.Split(reflect.TypeOf(dtos.EmployeeV1Response{}).Field(i).Tag.Get("json"), ",")[0]
+})
+
+type exportWriter interface {
+	WriteRow(values ...any) error
+	Flush() error
+	Close() error
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Export(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	var req ExportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Format == "" {
+		req.Format = exportFormatCSV
+	}
+
+	var asOf time.Time
+	if req.AsOf == "" {
+		asOf = c.timeModule.Now().UTC()
+	} else {
+		date, err := time.ParseInLocation(exportDateLayout, req.AsOf, time.UTC)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid as_of"})
+			return
+		}
+		// The positions starting on the day are in effect, not the ones of
+		// the next midnight
+		asOf = date.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	// The first page is read before anything is written, so that a failing
+	// database still gets a proper error response
+	items, cursor, err := c.exportPage(ctx, asOf, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to export employees")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to export employees"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	filename := fmt.Sprintf("employees-%s.%s", asOf.Format(exportDateLayout), req.Format)
+	contentType := "text/csv; charset=utf-8"
+	if req.Format == exportFormatXLSX {
+		contentType = xlsx.MIMEType
+	}
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Status(http.StatusOK)
+
+	writer, err := newExportWriter(ctx.Writer, req.Format)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to start employee export")
+		return
+	}
+	if err := writer.WriteRow(lo.ToAnySlice(exportColumns)...); err != nil {
+		logger.Error().Err(err).Msg("Failed to write employee export")
+		return
+	}
+
+	// From here on the status is sent, an error can only truncate the file
+	for {
+		for _, item := range items {
+			if err := writer.WriteRow(exportValues(item)...); err != nil {
+				logger.Error().Err(err).Msg("Failed to write employee export")
+				return
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			logger.Error().Err(err).Msg("Failed to write employee export")
+			return
+		}
+		ctx.Writer.Flush()
+
+		if cursor == nil {
+			break
+		}
+		items, cursor, err = c.exportPage(ctx, asOf, cursor)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to export employees")
+			return
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		logger.Error().Err(err).Msg("Failed to complete employee export")
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// exportPage returns a page of employees with the position effective at asOf,
+// and the cursor of the next page if there is one.
+func (c *Controller) exportPage(ctx *gin.Context, asOf time.Time, cursor *utils.Cursor) ([]dtos.EmployeeV1Response, *utils.Cursor, error) {
+	params := employeeinforepo.ListParams{
+		AsOf:   asOf,
+		SortBy: employeeinforepo.ListSortID,
+		Cursor: cursor,
+		// Fetch one extra row to know whether there is a next page
+		Limit: c.cfg.ExportPageSize + 1,
+	}
+	employeeInfos, err := c.employeeInfoRepo.List(ctx, c.db, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(employeeInfos) > c.cfg.ExportPageSize {
+		employeeInfos = employeeInfos[:c.cfg.ExportPageSize]
+		nextCursor = lo.ToPtr(employeeinforepo.ListCursor(params.SortBy, employeeInfos[len(employeeInfos)-1]))
+	}
+
+	employeeIDs := lo.Map(employeeInfos, func(info *models.EmployeeInfo, _ int) int64 {
+		return info.ID
+	})
+	employeePositions, err := c.employeePositionRepo.ListCurrentByEmployeeIDs(ctx, c.db, employeeIDs, asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := make([]dtos.EmployeeV1Response, 0, len(employeeInfos))
+	for _, employeeInfo := range employeeInfos {
+		employeePosition, ok := employeePositions[employeeInfo.ID]
+		if !ok {
+			// The position was replaced between the two queries
+			continue
+		}
+		// Exported files keep the V1 layout
+		items = append(items, newEmployeeV1Response(employeeInfo, employeePosition, utils.V1TimeFormatter))
+	}
+
+	return items, nextCursor, nil
+}
+
+func exportValues(item dtos.EmployeeV1Response) []any {
+	value := reflect.ValueOf(item)
+	return lo.Times(value.NumField(), func(i int) any {
+		return value.Field(i).Interface()
+	})
+}
+
+func newExportWriter(w io.Writer, format string) (exportWriter, error) {
+	if format == exportFormatXLSX {
+		return xlsx.NewWriter(w, exportSheetName)
+	}
+	return &csvExportWriter{csv.NewWriter(w)}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type csvExportWriter struct {
+	*csv.Writer
+}
+
+// csvFormulaPrefixes start the cells spreadsheets evaluate as formulas. A
+// sign only does when the cell is more than a number or a phone number.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+var csvSignedNumberRegexp = regexp.MustCompile(`^[+-][0-9 ().-]+$`)
+
+func (w *csvExportWriter) WriteRow(values ...any) error {
+	record := lo.Map(values, func(value any, _ int) string {
+		switch v := value.(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			// Quote the text a spreadsheet would run, such as a name of
+			// =HYPERLINK(...)
+			if v != "" && strings.ContainsRune(csvFormulaPrefixes, rune(v[0])) && !csvSignedNumberRegexp.MatchString(v) {
+				return "'" + v
+			}
+			return v
+		default:
+			return fmt.Sprint(value)
+		}
+	})
+	return w.Write(record)
+}
+
+func (w *csvExportWriter) Flush() error {
+	w.Writer.Flush()
+	return w.Error()
+}
+
+func (w *csvExportWriter) Close() error {
+	return w.Flush()
+}
@@ -0,0 +1,214 @@
+package employee
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExport(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given employees exist in the system", t, func() {
+			// Setup test data
+			// The end of 2023-06-01
+			asOf := time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+			createdAt := time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC)
+			startDate := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+			employeeInfos := []*models.EmployeeInfo{
+				{ID: 1, Name: "Alice", Age: 30, Email: "alice@example.com", TimeZone: "Asia/Taipei", Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt},
+				{ID: 2, Name: "Bob, Jr.", Age: 40, Phone: "+1 555 0100", Email: "bob@example.com", Version: 2, CreatedAt: createdAt, UpdatedAt: createdAt},
+				{ID: 3, Name: "=HYPERLINK(\"http://example.com\")", Age: 50, Phone: "+SUM(A1)", Email: "carol@example.com", Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt},
+			}
+			employeePositions := map[int64]*models.EmployeePosition{
+				1: {ID: 11, EmployeeID: 1, Position: "Developer", DepartmentID: 1, Department: "Engineering", Salary: money.New(500050, "USD"), StartDate: startDate},
+				2: {ID: 12, EmployeeID: 2, Position: "Developer", DepartmentID: 1, Department: "Engineering", Salary: money.New(520000, "USD"), StartDate: startDate},
+				3: {ID: 13, EmployeeID: 3, Position: "Designer", DepartmentID: 2, Department: "Product", Salary: money.New(480000, "USD"), StartDate: startDate},
+			}
+
+			s.controller.cfg.ExportPageSize = 2
+			defer func() { s.controller.cfg.ExportPageSize = 500 }()
+
+			expectPages := func(c C) {
+				gomock.InOrder(
+					s.employeeInfoRepo.EXPECT().
+						List(gomock.Any(), s.db, gomock.Any()).
+						DoAndReturn(func(_ interface{}, _ interface{}, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error) {
+							c.So(params.AsOf, ShouldEqual, asOf)
+							c.So(params.SortBy, ShouldEqual, employeeinforepo.ListSortID)
+							c.So(params.Cursor, ShouldBeNil)
+							c.So(params.Limit, ShouldEqual, 3)
+							return employeeInfos, nil
+						}),
+					s.employeePositionRepo.EXPECT().
+						ListCurrentByEmployeeIDs(gomock.Any(), s.db, []int64{1, 2}, asOf).
+						Return(employeePositions, nil),
+					s.employeeInfoRepo.EXPECT().
+						List(gomock.Any(), s.db, gomock.Any()).
+						DoAndReturn(func(_ interface{}, _ interface{}, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error) {
+							c.So(params.Cursor, ShouldNotBeNil)
+							c.So(params.Cursor.ID, ShouldEqual, 2)
+							return employeeInfos[2:], nil
+						}),
+					s.employeePositionRepo.EXPECT().
+						ListCurrentByEmployeeIDs(gomock.Any(), s.db, []int64{3}, asOf).
+						Return(employeePositions, nil),
+				)
+			}
+
+			Convey("When exporting as CSV", func(c C) {
+				expectPages(c)
+
+				var actualResponse string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/export?format=csv&as_of=2023-06-01",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then every page should be streamed after the header", func() {
+					lines := strings.Split(strings.TrimSpace(actualResponse), "\n")
+					So(lines, ShouldHaveLength, 4)
+					So(lines[0], ShouldEqual, "employee_id,name,age,phone,email,address,time_zone,created_at,updated_at,version,"+
+						"position_id,position,department_id,department,salary,start_date")
+					So(lines[1], ShouldEqual, "1,Alice,30,,alice@example.com,,Asia/Taipei,2023-01-01 09:00:00,2023-01-01 09:00:00,1,"+
+						"11,Developer,1,Engineering,5000.50 USD,2023-02-01 00:00:00")
+					// A phone number is kept as is
+					So(lines[2], ShouldStartWith, `2,"Bob, Jr.",40,+1 555 0100,`)
+					// A formula is written as text
+					So(lines[3], ShouldStartWith, `3,"'=HYPERLINK(""http://example.com"")",50,'+SUM(A1),`)
+				})
+			})
+
+			Convey("When exporting as XLSX", func(c C) {
+				expectPages(c)
+
+				var actualResponse string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/export?format=xlsx&as_of=2023-06-01",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should be a workbook holding every row", func() {
+					reader, err := zip.NewReader(strings.NewReader(actualResponse), int64(len(actualResponse)))
+					So(err, ShouldBeNil)
+
+					var sheet bytes.Buffer
+					for _, file := range reader.File {
+						if file.Name != "xl/worksheets/sheet1.xml" {
+							continue
+						}
+						rc, err := file.Open()
+						So(err, ShouldBeNil)
+						_, err = io.Copy(&sheet, rc)
+						So(err, ShouldBeNil)
+						rc.Close()
+					}
+					So(sheet.String(), ShouldContainSubstring, `<row r="4">`)
+					So(sheet.String(), ShouldContainSubstring, `<c r="O2" t="inlineStr"><is><t xml:space="preserve">5000.50 USD</t></is></c>`)
+					So(sheet.String(), ShouldContainSubstring, "Bob, Jr.")
+				})
+			})
+
+			Convey("When exporting without as_of", func(c C) {
+				nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					List(gomock.Any(), s.db, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error) {
+						c.So(params.AsOf, ShouldEqual, nowTime)
+						return nil, nil
+					})
+				s.employeePositionRepo.EXPECT().
+					ListCurrentByEmployeeIDs(gomock.Any(), s.db, []int64{}, nowTime).
+					Return(map[int64]*models.EmployeePosition{}, nil)
+
+				var actualResponse string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/export",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should be a CSV of the current roster", func() {
+					So(strings.Count(actualResponse, "\n"), ShouldEqual, 1)
+					So(actualResponse, ShouldStartWith, "employee_id,name,")
+				})
+			})
+
+			Convey("When as_of is not a date", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/export?as_of=06/01/2023",
+					nil,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate an invalid as_of", func() {
+					So(actualResponse["error"], ShouldEqual, "invalid as_of")
+				})
+			})
+
+			Convey("When the format is not supported", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/export?format=pdf",
+					nil,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate a validation error", func() {
+					So(actualResponse["error"], ShouldNotBeEmpty)
+				})
+			})
+
+			Convey("When the first page fails to be read", func() {
+				s.employeeInfoRepo.EXPECT().
+					List(gomock.Any(), s.db, gomock.Any()).
+					Return(nil, errors.New("database error"))
+
+				var actualResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/export?as_of=2023-06-01",
+					nil,
+					&actualResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(actualResponse["error"], ShouldEqual, "failed to export employees")
+				})
+			})
+		})
+	})
+}
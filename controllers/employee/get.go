@@ -3,7 +3,6 @@ package employee
 import (
 	"strconv"
 
-	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
@@ -28,15 +27,23 @@ func (c *Controller) Get(ctx *gin.Context) {
 
 	////////////////////////////////////////////////////////////////////////////
 
-	// Check if the employee detail is in cache
-	cacheData, err := c.cacheManager.GetEmployeeDetailV1(ctx, employeeID)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to get employee detail from cache")
-	}
-	if err == nil && cacheData != nil {
-		logger.Info().Msg("Cache hit")
-		ctx.JSON(200, cacheData)
-		return
+	// Only V1 responses are cached
+	formatter := utils.GetTimeFormatter(ctx)
+	if formatter.Format() == utils.TimeFormatV1 {
+		// Check if the employee detail is in cache
+		cacheData, err := c.cacheManager.GetEmployeeDetailV1(ctx, employeeID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to get employee detail from cache")
+		}
+		// Entries cached before versioning carry no version and cannot be
+		// tagged, entries cached before departments carry no department ID
+		// and entries cached before time zones carry no time zone
+		if err == nil && cacheData != nil && cacheData.Version != 0 && cacheData.DepartmentID != 0 && cacheData.TimeZone != "" {
+			logger.Info().Msg("Cache hit")
+			ctx.Header(utils.ETagHeader, employeeETag(cacheData.Version, cacheData.UpdatedAt))
+			ctx.JSON(200, cacheData)
+			return
+		}
 	}
 
 	////////////////////////////////////////////////////////////////////////////
@@ -49,34 +56,19 @@ func (c *Controller) Get(ctx *gin.Context) {
 
 	nowTime := c.timeModule.Now()
 	employeePosition, err := c.employeePositionRepo.GetCurrentByEmployeeID(ctx, c.db, employeeID, nowTime)
-	if err != nil {
+	if err != nil || employeePosition == nil {
 		ctx.JSON(404, gin.H{"error": "employee position not found"})
 		return
 	}
 
 	////////////////////////////////////////////////////////////////////////////
 
-	response := &dtos.EmployeeV1Response{
-		EmployeeID: employeeInfo.ID,
-		Name:       employeeInfo.Name,
-		Age:        employeeInfo.Age,
-		Phone:      employeeInfo.Phone,
-		Email:      employeeInfo.Email,
-		Address:    employeeInfo.Address,
-		CreatedAt:  utils.FormatedTime(employeeInfo.CreatedAt),
-		UpdatedAt:  utils.FormatedTime(employeeInfo.UpdatedAt),
-
-		PositionID: employeePosition.ID,
-		Position:   employeePosition.Position,
-		Department: employeePosition.Department,
-		Salary:     employeePosition.Salary,
-		StartDate:  utils.FormatedTime(employeePosition.StartDate),
-	}
-
 	// Cache the employee detail
-	if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeID, *response, 0); err != nil {
+	cacheData := newEmployeeV1Response(employeeInfo, employeePosition, utils.V1TimeFormatter)
+	if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeID, cacheData, 0); err != nil {
 		logger.Error().Err(err).Msg("Failed to cache employee detail")
 	}
 
-	ctx.JSON(200, response)
+	ctx.Header(utils.ETagHeader, employeeInfoETag(employeeInfo))
+	ctx.JSON(200, newEmployeeV1Response(employeeInfo, employeePosition, formatter))
 }
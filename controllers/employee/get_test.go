@@ -8,6 +8,7 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
@@ -27,52 +28,61 @@ func TestGet(t *testing.T) {
 				Address:   "456 Oak Avenue",
 				Phone:     "555-5678",
 				Email:     "jane.smith@example.com",
+				TimeZone:  "Asia/Taipei",
+				Version:   3,
 				CreatedAt: nowTime.Add(-24 * time.Hour),
 				UpdatedAt: nowTime.Add(-12 * time.Hour),
 			}
 
 			employeePosition := &models.EmployeePosition{
-				ID:         456,
-				EmployeeID: employeeID,
-				Position:   "Senior Developer",
-				Department: "Engineering",
-				Salary:     95000.00,
-				StartDate:  nowTime.Add(-6 * 30 * 24 * time.Hour), // ~6 months ago
+				ID:           456,
+				EmployeeID:   employeeID,
+				Position:     "Senior Developer",
+				DepartmentID: 2,
+				Department:   "Engineering",
+				Salary:       money.New(9500000, "USD"),
+				StartDate:    nowTime.Add(-6 * 30 * 24 * time.Hour), // ~6 months ago
 			}
 
 			// Expected response data structure
 			expectedResponse := dtos.EmployeeV1Response{
-				EmployeeID: employeeInfo.ID,
-				Name:       employeeInfo.Name,
-				Age:        employeeInfo.Age,
-				Phone:      employeeInfo.Phone,
-				Email:      employeeInfo.Email,
-				Address:    employeeInfo.Address,
-				CreatedAt:  utils.FormatedTime(employeeInfo.CreatedAt),
-				UpdatedAt:  utils.FormatedTime(employeeInfo.UpdatedAt),
-				PositionID: employeePosition.ID,
-				Position:   employeePosition.Position,
-				Department: employeePosition.Department,
-				Salary:     employeePosition.Salary,
-				StartDate:  utils.FormatedTime(employeePosition.StartDate),
+				EmployeeID:   employeeInfo.ID,
+				Name:         employeeInfo.Name,
+				Age:          employeeInfo.Age,
+				Phone:        employeeInfo.Phone,
+				Email:        employeeInfo.Email,
+				Address:      employeeInfo.Address,
+				TimeZone:     employeeInfo.TimeZone,
+				CreatedAt:    utils.FormatedTime(employeeInfo.CreatedAt),
+				UpdatedAt:    utils.FormatedTime(employeeInfo.UpdatedAt),
+				Version:      employeeInfo.Version,
+				PositionID:   employeePosition.ID,
+				Position:     employeePosition.Position,
+				DepartmentID: employeePosition.DepartmentID,
+				Department:   employeePosition.Department,
+				Salary:       employeePosition.Salary,
+				StartDate:    utils.FormatedTime(employeePosition.StartDate),
 			}
 
 			Convey("When retrieving the employee by ID and cache hits", func() {
 				// Set up cache hit expectation
 				cachedResponse := &dtos.EmployeeV1Response{
-					EmployeeID: employeeInfo.ID,
-					Name:       employeeInfo.Name,
-					Age:        employeeInfo.Age,
-					Phone:      employeeInfo.Phone,
-					Email:      employeeInfo.Email,
-					Address:    employeeInfo.Address,
-					CreatedAt:  utils.FormatedTime(employeeInfo.CreatedAt),
-					UpdatedAt:  utils.FormatedTime(employeeInfo.UpdatedAt),
-					PositionID: employeePosition.ID,
-					Position:   employeePosition.Position,
-					Department: employeePosition.Department,
-					Salary:     employeePosition.Salary,
-					StartDate:  utils.FormatedTime(employeePosition.StartDate),
+					EmployeeID:   employeeInfo.ID,
+					Name:         employeeInfo.Name,
+					Age:          employeeInfo.Age,
+					Phone:        employeeInfo.Phone,
+					Email:        employeeInfo.Email,
+					Address:      employeeInfo.Address,
+					TimeZone:     employeeInfo.TimeZone,
+					CreatedAt:    utils.FormatedTime(employeeInfo.CreatedAt),
+					UpdatedAt:    utils.FormatedTime(employeeInfo.UpdatedAt),
+					Version:      employeeInfo.Version,
+					PositionID:   employeePosition.ID,
+					Position:     employeePosition.Position,
+					DepartmentID: employeePosition.DepartmentID,
+					Department:   employeePosition.Department,
+					Salary:       employeePosition.Salary,
+					StartDate:    utils.FormatedTime(employeePosition.StartDate),
 				}
 
 				s.cacheManager.EXPECT().
@@ -102,10 +112,77 @@ func TestGet(t *testing.T) {
 					So(actualResponse.PositionID, ShouldEqual, expectedResponse.PositionID)
 					So(actualResponse.Position, ShouldEqual, expectedResponse.Position)
 					So(actualResponse.Department, ShouldEqual, expectedResponse.Department)
-					So(actualResponse.Salary, ShouldEqual, expectedResponse.Salary)
+					So(actualResponse.Salary, ShouldResemble, expectedResponse.Salary)
 					So(actualResponse.CreatedAt, ShouldEqual, expectedResponse.CreatedAt)
 					So(actualResponse.UpdatedAt, ShouldEqual, expectedResponse.UpdatedAt)
 					So(actualResponse.StartDate, ShouldEqual, expectedResponse.StartDate)
+					So(actualResponse.Version, ShouldEqual, expectedResponse.Version)
+				})
+			})
+
+			Convey("When the cached employee detail predates versioning", func() {
+				// Entries without a version cannot produce an entity tag
+				s.cacheManager.EXPECT().
+					GetEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(&dtos.EmployeeV1Response{EmployeeID: employeeID}, nil)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), s.db, employeeID).
+					Return(employeeInfo, nil)
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					Return(employeePosition, nil)
+				s.cacheManager.EXPECT().
+					SetEmployeeDetailV1(gomock.Any(), employeeID, expectedResponse, gomock.Any()).
+					Return(nil)
+
+				var actualResponse dtos.EmployeeV1Response
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the employee should be read from the database", func() {
+					So(actualResponse.Name, ShouldEqual, expectedResponse.Name)
+					So(actualResponse.Version, ShouldEqual, expectedResponse.Version)
+				})
+			})
+
+			Convey("When asking for RFC 3339 times", func() {
+				// The cache only holds V1 responses, so it is not read
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), s.db, employeeID).
+					Return(employeeInfo, nil)
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					Return(employeePosition, nil)
+				s.cacheManager.EXPECT().
+					SetEmployeeDetailV1(gomock.Any(), employeeID, expectedResponse, gomock.Any()).
+					Return(nil)
+
+				header := http.Header{}
+				header.Set(utils.TimeFormatHeader, string(utils.TimeFormatRFC3339))
+				var actualResponse dtos.EmployeeV1Response
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123",
+					header,
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the times should carry their offset", func() {
+					So(actualResponse.TimeZone, ShouldEqual, "Asia/Taipei")
+					So(actualResponse.CreatedAt, ShouldEqual, "2023-06-14T12:00:00Z")
+					So(actualResponse.UpdatedAt, ShouldEqual, "2023-06-15T00:00:00Z")
 				})
 			})
 
@@ -157,7 +234,7 @@ func TestGet(t *testing.T) {
 					So(actualResponse.PositionID, ShouldEqual, expectedResponse.PositionID)
 					So(actualResponse.Position, ShouldEqual, expectedResponse.Position)
 					So(actualResponse.Department, ShouldEqual, expectedResponse.Department)
-					So(actualResponse.Salary, ShouldEqual, expectedResponse.Salary)
+					So(actualResponse.Salary, ShouldResemble, expectedResponse.Salary)
 					So(actualResponse.CreatedAt, ShouldEqual, expectedResponse.CreatedAt)
 					So(actualResponse.UpdatedAt, ShouldEqual, expectedResponse.UpdatedAt)
 					So(actualResponse.StartDate, ShouldEqual, expectedResponse.StartDate)
@@ -0,0 +1,441 @@
+package employee
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	importDateLayout = "2006-01-02"
+	importFileField  = "file"
+)
+
+// importColumns are the json names of the CreateRequest fields
+var importColumns = []string{
+	"name",
+	"age",
+	"address",
+	"phone",
+	"email",
+	"position",
+	"department_id",
+	"salary",
+	"start_date",
+}
+
+// importOptionalColumns may be left out of the header
+var importOptionalColumns = []string{
+	"time_zone",
+}
+
+type ImportRequest struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type ImportRowError struct {
+	// Row is the line of the row in the CSV file, the header being line 1
+	Row    int      `json:"row"`
+	Errors []string `json:"errors"`
+}
+
+type ImportCreatedRow struct {
+	Row        int                             `json:"row"`
+	EmployeeID int64                           `json:"employee_id"`
+	PositionID int64                           `json:"position_id"`
+	SalaryBand *dtos.SalaryBandCheckV1Response `json:"salary_band"`
+}
+
+type ImportResponse struct {
+	DryRun    bool               `json:"dry_run"`
+	TotalRows int                `json:"total_rows"`
+	ValidRows int                `json:"valid_rows"`
+	Created   []ImportCreatedRow `json:"created"`
+	Rejected  []ImportRowError   `json:"rejected"`
+}
+
+type importRow struct {
+	row int
+	req CreateRequest
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Import(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	var req ImportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, c.cfg.ImportMaxBytes)
+	file, err := openImportFile(ctx)
+	if err != nil {
+		respondImportFileError(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	rows, rejected, err := c.parseImportFile(file)
+	if err != nil {
+		respondImportFileError(ctx, err)
+		return
+	}
+	rows, rejected, err = c.checkImportRows(ctx, rows, rejected)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to check import rows")
+		utils.RespondError(ctx, err, "failed to check import rows")
+		return
+	}
+
+	response := ImportResponse{
+		DryRun:    req.DryRun,
+		TotalRows: len(rows) + len(rejected),
+		ValidRows: len(rows),
+		Created:   []ImportCreatedRow{},
+		Rejected:  rejected,
+	}
+	if req.DryRun {
+		ctx.JSON(http.StatusOK, response)
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	// Every batch is a unit of work, a failing row rolls back its whole batch
+	nowTime := c.timeModule.Now()
+	for _, batch := range lo.Chunk(rows, c.cfg.ImportBatchSize) {
+		created := make([]ImportCreatedRow, 0, len(batch))
+		failedRow := 0
+		err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+			for _, row := range batch {
+				employeeInfo, employeePosition := newEmployeeFromCreateRequest(row.req)
+				salaryBand, err := c.createEmployee(ctx, tx.DB, employeeInfo, employeePosition, nowTime)
+				if err != nil {
+					failedRow = row.row
+					return err
+				}
+				created = append(created, ImportCreatedRow{
+					Row:        row.row,
+					EmployeeID: employeeInfo.ID,
+					PositionID: employeePosition.ID,
+					SalaryBand: salaryBand,
+				})
+			}
+			return nil
+		})
+		if err == nil {
+			response.Created = append(response.Created, created...)
+			continue
+		}
+
+		logger.Error().Err(err).Int("row", failedRow).Msg("Failed to import employee batch")
+		for _, row := range batch {
+			message := "rolled back with its batch"
+			if row.row == failedRow || failedRow == 0 {
+				message = err.Error()
+			}
+			response.Rejected = append(response.Rejected, ImportRowError{
+				Row:    row.row,
+				Errors: []string{message},
+			})
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// openImportFile returns the CSV sent either as the "file" field of a
+// multipart form or as the raw request body.
+func openImportFile(ctx *gin.Context) (io.ReadCloser, error) {
+	if ctx.ContentType() == binding.MIMEMultipartPOSTForm {
+		header, err := ctx.FormFile(importFileField)
+		if err != nil {
+			return nil, fmt.Errorf("missing csv file: %w", err)
+		}
+		return header.Open()
+	}
+
+	return ctx.Request.Body, nil
+}
+
+func respondImportFileError(ctx *gin.Context, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "csv file is too large"})
+		return
+	}
+	ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
+
+// parseImportFile validates every row of the file. It only fails when the
+// file as a whole is unusable, the invalid rows are returned as rejected.
+func (c *Controller) parseImportFile(file io.Reader) ([]importRow, []ImportRowError, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("empty csv file")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+	columns, err := importColumnIndexes(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := []importRow{}
+	rejected := []ImportRowError{}
+	emails := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		// Malformed rows count too, they are read all the same
+		if len(rows)+len(rejected) >= c.cfg.ImportMaxRows {
+			return nil, nil, fmt.Errorf("too many rows, at most %d are allowed", c.cfg.ImportMaxRows)
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, fmt.Errorf("failed to read csv: %w", err)
+			}
+			// The positions of the fields are only known after a successful read
+			rejected = append(rejected, ImportRowError{Row: parseErr.StartLine, Errors: []string{parseErr.Err.Error()}})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		req, rowErrors := parseImportRecord(record, columns, c.cfg.DefaultCurrency)
+		if row, ok := emails[strings.ToLower(req.Email)]; ok && req.Email != "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("email: duplicates row %d", row))
+		}
+		if len(rowErrors) > 0 {
+			rejected = append(rejected, ImportRowError{Row: line, Errors: rowErrors})
+			continue
+		}
+
+		emails[strings.ToLower(req.Email)] = line
+		rows = append(rows, importRow{row: line, req: req})
+	}
+
+	return rows, rejected, nil
+}
+
+// checkImportRows rejects the rows the creation would refuse, so that a dry
+// run reports them too and a refused row does not roll back its batch.
+func (c *Controller) checkImportRows(ctx context.Context, rows []importRow, rejected []ImportRowError) ([]importRow, []ImportRowError, error) {
+	for _, check := range []func(context.Context, []importRow) (map[int]string, error){
+		c.checkImportDepartments,
+		c.checkImportEmails,
+		c.checkImportSalaryBands,
+	} {
+		if len(rows) == 0 {
+			break
+		}
+		rowErrors, err := check(ctx, rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = lo.Filter(rows, func(row importRow, _ int) bool {
+			rowError, ok := rowErrors[row.row]
+			if ok {
+				rejected = append(rejected, ImportRowError{Row: row.row, Errors: []string{rowError}})
+			}
+			return !ok
+		})
+	}
+	slices.SortFunc(rejected, func(a, b ImportRowError) int { return a.Row - b.Row })
+
+	return rows, rejected, nil
+}
+
+// checkImportDepartments returns the rows referencing an unknown department
+func (c *Controller) checkImportDepartments(ctx context.Context, rows []importRow) (map[int]string, error) {
+	known := map[int64]bool{}
+	for _, departmentID := range lo.Uniq(lo.Map(rows, func(row importRow, _ int) int64 { return row.req.DepartmentID })) {
+		department, err := c.departmentRepo.Get(ctx, c.db, departmentID)
+		if err != nil {
+			return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
+		}
+		known[departmentID] = department != nil
+	}
+
+	rowErrors := map[int]string{}
+	for _, row := range rows {
+		if !known[row.req.DepartmentID] {
+			rowErrors[row.row] = "department_id: department not found"
+		}
+	}
+	return rowErrors, nil
+}
+
+// checkImportEmails returns the rows whose email an active employee uses
+func (c *Controller) checkImportEmails(ctx context.Context, rows []importRow) (map[int]string, error) {
+	emails := lo.Map(rows, func(row importRow, _ int) string { return row.req.Email })
+	employeeInfos, err := c.employeeInfoRepo.ListByEmails(ctx, c.db, emails)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to list employees")
+	}
+	used := map[string]int64{}
+	for _, employeeInfo := range employeeInfos {
+		used[strings.ToLower(employeeInfo.Email)] = employeeInfo.ID
+	}
+
+	rowErrors := map[int]string{}
+	for _, row := range rows {
+		if employeeID, ok := used[strings.ToLower(row.req.Email)]; ok {
+			rowErrors[row.row] = fmt.Sprintf("email: already used by employee %d", employeeID)
+		}
+	}
+	return rowErrors, nil
+}
+
+// checkImportSalaryBands returns the rows whose salary the salary band
+// policy refuses
+func (c *Controller) checkImportSalaryBands(ctx context.Context, rows []importRow) (map[int]string, error) {
+	rowErrors := map[int]string{}
+	for _, row := range rows {
+		_, employeePosition := newEmployeeFromCreateRequest(row.req)
+		_, err := c.checkSalaryBand(ctx, c.db, employeePosition)
+		var httpErr *utils.HttpError
+		if errors.As(err, &httpErr) && httpErr.Code == http.StatusBadRequest {
+			rowErrors[row.row] = "salary: " + httpErr.Message
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rowErrors, nil
+}
+
+func importColumnIndexes(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for i, column := range header {
+		// Spreadsheet exports often start with a byte order mark
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if !lo.Contains(importColumns, column) && !lo.Contains(importOptionalColumns, column) {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		if _, ok := columns[column]; ok {
+			return nil, fmt.Errorf("duplicate column %q", column)
+		}
+		columns[column] = i
+	}
+
+	for _, column := range importColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing column %q", column)
+		}
+	}
+
+	return columns, nil
+}
+
+func parseImportRecord(record []string, columns map[string]int, defaultCurrency string) (CreateRequest, []string) {
+	value := func(column string) string {
+		return strings.TrimSpace(record[columns[column]])
+	}
+
+	rowErrors := []string{}
+	req := CreateRequest{
+		Name:     value("name"),
+		Address:  value("address"),
+		Phone:    value("phone"),
+		Email:    value("email"),
+		Position: value("position"),
+	}
+	if _, ok := columns["time_zone"]; ok {
+		req.TimeZone = value("time_zone")
+	}
+
+	if raw := value("age"); raw != "" {
+		age, err := strconv.Atoi(raw)
+		if err != nil {
+			rowErrors = append(rowErrors, "age: must be an integer")
+		}
+		req.Age = age
+	}
+	if raw := value("department_id"); raw != "" {
+		departmentID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			rowErrors = append(rowErrors, "department_id: must be an integer")
+		}
+		req.DepartmentID = departmentID
+	}
+	// A salary may be followed by its currency, as exported
+	if raw := value("salary"); raw != "" {
+		salary, err := money.ParseText(raw, defaultCurrency)
+		if err != nil {
+			rowErrors = append(rowErrors, "salary: must be an amount, optionally followed by its currency")
+		} else if salary.Amount <= 0 {
+			rowErrors = append(rowErrors, "salary: must be positive")
+		}
+		req.Salary = salary
+	}
+	if raw := value("start_date"); raw != "" {
+		startDate, err := time.ParseInLocation(importDateLayout, raw, time.UTC)
+		if err != nil {
+			rowErrors = append(rowErrors, "start_date: must be a date formatted as "+importDateLayout)
+		}
+		req.StartDate = startDate.Unix()
+	}
+	if len(rowErrors) > 0 {
+		return req, rowErrors
+	}
+
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		var validationErrors validator.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			return req, []string{err.Error()}
+		}
+		for _, fieldErr := range validationErrors {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: failed on %s", importColumnName(fieldErr.Field()), fieldErr.Tag()))
+		}
+	}
+	// A missing salary is left zero, it has no binding tag
+	if req.Salary.IsZero() {
+		rowErrors = append(rowErrors, "salary: failed on required")
+	}
+
+	return req, rowErrors
+}
+
+// importColumnName maps a CreateRequest field to its column
+func importColumnName(field string) string {
+	var name strings.Builder
+	for i, r := range field {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			name.WriteByte('_')
+		}
+		name.WriteRune(r)
+	}
+	return strings.ToLower(name.String())
+}
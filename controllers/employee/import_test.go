@@ -0,0 +1,454 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImport(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a CSV file of employees", t, func() {
+			nowTime := time.Date(2023, 1, 15, 12, 0, 0, 0, time.UTC)
+			header := http.Header{"Content-Type": []string{"text/csv"}}
+			department := &models.Department{ID: 1, Name: "Engineering"}
+
+			csvFile := strings.Join([]string{
+				"name,age,address,phone,email,position,department_id,salary,start_date",
+				"John Doe,30,123 Main St,555-1234,john.doe@example.com,Developer,1,75000,2023-01-01",
+				"Jane Doe,abc,456 Main St,555-5678,jane.doe@example.com,Designer,2,65000,2023-02-01",
+				"Jim Doe,40,789 Main St,555-9012,jim.doe@example.com,Manager,1,95000,2023-03-01",
+				"Jack Doe,25,321 Main St,555-3456,JOHN.DOE@example.com,Developer,1,70000,01/04/2023",
+				"Jill Doe,35,654 Main St,555-7890,,Developer,1,80000,2023-05-01",
+			}, "\n")
+
+			Convey("When importing with dry_run", func() {
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(1)).
+					Return(department, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByEmails(gomock.Any(), s.db, []string{"john.doe@example.com", "jim.doe@example.com"}).
+					Return(nil, nil)
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), s.db, gomock.Any(), "USD", gomock.Any()).
+					Return(nil, nil).
+					Times(2)
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import?dry_run=true",
+					header,
+					csvFile,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then every invalid row should be reported without creating anything", func() {
+					So(actualResponse.DryRun, ShouldBeTrue)
+					So(actualResponse.TotalRows, ShouldEqual, 5)
+					So(actualResponse.ValidRows, ShouldEqual, 2)
+					So(actualResponse.Created, ShouldBeEmpty)
+					So(actualResponse.Rejected, ShouldResemble, []ImportRowError{
+						{Row: 3, Errors: []string{"age: must be an integer"}},
+						{Row: 5, Errors: []string{
+							"start_date: must be a date formatted as 2006-01-02",
+							"email: duplicates row 2",
+						}},
+						{Row: 6, Errors: []string{"email: failed on required"}},
+					})
+				})
+			})
+
+			Convey("When the file has a time zone column", func() {
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(1)).
+					Return(department, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByEmails(gomock.Any(), s.db, []string{"john.doe@example.com"}).
+					Return(nil, nil)
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), s.db, gomock.Any(), "USD", gomock.Any()).
+					Return(nil, nil)
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import?dry_run=true",
+					header,
+					strings.Join([]string{
+						"name,age,address,phone,email,position,department_id,salary,start_date,time_zone",
+						"John Doe,30,123 Main St,555-1234,john.doe@example.com,Developer,1,75000,2023-01-01,Asia/Taipei",
+						"Jim Doe,40,789 Main St,555-9012,jim.doe@example.com,Manager,1,95000,2023-03-01,Taipei",
+					}, "\n"),
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then unknown zones should be rejected", func() {
+					So(actualResponse.ValidRows, ShouldEqual, 1)
+					So(actualResponse.Rejected, ShouldResemble, []ImportRowError{
+						{Row: 3, Errors: []string{"time_zone: failed on timezone"}},
+					})
+				})
+			})
+
+			Convey("When importing the valid rows", func() {
+				// Checked before the import, then again by every created row
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(department, nil).
+					Times(3)
+				s.employeeInfoRepo.EXPECT().
+					ListByEmails(gomock.Any(), s.db, gomock.Any()).
+					Return(nil, nil)
+				// No band applies to the imported positions, checked twice too
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), gomock.Any(), "USD", gomock.Any()).
+					Return(nil, nil).
+					Times(4)
+
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				nextID := int64(0)
+				startDates := []int64{}
+				s.employeeInfoRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
+						nextID++
+						info.ID = nextID
+						return nil
+					}).
+					Times(2)
+				s.employeePositionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), nowTime).
+					DoAndReturn(func(_ interface{}, _ interface{}, pos *models.EmployeePosition, _ time.Time) error {
+						pos.ID = pos.EmployeeID + 100
+						startDates = append(startDates, pos.StartDate.Unix())
+						return nil
+					}).
+					Times(2)
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					csvFile,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the valid rows should be created in one batch", func() {
+					So(actualResponse.DryRun, ShouldBeFalse)
+					So(actualResponse.Created, ShouldResemble, []ImportCreatedRow{
+						{Row: 2, EmployeeID: 1, PositionID: 101},
+						{Row: 4, EmployeeID: 2, PositionID: 102},
+					})
+					So(actualResponse.Rejected, ShouldHaveLength, 3)
+					So(startDates, ShouldResemble, []int64{
+						time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+						time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC).Unix(),
+					})
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
+			})
+
+			Convey("When a dry run meets an existing email and a salary out of its band", func() {
+				s.controller.cfg.SalaryBandPolicy = salaryband.PolicyReject
+				defer func() { s.controller.cfg.SalaryBandPolicy = salaryband.PolicyFlag }()
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(1)).
+					Return(department, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByEmails(gomock.Any(), s.db, []string{"john.doe@example.com", "jim.doe@example.com"}).
+					Return([]*models.EmployeeInfo{{ID: 7, Email: "JIM.DOE@example.com"}}, nil)
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), s.db, "Developer", "USD", gomock.Any()).
+					Return(&models.SalaryBand{
+						ID:            4,
+						Position:      "Developer",
+						Currency:      "USD",
+						EffectiveDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+						Min:           5000000,
+						Mid:           6000000,
+						Max:           7000000,
+					}, nil)
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import?dry_run=true",
+					header,
+					csvFile,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then both rows should be rejected as the import would", func() {
+					So(actualResponse.ValidRows, ShouldEqual, 0)
+					So(actualResponse.Rejected, ShouldHaveLength, 5)
+					So(actualResponse.Rejected[0].Row, ShouldEqual, 2)
+					So(actualResponse.Rejected[0].Errors, ShouldHaveLength, 1)
+					So(actualResponse.Rejected[0].Errors[0], ShouldStartWith, "salary: salary is above the salary band maximum")
+					So(actualResponse.Rejected[2], ShouldResemble, ImportRowError{
+						Row:    4,
+						Errors: []string{"email: already used by employee 7"},
+					})
+				})
+			})
+
+			Convey("When a batch fails to be created", func() {
+				s.controller.cfg.ImportBatchSize = 1
+				defer func() { s.controller.cfg.ImportBatchSize = 100 }()
+
+				// Checked before the import, then again by both attempted rows
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(department, nil).
+					Times(3)
+				s.employeeInfoRepo.EXPECT().
+					ListByEmails(gomock.Any(), s.db, gomock.Any()).
+					Return(nil, nil)
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(4)
+
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				gomock.InOrder(
+					s.employeeInfoRepo.EXPECT().
+						Create(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
+							info.ID = 1
+							return nil
+						}),
+					s.employeeInfoRepo.EXPECT().
+						Create(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(errors.New("database error")),
+				)
+				s.employeePositionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), nowTime).
+					DoAndReturn(func(_ interface{}, _ interface{}, pos *models.EmployeePosition, _ time.Time) error {
+						pos.ID = 101
+						return nil
+					})
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					csvFile,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then only the rows of the failed batch should be rejected", func() {
+					So(actualResponse.Created, ShouldResemble, []ImportCreatedRow{
+						{Row: 2, EmployeeID: 1, PositionID: 101},
+					})
+					So(actualResponse.Rejected, ShouldContain, ImportRowError{
+						Row:    4,
+						Errors: []string{"failed to create employee info"},
+					})
+					So(s.mockDB.ExpectationsWereMet(), ShouldBeNil)
+				})
+			})
+
+			Convey("When a row references an unknown department", func() {
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(9)).
+					Return(nil, nil)
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import?dry_run=true",
+					header,
+					strings.Join([]string{
+						"name,age,address,phone,email,position,department_id,salary,start_date",
+						"John Doe,30,123 Main St,555-1234,john.doe@example.com,Developer,9,75000,2023-01-01",
+					}, "\n"),
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the row should be rejected", func() {
+					So(actualResponse.ValidRows, ShouldEqual, 0)
+					So(actualResponse.Rejected, ShouldResemble, []ImportRowError{
+						{Row: 2, Errors: []string{"department_id: department not found"}},
+					})
+				})
+			})
+
+			Convey("When a row has a malformed quote", func() {
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(3)).
+					Return(&models.Department{ID: 3, Name: "Design"}, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByEmails(gomock.Any(), s.db, []string{"john.doe@example.com"}).
+					Return(nil, nil)
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), s.db, gomock.Any(), "USD", gomock.Any()).
+					Return(nil, nil)
+
+				var actualResponse ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import?dry_run=true",
+					header,
+					strings.Join([]string{
+						"name,age,address,phone,email,position,department_id,salary,start_date",
+						`"x"y,3`,
+						"John Doe,30,123 Main St,555-1234,john.doe@example.com,Developer,3,75000,2023-01-01",
+					}, "\n"),
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the row should be rejected and the next rows read", func() {
+					So(actualResponse.ValidRows, ShouldEqual, 1)
+					So(actualResponse.Rejected, ShouldHaveLength, 1)
+					So(actualResponse.Rejected[0].Row, ShouldEqual, 2)
+					So(actualResponse.Rejected[0].Errors, ShouldResemble, []string{`extraneous or missing " in quoted-field`})
+				})
+			})
+
+			Convey("When the file is sent as a multipart form without the file field", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					http.Header{"Content-Type": []string{"multipart/form-data; boundary=x"}},
+					"--x--\r\n",
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate the missing file", func() {
+					So(actualResponse["error"], ShouldStartWith, "missing csv file")
+				})
+			})
+
+			Convey("When the header misses a column", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					"\ufeffName,age,address,phone,email,position,department_id,salary\n",
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should name the missing column", func() {
+					So(actualResponse["error"], ShouldEqual, `missing column "start_date"`)
+				})
+			})
+
+			Convey("When the header has an unknown column", func() {
+				var actualResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					"name,nickname\n",
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should name the unknown column", func() {
+					So(actualResponse["error"], ShouldEqual, `unknown column "nickname"`)
+				})
+			})
+
+			Convey("When the file has more rows than allowed", func() {
+				s.controller.cfg.ImportMaxRows = 2
+				defer func() { s.controller.cfg.ImportMaxRows = 5000 }()
+
+				var actualResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					csvFile,
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the whole import should be refused", func() {
+					So(actualResponse["error"], ShouldEqual, "too many rows, at most 2 are allowed")
+				})
+			})
+
+			Convey("When the file has more malformed rows than allowed", func() {
+				s.controller.cfg.ImportMaxRows = 2
+				defer func() { s.controller.cfg.ImportMaxRows = 5000 }()
+
+				var actualResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					"name,age,address,phone,email,position,department_id,salary,start_date\n"+strings.Repeat(`"x"y,3`+"\n", 3),
+					&actualResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the whole import should be refused", func() {
+					So(actualResponse["error"], ShouldEqual, "too many rows, at most 2 are allowed")
+				})
+			})
+
+			Convey("When the file is too large", func() {
+				s.controller.cfg.ImportMaxBytes = 64
+				defer func() { s.controller.cfg.ImportMaxBytes = 5242880 }()
+
+				var actualResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/import",
+					header,
+					csvFile,
+					&actualResponse,
+					http.StatusRequestEntityTooLarge,
+				)
+
+				Convey("Then the whole import should be refused", func() {
+					So(actualResponse["error"], ShouldEqual, "csv file is too large")
+				})
+			})
+		})
+	})
+}
@@ -6,18 +6,31 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/taskmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"gorm.io/gorm"
 )
 
 //go:generate mockgen -source=interface.go -destination=interface_mock.go -package=employee
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
 type TimeModule interface {
 	Now() time.Time
 }
 
 type EmployeeInfoRepo interface {
 	Create(ctx context.Context, tx *gorm.DB, data *models.EmployeeInfo) error
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
 	MustGet(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+	GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
 	Save(ctx context.Context, tx *gorm.DB, data *models.EmployeeInfo) error
+	List(ctx context.Context, tx *gorm.DB, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error)
+	ListByEmails(ctx context.Context, tx *gorm.DB, emails []string) ([]*models.EmployeeInfo, error)
+	Terminate(ctx context.Context, tx *gorm.DB, id int64, terminatedAt time.Time, reason string) error
+	Reinstate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
 }
 
 type EmployeePositionRepo interface {
@@ -25,10 +38,36 @@ type EmployeePositionRepo interface {
 	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeePosition, error)
 	GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error)
 	MustGet(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeePosition, error)
+	ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error)
+	ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error)
+	ListPending(ctx context.Context, tx *gorm.DB, nowtime time.Time) ([]*models.EmployeePosition, error)
+	ListPendingByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) ([]*models.EmployeePosition, error)
+	DeletePending(ctx context.Context, tx *gorm.DB, id int64, nowtime time.Time) (bool, error)
+}
+
+type EmployeeAttendanceRepo interface {
+	CloseOpenByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, clockOutTime time.Time) (*models.EmployeeAttendance, error)
+	ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error)
+}
+
+type DepartmentRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+}
+
+type SalaryBandRepo interface {
+	GetEffective(ctx context.Context, tx *gorm.DB, position string, currency string, date time.Time) (*models.SalaryBand, error)
+	List(ctx context.Context, tx *gorm.DB, position string) ([]*models.SalaryBand, error)
 }
 
 type CacheManager interface {
 	GetEmployeeDetailV1(ctx context.Context, employeeID int64) (*dtos.EmployeeV1Response, error)
 	SetEmployeeDetailV1(ctx context.Context, employeeID int64, data dtos.EmployeeV1Response, expired time.Duration) error
 	DeleteEmployeeDetailV1(ctx context.Context, employeeID int64) error
+	DeleteAttendanceV1(ctx context.Context, employeeID int64) error
+}
+
+type TaskPool interface {
+	GetCtx() context.Context
+	SubmitTask(task taskmanager.Task)
+	CancelTask(taskID string) error
 }
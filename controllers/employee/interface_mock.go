@@ -16,10 +16,51 @@ import (
 
 	dtos "github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	employeeinforepo "github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	taskmanager "github.com/WangWilly/labs-hr-go/pkgs/taskmanager"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
 
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
 // MockTimeModule is a mock of TimeModule interface.
 type MockTimeModule struct {
 	ctrl     *gomock.Controller
@@ -96,6 +137,66 @@ func (mr *MockEmployeeInfoRepoMockRecorder) Create(ctx, tx, data any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Create), ctx, tx, data)
 }
 
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockEmployeeInfoRepo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockEmployeeInfoRepoMockRecorder) GetForUpdate(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).GetForUpdate), ctx, tx, id)
+}
+
+// List mocks base method.
+func (m *MockEmployeeInfoRepo) List(ctx context.Context, tx *gorm.DB, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx, params)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEmployeeInfoRepoMockRecorder) List(ctx, tx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).List), ctx, tx, params)
+}
+
+// ListByEmails mocks base method.
+func (m *MockEmployeeInfoRepo) ListByEmails(ctx context.Context, tx *gorm.DB, emails []string) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmails", ctx, tx, emails)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmails indicates an expected call of ListByEmails.
+func (mr *MockEmployeeInfoRepoMockRecorder) ListByEmails(ctx, tx, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmails", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).ListByEmails), ctx, tx, emails)
+}
+
 // MustGet mocks base method.
 func (m *MockEmployeeInfoRepo) MustGet(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +212,21 @@ func (mr *MockEmployeeInfoRepoMockRecorder) MustGet(ctx, tx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGet", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).MustGet), ctx, tx, id)
 }
 
+// Reinstate mocks base method.
+func (m *MockEmployeeInfoRepo) Reinstate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reinstate", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reinstate indicates an expected call of Reinstate.
+func (mr *MockEmployeeInfoRepoMockRecorder) Reinstate(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reinstate", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Reinstate), ctx, tx, id)
+}
+
 // Save mocks base method.
 func (m *MockEmployeeInfoRepo) Save(ctx context.Context, tx *gorm.DB, data *models.EmployeeInfo) error {
 	m.ctrl.T.Helper()
@@ -125,6 +241,20 @@ func (mr *MockEmployeeInfoRepoMockRecorder) Save(ctx, tx, data any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Save), ctx, tx, data)
 }
 
+// Terminate mocks base method.
+func (m *MockEmployeeInfoRepo) Terminate(ctx context.Context, tx *gorm.DB, id int64, terminatedAt time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Terminate", ctx, tx, id, terminatedAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Terminate indicates an expected call of Terminate.
+func (mr *MockEmployeeInfoRepoMockRecorder) Terminate(ctx, tx, id, terminatedAt, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Terminate), ctx, tx, id, terminatedAt, reason)
+}
+
 // MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
 type MockEmployeePositionRepo struct {
 	ctrl     *gomock.Controller
@@ -163,6 +293,21 @@ func (mr *MockEmployeePositionRepoMockRecorder) Create(ctx, tx, data, nowtime an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeePositionRepo)(nil).Create), ctx, tx, data, nowtime)
 }
 
+// DeletePending mocks base method.
+func (m *MockEmployeePositionRepo) DeletePending(ctx context.Context, tx *gorm.DB, id int64, nowtime time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePending", ctx, tx, id, nowtime)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePending indicates an expected call of DeletePending.
+func (mr *MockEmployeePositionRepoMockRecorder) DeletePending(ctx, tx, id, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePending", reflect.TypeOf((*MockEmployeePositionRepo)(nil).DeletePending), ctx, tx, id, nowtime)
+}
+
 // Get mocks base method.
 func (m *MockEmployeePositionRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeePosition, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +338,66 @@ func (mr *MockEmployeePositionRepoMockRecorder) GetCurrentByEmployeeID(ctx, tx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).GetCurrentByEmployeeID), ctx, tx, employeeID, nowtime)
 }
 
+// ListByEmployeeID mocks base method.
+func (m *MockEmployeePositionRepo) ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeID", ctx, tx, employeeID)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeID indicates an expected call of ListByEmployeeID.
+func (mr *MockEmployeePositionRepoMockRecorder) ListByEmployeeID(ctx, tx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListByEmployeeID), ctx, tx, employeeID)
+}
+
+// ListCurrentByEmployeeIDs mocks base method.
+func (m *MockEmployeePositionRepo) ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrentByEmployeeIDs", ctx, tx, employeeIDs, nowtime)
+	ret0, _ := ret[0].(map[int64]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrentByEmployeeIDs indicates an expected call of ListCurrentByEmployeeIDs.
+func (mr *MockEmployeePositionRepoMockRecorder) ListCurrentByEmployeeIDs(ctx, tx, employeeIDs, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrentByEmployeeIDs", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListCurrentByEmployeeIDs), ctx, tx, employeeIDs, nowtime)
+}
+
+// ListPending mocks base method.
+func (m *MockEmployeePositionRepo) ListPending(ctx context.Context, tx *gorm.DB, nowtime time.Time) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, tx, nowtime)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockEmployeePositionRepoMockRecorder) ListPending(ctx, tx, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListPending), ctx, tx, nowtime)
+}
+
+// ListPendingByEmployeeID mocks base method.
+func (m *MockEmployeePositionRepo) ListPendingByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingByEmployeeID", ctx, tx, employeeID, nowtime)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingByEmployeeID indicates an expected call of ListPendingByEmployeeID.
+func (mr *MockEmployeePositionRepoMockRecorder) ListPendingByEmployeeID(ctx, tx, employeeID, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListPendingByEmployeeID), ctx, tx, employeeID, nowtime)
+}
+
 // MustGet mocks base method.
 func (m *MockEmployeePositionRepo) MustGet(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeePosition, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +413,153 @@ func (mr *MockEmployeePositionRepoMockRecorder) MustGet(ctx, tx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGet", reflect.TypeOf((*MockEmployeePositionRepo)(nil).MustGet), ctx, tx, id)
 }
 
+// MockEmployeeAttendanceRepo is a mock of EmployeeAttendanceRepo interface.
+type MockEmployeeAttendanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeAttendanceRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeAttendanceRepoMockRecorder is the mock recorder for MockEmployeeAttendanceRepo.
+type MockEmployeeAttendanceRepoMockRecorder struct {
+	mock *MockEmployeeAttendanceRepo
+}
+
+// NewMockEmployeeAttendanceRepo creates a new mock instance.
+func NewMockEmployeeAttendanceRepo(ctrl *gomock.Controller) *MockEmployeeAttendanceRepo {
+	mock := &MockEmployeeAttendanceRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeAttendanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeAttendanceRepo) EXPECT() *MockEmployeeAttendanceRepoMockRecorder {
+	return m.recorder
+}
+
+// CloseOpenByEmployeeID mocks base method.
+func (m *MockEmployeeAttendanceRepo) CloseOpenByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, clockOutTime time.Time) (*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseOpenByEmployeeID", ctx, tx, employeeID, clockOutTime)
+	ret0, _ := ret[0].(*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseOpenByEmployeeID indicates an expected call of CloseOpenByEmployeeID.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) CloseOpenByEmployeeID(ctx, tx, employeeID, clockOutTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseOpenByEmployeeID", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).CloseOpenByEmployeeID), ctx, tx, employeeID, clockOutTime)
+}
+
+// ListByEmployeeIDs mocks base method.
+func (m *MockEmployeeAttendanceRepo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeIDs", ctx, tx, employeeIDs, from, to)
+	ret0, _ := ret[0].([]*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeIDs indicates an expected call of ListByEmployeeIDs.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) ListByEmployeeIDs(ctx, tx, employeeIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeIDs", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).ListByEmployeeIDs), ctx, tx, employeeIDs, from, to)
+}
+
+// MockDepartmentRepo is a mock of DepartmentRepo interface.
+type MockDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepoMockRecorder is the mock recorder for MockDepartmentRepo.
+type MockDepartmentRepoMockRecorder struct {
+	mock *MockDepartmentRepo
+}
+
+// NewMockDepartmentRepo creates a new mock instance.
+func NewMockDepartmentRepo(ctrl *gomock.Controller) *MockDepartmentRepo {
+	mock := &MockDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepo) EXPECT() *MockDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDepartmentRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepartmentRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepartmentRepo)(nil).Get), ctx, tx, id)
+}
+
+// MockSalaryBandRepo is a mock of SalaryBandRepo interface.
+type MockSalaryBandRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSalaryBandRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSalaryBandRepoMockRecorder is the mock recorder for MockSalaryBandRepo.
+type MockSalaryBandRepoMockRecorder struct {
+	mock *MockSalaryBandRepo
+}
+
+// NewMockSalaryBandRepo creates a new mock instance.
+func NewMockSalaryBandRepo(ctrl *gomock.Controller) *MockSalaryBandRepo {
+	mock := &MockSalaryBandRepo{ctrl: ctrl}
+	mock.recorder = &MockSalaryBandRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSalaryBandRepo) EXPECT() *MockSalaryBandRepoMockRecorder {
+	return m.recorder
+}
+
+// GetEffective mocks base method.
+func (m *MockSalaryBandRepo) GetEffective(ctx context.Context, tx *gorm.DB, position, currency string, date time.Time) (*models.SalaryBand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffective", ctx, tx, position, currency, date)
+	ret0, _ := ret[0].(*models.SalaryBand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffective indicates an expected call of GetEffective.
+func (mr *MockSalaryBandRepoMockRecorder) GetEffective(ctx, tx, position, currency, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffective", reflect.TypeOf((*MockSalaryBandRepo)(nil).GetEffective), ctx, tx, position, currency, date)
+}
+
+// List mocks base method.
+func (m *MockSalaryBandRepo) List(ctx context.Context, tx *gorm.DB, position string) ([]*models.SalaryBand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx, position)
+	ret0, _ := ret[0].([]*models.SalaryBand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSalaryBandRepoMockRecorder) List(ctx, tx, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSalaryBandRepo)(nil).List), ctx, tx, position)
+}
+
 // MockCacheManager is a mock of CacheManager interface.
 type MockCacheManager struct {
 	ctrl     *gomock.Controller
@@ -232,6 +584,20 @@ func (m *MockCacheManager) EXPECT() *MockCacheManagerMockRecorder {
 	return m.recorder
 }
 
+// DeleteAttendanceV1 mocks base method.
+func (m *MockCacheManager) DeleteAttendanceV1(ctx context.Context, employeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendanceV1", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendanceV1 indicates an expected call of DeleteAttendanceV1.
+func (mr *MockCacheManagerMockRecorder) DeleteAttendanceV1(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendanceV1", reflect.TypeOf((*MockCacheManager)(nil).DeleteAttendanceV1), ctx, employeeID)
+}
+
 // DeleteEmployeeDetailV1 mocks base method.
 func (m *MockCacheManager) DeleteEmployeeDetailV1(ctx context.Context, employeeID int64) error {
 	m.ctrl.T.Helper()
@@ -274,3 +640,67 @@ func (mr *MockCacheManagerMockRecorder) SetEmployeeDetailV1(ctx, employeeID, dat
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmployeeDetailV1", reflect.TypeOf((*MockCacheManager)(nil).SetEmployeeDetailV1), ctx, employeeID, data, expired)
 }
+
+// MockTaskPool is a mock of TaskPool interface.
+type MockTaskPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskPoolMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskPoolMockRecorder is the mock recorder for MockTaskPool.
+type MockTaskPoolMockRecorder struct {
+	mock *MockTaskPool
+}
+
+// NewMockTaskPool creates a new mock instance.
+func NewMockTaskPool(ctrl *gomock.Controller) *MockTaskPool {
+	mock := &MockTaskPool{ctrl: ctrl}
+	mock.recorder = &MockTaskPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskPool) EXPECT() *MockTaskPoolMockRecorder {
+	return m.recorder
+}
+
+// CancelTask mocks base method.
+func (m *MockTaskPool) CancelTask(taskID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTask", taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelTask indicates an expected call of CancelTask.
+func (mr *MockTaskPoolMockRecorder) CancelTask(taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTask", reflect.TypeOf((*MockTaskPool)(nil).CancelTask), taskID)
+}
+
+// GetCtx mocks base method.
+func (m *MockTaskPool) GetCtx() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCtx")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// GetCtx indicates an expected call of GetCtx.
+func (mr *MockTaskPoolMockRecorder) GetCtx() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCtx", reflect.TypeOf((*MockTaskPool)(nil).GetCtx))
+}
+
+// SubmitTask mocks base method.
+func (m *MockTaskPool) SubmitTask(task taskmanager.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubmitTask", task)
+}
+
+// SubmitTask indicates an expected call of SubmitTask.
+func (mr *MockTaskPoolMockRecorder) SubmitTask(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTask", reflect.TypeOf((*MockTaskPool)(nil).SubmitTask), task)
+}
@@ -0,0 +1,116 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	defaultListLimit = 20
+)
+
+type ListRequest struct {
+	Name         string `form:"name"`
+	Email        string `form:"email"`
+	Department   string `form:"department"`
+	DepartmentID int64  `form:"department_id"`
+	Position     string `form:"position"`
+
+	// Sort is one of id, name or created_at, prefixed with "-" for descending order
+	Sort   string `form:"sort"   binding:"omitempty,oneof=id -id name -name created_at -created_at"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit"  binding:"omitempty,min=1,max=100"`
+}
+
+type ListResponse = dtos.PageV1Response[dtos.EmployeeV1Response]
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) List(ctx *gin.Context) {
+	var req ListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cursor, err := utils.DecodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	nowTime := c.timeModule.Now()
+	params := employeeinforepo.ListParams{
+		Name:         req.Name,
+		Email:        req.Email,
+		Department:   req.Department,
+		DepartmentID: req.DepartmentID,
+		Position:     req.Position,
+		AsOf:         nowTime,
+		SortBy:       employeeinforepo.ListSort(strings.TrimPrefix(req.Sort, "-")),
+		Desc:         strings.HasPrefix(req.Sort, "-"),
+		Cursor:       cursor,
+		// Fetch one extra row to know whether there is a next page
+		Limit: limit + 1,
+	}
+	employeeInfos, err := c.employeeInfoRepo.List(ctx, c.db, params)
+	if errors.Is(err, employeeinforepo.ErrInvalidCursor) {
+		// The cursor was issued for another sort
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employees"})
+		return
+	}
+
+	nextCursor := ""
+	if len(employeeInfos) > limit {
+		employeeInfos = employeeInfos[:limit]
+		nextCursor = utils.EncodeCursor(employeeinforepo.ListCursor(params.SortBy, employeeInfos[limit-1]))
+	}
+
+	employeeIDs := lo.Map(employeeInfos, func(info *models.EmployeeInfo, _ int) int64 {
+		return info.ID
+	})
+	employeePositions, err := c.employeePositionRepo.ListCurrentByEmployeeIDs(ctx, c.db, employeeIDs, nowTime)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	formatter := utils.GetTimeFormatter(ctx)
+	items := make([]dtos.EmployeeV1Response, 0, len(employeeInfos))
+	for _, employeeInfo := range employeeInfos {
+		employeePosition, ok := employeePositions[employeeInfo.ID]
+		if !ok {
+			// The position was replaced between the two queries
+			continue
+		}
+		items = append(items, newEmployeeV1Response(employeeInfo, employeePosition, formatter))
+	}
+
+	ctx.JSON(http.StatusOK, ListResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	})
+}
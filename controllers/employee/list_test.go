@@ -0,0 +1,195 @@
+package employee
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given employees exist in the system", t, func() {
+			// Setup test data
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+			employeeInfos := []*models.EmployeeInfo{
+				{ID: 1, Name: "Alice", Email: "alice@example.com", CreatedAt: nowTime.Add(-72 * time.Hour)},
+				{ID: 2, Name: "Bob", Email: "bob@example.com", CreatedAt: nowTime.Add(-48 * time.Hour)},
+				{ID: 3, Name: "Carol", Email: "carol@example.com", CreatedAt: nowTime.Add(-24 * time.Hour)},
+			}
+			employeePositions := map[int64]*models.EmployeePosition{
+				1: {ID: 11, EmployeeID: 1, Position: "Developer", Department: "Engineering", Salary: money.New(500000, "USD")},
+				2: {ID: 12, EmployeeID: 2, Position: "Developer", Department: "Engineering", Salary: money.New(520000, "USD")},
+				3: {ID: 13, EmployeeID: 3, Position: "Designer", Department: "Product", Salary: money.New(480000, "USD")},
+			}
+
+			Convey("When listing with filters and a page size", func(c C) {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					List(gomock.Any(), s.db, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error) {
+						c.So(params.Department, ShouldEqual, "Engineering")
+						c.So(params.Name, ShouldEqual, "o")
+						c.So(params.AsOf, ShouldEqual, nowTime)
+						c.So(params.SortBy, ShouldEqual, employeeinforepo.ListSortName)
+						c.So(params.Desc, ShouldBeTrue)
+						c.So(params.Cursor, ShouldBeNil)
+						c.So(params.Limit, ShouldEqual, 3)
+						return employeeInfos, nil
+					})
+
+				s.employeePositionRepo.EXPECT().
+					ListCurrentByEmployeeIDs(gomock.Any(), s.db, []int64{1, 2}, nowTime).
+					Return(employeePositions, nil)
+
+				var actualResponse ListResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee?department=Engineering&name=o&sort=-name&limit=2",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should contain the first page and a cursor", func() {
+					So(actualResponse.Items, ShouldHaveLength, 2)
+					So(actualResponse.Items[0].EmployeeID, ShouldEqual, 1)
+					So(actualResponse.Items[0].PositionID, ShouldEqual, 11)
+					So(actualResponse.Items[0].Department, ShouldEqual, "Engineering")
+					So(actualResponse.Items[1].EmployeeID, ShouldEqual, 2)
+
+					cursor, err := utils.DecodeCursor(actualResponse.NextCursor)
+					So(err, ShouldBeNil)
+					So(cursor, ShouldNotBeNil)
+					So(cursor.ID, ShouldEqual, 2)
+					So(cursor.Value, ShouldEqual, "Bob")
+				})
+			})
+
+			Convey("When listing the last page with a cursor", func(c C) {
+				cursor := utils.EncodeCursor(utils.Cursor{ID: 2})
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					List(gomock.Any(), s.db, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, params employeeinforepo.ListParams) ([]*models.EmployeeInfo, error) {
+						c.So(params.Cursor, ShouldNotBeNil)
+						c.So(params.Cursor.ID, ShouldEqual, 2)
+						c.So(params.Limit, ShouldEqual, defaultListLimit+1)
+						return employeeInfos[2:], nil
+					})
+
+				s.employeePositionRepo.EXPECT().
+					ListCurrentByEmployeeIDs(gomock.Any(), s.db, []int64{3}, nowTime).
+					Return(employeePositions, nil)
+
+				var actualResponse ListResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee?cursor="+cursor,
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should have no next cursor", func() {
+					So(actualResponse.Items, ShouldHaveLength, 1)
+					So(actualResponse.Items[0].EmployeeID, ShouldEqual, 3)
+					So(actualResponse.NextCursor, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the repository fails", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.employeeInfoRepo.EXPECT().
+					List(gomock.Any(), s.db, gomock.Any()).
+					Return(nil, errors.New("database error"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee",
+					nil,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to list employees")
+				})
+			})
+		})
+
+		Convey("Given an invalid list request", t, func() {
+			Convey("When sorting by an unsupported field", func() {
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee?sort=salary",
+					nil,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate a validation error", func() {
+					So(errorResponse["error"], ShouldNotBeEmpty)
+				})
+			})
+
+			Convey("When passing a cursor of another sort", func() {
+				s.timeModule.EXPECT().Now().Return(time.Now())
+
+				s.employeeInfoRepo.EXPECT().
+					List(gomock.Any(), s.db, gomock.Any()).
+					Return(nil, fmt.Errorf("%w: bad value", employeeinforepo.ErrInvalidCursor))
+
+				cursor := utils.EncodeCursor(utils.Cursor{Value: "Alice", ID: 1})
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee?sort=created_at&cursor="+cursor,
+					nil,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate an invalid cursor", func() {
+					So(errorResponse["error"], ShouldEqual, "invalid cursor")
+				})
+			})
+
+			Convey("When passing a malformed cursor", func() {
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee?cursor=%21%21",
+					nil,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate an invalid cursor", func() {
+					So(errorResponse["error"], ShouldEqual, "invalid cursor")
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,144 @@
+package employee
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type PatchResponse struct {
+	UpdateResponse
+	ChangedFields []string `json:"changed_fields"`
+}
+
+// Patch applies a JSON Merge Patch (RFC 7396) to the employee info. Members
+// set to null are cleared, members left out keep their current value.
+func (c *Controller) Patch(ctx *gin.Context) {
+	if ctx.ContentType() != utils.MergePatchContentType && ctx.ContentType() != binding.MIMEJSON {
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "content type must be " + utils.MergePatchContentType})
+		return
+	}
+
+	patch, err := ctx.GetRawData()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	id := ctx.Param("id")
+	// Convert id to int64
+	employeeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var employeeInfo *models.EmployeeInfo
+	var changed []string
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err = c.employeeInfoRepo.MustGet(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusNotFound, "employee not found")
+		}
+		if !matchIfMatch(ctx, employeeInfo) {
+			return errPreconditionFailed
+		}
+
+		req, err := mergeUpdateRequest(employeeInfo, patch)
+		if err != nil {
+			return utils.NewHttpError(http.StatusBadRequest, err.Error())
+		}
+
+		changed = applyUpdateRequest(employeeInfo, req)
+		if len(changed) == 0 {
+			return nil
+		}
+		if err := c.employeeInfoRepo.Save(ctx, tx.DB, employeeInfo); err != nil {
+			if errors.Is(err, employeeinforepo.ErrVersionConflict) {
+				return errPreconditionFailed
+			}
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to update employee info")
+		}
+
+		tx.AfterCommit(func() {
+			c.refreshEmployeeDetailCache(ctx, employeeInfo)
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to update employee info")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.Header(utils.ETagHeader, employeeInfoETag(employeeInfo))
+	ctx.JSON(http.StatusOK, PatchResponse{
+		UpdateResponse: newUpdateResponse(employeeInfo),
+		ChangedFields:  changed,
+	})
+}
+
+// mergeUpdateRequest applies the patch to the employee info and validates the
+// resulting document the same way as a full replacement.
+func mergeUpdateRequest(employeeInfo *models.EmployeeInfo, patch []byte) (UpdateRequest, error) {
+	var req UpdateRequest
+
+	current, err := json.Marshal(newUpdateRequest(employeeInfo))
+	if err != nil {
+		return req, err
+	}
+	patched, err := utils.MergePatch(current, patch)
+	if err != nil {
+		return req, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		return req, err
+	}
+	clearRemovedFields(&req)
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		return req, err
+	}
+
+	return req, nil
+}
+
+// clearRemovedFields turns the members removed by a null in the patch into
+// their zero value, so that the validation decides whether they may be empty.
+// A removed time zone is reset to UTC instead.
+func clearRemovedFields(req *UpdateRequest) {
+	if req.Name == nil {
+		req.Name = new(string)
+	}
+	if req.Age == nil {
+		req.Age = new(int)
+	}
+	if req.Address == nil {
+		req.Address = new(string)
+	}
+	if req.Phone == nil {
+		req.Phone = new(string)
+	}
+	if req.Email == nil {
+		req.Email = new(string)
+	}
+	if req.TimeZone == nil {
+		req.TimeZone = lo.ToPtr(models.DefaultTimeZone)
+	}
+}
@@ -0,0 +1,336 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatch(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee exists in the system", t, func() {
+			// Setup test data
+			employeeID := int64(123)
+			existingEmployeeInfo := func() *models.EmployeeInfo {
+				return &models.EmployeeInfo{
+					ID:       employeeID,
+					Name:     "Jane Smith",
+					Age:      28,
+					Address:  "456 Oak Avenue",
+					Phone:    "555-5678",
+					Email:    "jane.smith@example.com",
+					TimeZone: models.DefaultTimeZone,
+				}
+			}
+			header := http.Header{"Content-Type": []string{utils.MergePatchContentType}}
+
+			Convey("When clearing the address and changing the phone", func(c C) {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				patch := map[string]interface{}{
+					"address": nil,
+					"phone":   "555-0000",
+				}
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
+						c.So(info.Name, ShouldEqual, "Jane Smith")
+						c.So(info.Address, ShouldEqual, "")
+						c.So(info.Phone, ShouldEqual, "555-0000")
+						return nil
+					})
+				s.cacheManager.EXPECT().
+					GetEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				var actualResponse PatchResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					patch,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should list the changed fields", func() {
+					So(actualResponse.ID, ShouldEqual, employeeID)
+					So(actualResponse.Address, ShouldEqual, "")
+					So(actualResponse.Phone, ShouldEqual, "555-0000")
+					So(actualResponse.ChangedFields, ShouldResemble, []string{"address", "phone"})
+				})
+			})
+
+			Convey("When removing a time zone that was set", func(c C) {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				patch := map[string]interface{}{
+					"time_zone": nil,
+				}
+
+				employeeInfo := existingEmployeeInfo()
+				employeeInfo.TimeZone = "Europe/Berlin"
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
+						c.So(info.TimeZone, ShouldEqual, models.DefaultTimeZone)
+						return nil
+					})
+				s.cacheManager.EXPECT().
+					GetEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				var actualResponse PatchResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					patch,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the employee should be back on UTC", func() {
+					So(actualResponse.TimeZone, ShouldEqual, models.DefaultTimeZone)
+					So(actualResponse.ChangedFields, ShouldResemble, []string{"time_zone"})
+				})
+			})
+
+			Convey("When the patch does not change anything", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				patch := map[string]interface{}{
+					"name": "Jane Smith",
+				}
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+
+				var actualResponse PatchResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					patch,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then nothing should be saved", func() {
+					So(actualResponse.ChangedFields, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When clearing a required field", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				patch := map[string]interface{}{
+					"name": nil,
+				}
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					patch,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate a validation error", func() {
+					So(errorResponse["error"], ShouldContainSubstring, "Name")
+				})
+			})
+
+			Convey("When patching an unknown field", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				patch := map[string]interface{}{
+					"salary": 1000,
+				}
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					patch,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate the unknown field", func() {
+					So(errorResponse["error"], ShouldContainSubstring, "salary")
+				})
+			})
+
+			Convey("When the If-Match header does not match the employee", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					http.Header{
+						"Content-Type":      []string{utils.MergePatchContentType},
+						utils.IfMatchHeader: []string{`"stale"`},
+					},
+					map[string]interface{}{"age": 29},
+					&errorResponse,
+					http.StatusPreconditionFailed,
+				)
+
+				Convey("Then the response should indicate a failed precondition", func() {
+					So(errorResponse["error"], ShouldEqual, "employee has been modified")
+				})
+			})
+
+			Convey("When the employee is modified concurrently", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(employeeinforepo.ErrVersionConflict)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					map[string]interface{}{"age": 29},
+					&errorResponse,
+					http.StatusPreconditionFailed,
+				)
+
+				Convey("Then the response should indicate a failed precondition", func() {
+					So(errorResponse["error"], ShouldEqual, "employee has been modified")
+				})
+			})
+
+			Convey("When saving employee info fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				patch := map[string]interface{}{
+					"age": 29,
+				}
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo(), nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("database error"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					patch,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to update employee info")
+				})
+			})
+
+			Convey("When employee info is not found", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, errors.New("employee not found"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					header,
+					map[string]interface{}{"age": 29},
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate employee not found", func() {
+					So(errorResponse["error"], ShouldEqual, "employee not found")
+				})
+			})
+
+			Convey("When the content type is not supported", func() {
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPatch,
+					"/employee/123",
+					http.Header{"Content-Type": []string{"text/plain"}},
+					map[string]interface{}{"age": 29},
+					&errorResponse,
+					http.StatusUnsupportedMediaType,
+				)
+
+				Convey("Then the response should indicate the expected content type", func() {
+					So(errorResponse["error"], ShouldContainSubstring, utils.MergePatchContentType)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,103 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListPendingPositionsResponse struct {
+	EmployeeID int64                 `json:"employee_id"`
+	Positions  []PositionHistoryItem `json:"positions"`
+}
+
+func (c *Controller) ListPendingPositions(ctx *gin.Context) {
+	id := ctx.Param("id")
+	// Convert id to int64
+	employeeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	nowTime := c.timeModule.Now()
+	employeePositions, err := c.employeePositionRepo.ListPendingByEmployeeID(ctx, c.db, employeeID, nowTime)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list pending employee positions"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ListPendingPositionsResponse{
+		EmployeeID: employeeID,
+		Positions:  buildPositionHistory(employeePositions, nowTime, utils.GetTimeFormatter(ctx)),
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CancelPendingPositionResponse struct {
+	EmployeeID int64 `json:"employee_id"`
+	PositionID int64 `json:"position_id"`
+}
+
+func (c *Controller) CancelPendingPosition(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	// Convert ids to int64
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+	positionID, err := strconv.ParseInt(ctx.Param("position_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid position id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeePosition, err := c.employeePositionRepo.Get(ctx, tx.DB, positionID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee position")
+		}
+		if employeePosition == nil || employeePosition.EmployeeID != employeeID {
+			return utils.NewHttpError(http.StatusNotFound, "employee position not found")
+		}
+
+		deleted, err := c.employeePositionRepo.DeletePending(ctx, tx.DB, positionID, c.timeModule.Now())
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to cancel employee position")
+		}
+		if !deleted {
+			return utils.NewHttpError(http.StatusConflict, "employee position already in effect")
+		}
+
+		tx.AfterCommit(func() {
+			c.cancelActivation(ctx, positionID)
+			if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
+				logger.Error().Err(err).Msg("Failed to delete employee detail cache")
+			}
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to cancel employee position")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, CancelPendingPositionResponse{
+		EmployeeID: employeeID,
+		PositionID: positionID,
+	})
+}
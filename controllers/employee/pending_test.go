@@ -0,0 +1,224 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/tasks"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPendingPositions(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with a future-dated promotion", t, func() {
+			// Setup test data
+			employeeID := int64(123)
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+			employeePositions := []*models.EmployeePosition{
+				{
+					ID:         3,
+					EmployeeID: employeeID,
+					Position:   "Lead Developer",
+					Department: "Engineering",
+					Salary:     money.New(800000, "USD"),
+					StartDate:  time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+				},
+			}
+
+			Convey("When listing the pending positions", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListPendingByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					Return(employeePositions, nil)
+
+				var actualResponse ListPendingPositionsResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions/pending",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the future position should be returned", func() {
+					So(actualResponse.EmployeeID, ShouldEqual, employeeID)
+					So(actualResponse.Positions, ShouldHaveLength, 1)
+					So(actualResponse.Positions[0].PositionID, ShouldEqual, 3)
+					So(actualResponse.Positions[0].IsFuture, ShouldBeTrue)
+				})
+			})
+
+			Convey("When there are no pending positions", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListPendingByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					Return(nil, nil)
+
+				var actualResponse ListPendingPositionsResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions/pending",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then an empty list should be returned", func() {
+					So(actualResponse.Positions, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the repository fails", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListPendingByEmployeeID(gomock.Any(), s.db, employeeID, nowTime).
+					Return(nil, errors.New("database error"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions/pending",
+					nil,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to list pending employee positions")
+				})
+			})
+		})
+	})
+}
+
+func TestCancelPendingPosition(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with a future-dated promotion", t, func() {
+			// Setup test data
+			employeeID := int64(123)
+			positionID := int64(3)
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+			employeePosition := &models.EmployeePosition{
+				ID:         positionID,
+				EmployeeID: employeeID,
+				Position:   "Lead Developer",
+				Department: "Engineering",
+				Salary:     money.New(800000, "USD"),
+				StartDate:  time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			}
+
+			Convey("When cancelling the pending position", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.employeePositionRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), positionID).
+					Return(employeePosition, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					DeletePending(gomock.Any(), gomock.Any(), positionID, nowTime).
+					Return(true, nil)
+				s.taskPool.EXPECT().
+					CancelTask(tasks.PositionActivationTaskID(positionID)).
+					Return(nil)
+				s.cacheManager.EXPECT().
+					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil)
+
+				var actualResponse CancelPendingPositionResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123/positions/3",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the cancelled position should be returned", func() {
+					So(actualResponse.EmployeeID, ShouldEqual, employeeID)
+					So(actualResponse.PositionID, ShouldEqual, positionID)
+				})
+			})
+
+			Convey("When the position is already in effect", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeePositionRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), positionID).
+					Return(employeePosition, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					DeletePending(gomock.Any(), gomock.Any(), positionID, nowTime).
+					Return(false, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123/positions/3",
+					nil,
+					&errorResponse,
+					http.StatusConflict,
+				)
+
+				Convey("Then the response should indicate a conflict", func() {
+					So(errorResponse["error"], ShouldEqual, "employee position already in effect")
+				})
+			})
+
+			Convey("When the position belongs to another employee", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeePositionRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), positionID).
+					Return(employeePosition, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/456/positions/3",
+					nil,
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate not found", func() {
+					So(errorResponse["error"], ShouldEqual, "employee position not found")
+				})
+			})
+
+			Convey("When providing an invalid position ID", func() {
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodDelete,
+					"/employee/123/positions/invalid-id",
+					nil,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate invalid position ID", func() {
+					So(errorResponse["error"], ShouldEqual, "invalid position id")
+				})
+			})
+		})
+	})
+}
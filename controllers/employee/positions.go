@@ -0,0 +1,110 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type PositionHistoryItem struct {
+	PositionID   int64       `json:"position_id"`
+	Position     string      `json:"position"`
+	DepartmentID int64       `json:"department_id"`
+	Department   string      `json:"department"`
+	Salary       money.Money `json:"salary"`
+	// SalaryDelta is the raise from the previous position, null for the
+	// first one and after a change of currency
+	SalaryDelta *money.Money `json:"salary_delta"`
+	StartDate   string       `json:"start_date"`
+	// EndDate is the last day of the position, empty while it is open-ended
+	EndDate   string `json:"end_date"`
+	IsCurrent bool   `json:"is_current"`
+	IsFuture  bool   `json:"is_future"`
+}
+
+type ListPositionsResponse struct {
+	EmployeeID int64                 `json:"employee_id"`
+	Positions  []PositionHistoryItem `json:"positions"`
+}
+
+func (c *Controller) ListPositions(ctx *gin.Context) {
+	id := ctx.Param("id")
+	// Convert id to int64
+	employeeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employeePositions, err := c.employeePositionRepo.ListByEmployeeID(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+	if len(employeePositions) == 0 {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee positions not found"})
+		return
+	}
+
+	nowTime := c.timeModule.Now()
+	ctx.JSON(http.StatusOK, ListPositionsResponse{
+		EmployeeID: employeeID,
+		Positions:  buildPositionHistory(employeePositions, nowTime, utils.GetTimeFormatter(ctx)),
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// buildPositionHistory expects the positions ordered by start date.
+func buildPositionHistory(
+	employeePositions []*models.EmployeePosition,
+	nowTime time.Time,
+	formatter utils.TimeFormatter,
+) []PositionHistoryItem {
+	// The current position is the last one already in effect
+	currentIdx := -1
+	for i, employeePosition := range employeePositions {
+		if !employeePosition.StartDate.After(nowTime) {
+			currentIdx = i
+		}
+	}
+
+	items := make([]PositionHistoryItem, 0, len(employeePositions))
+	for i, employeePosition := range employeePositions {
+		item := PositionHistoryItem{
+			PositionID:   employeePosition.ID,
+			Position:     employeePosition.Position,
+			DepartmentID: employeePosition.DepartmentID,
+			Department:   employeePosition.Department,
+			Salary:       employeePosition.Salary,
+			StartDate:    formatter.Time(employeePosition.StartDate),
+			IsCurrent:    i == currentIdx,
+			IsFuture:     employeePosition.StartDate.After(nowTime),
+		}
+		if i > 0 && employeePositions[i-1].Salary.Currency == employeePosition.Salary.Currency {
+			item.SalaryDelta = lo.ToPtr(employeePosition.Salary.Sub(employeePositions[i-1].Salary))
+		}
+		if i+1 < len(employeePositions) {
+			// A position ends the day before the next one starts, or on its
+			// own start day when the next one starts that same day
+			endDate := employeePositions[i+1].StartDate.AddDate(0, 0, -1)
+			if endDate.Before(employeePosition.StartDate) {
+				endDate = employeePosition.StartDate
+			}
+			item.EndDate = formatter.Time(endDate)
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -0,0 +1,173 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPositions(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with a promotion history", t, func() {
+			// Setup test data
+			employeeID := int64(123)
+			nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+			employeePositions := []*models.EmployeePosition{
+				{
+					ID:         1,
+					EmployeeID: employeeID,
+					Position:   "Developer",
+					Department: "Engineering",
+					Salary:     money.New(500000, "USD"),
+					StartDate:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:         2,
+					EmployeeID: employeeID,
+					Position:   "Senior Developer",
+					Department: "Engineering",
+					Salary:     money.New(650050, "USD"),
+					StartDate:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:         3,
+					EmployeeID: employeeID,
+					Position:   "Lead Developer",
+					Department: "Engineering",
+					Salary:     money.New(800000, "USD"),
+					StartDate:  time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+				},
+			}
+
+			Convey("When listing the position history", func() {
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), s.db, employeeID).
+					Return(employeePositions, nil)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				var actualResponse ListPositionsResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the history should be ordered with computed end dates and deltas", func() {
+					So(actualResponse.EmployeeID, ShouldEqual, employeeID)
+					So(actualResponse.Positions, ShouldHaveLength, 3)
+
+					first := actualResponse.Positions[0]
+					So(first.PositionID, ShouldEqual, 1)
+					So(first.StartDate, ShouldEqual, "2022-01-01 00:00:00")
+					So(first.EndDate, ShouldEqual, "2022-12-31 00:00:00")
+					So(first.SalaryDelta, ShouldBeNil)
+					So(first.IsCurrent, ShouldBeFalse)
+					So(first.IsFuture, ShouldBeFalse)
+
+					second := actualResponse.Positions[1]
+					So(second.PositionID, ShouldEqual, 2)
+					So(second.EndDate, ShouldEqual, "2023-06-30 00:00:00")
+					So(*second.SalaryDelta, ShouldResemble, money.New(150050, "USD"))
+					So(second.IsCurrent, ShouldBeTrue)
+					So(second.IsFuture, ShouldBeFalse)
+
+					third := actualResponse.Positions[2]
+					So(third.PositionID, ShouldEqual, 3)
+					So(third.EndDate, ShouldBeEmpty)
+					So(*third.SalaryDelta, ShouldResemble, money.New(149950, "USD"))
+					So(third.IsCurrent, ShouldBeFalse)
+					So(third.IsFuture, ShouldBeTrue)
+				})
+			})
+
+			Convey("When two positions start on the same day", func() {
+				// The position was corrected on its first day
+				sameDay := []*models.EmployeePosition{
+					employeePositions[0],
+					{
+						ID:         4,
+						EmployeeID: employeeID,
+						Position:   "Backend Developer",
+						Department: "Engineering",
+						Salary:     money.New(520000, "USD"),
+						StartDate:  employeePositions[0].StartDate,
+					},
+				}
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), s.db, employeeID).
+					Return(sameDay, nil)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				var actualResponse ListPositionsResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the first one should end on its start day", func() {
+					So(actualResponse.Positions, ShouldHaveLength, 2)
+					So(actualResponse.Positions[0].StartDate, ShouldEqual, "2022-01-01 00:00:00")
+					So(actualResponse.Positions[0].EndDate, ShouldEqual, "2022-01-01 00:00:00")
+					So(actualResponse.Positions[1].EndDate, ShouldBeEmpty)
+					So(actualResponse.Positions[1].IsCurrent, ShouldBeTrue)
+				})
+			})
+
+			Convey("When the employee has no positions", func() {
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), s.db, employeeID).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions",
+					nil,
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate not found", func() {
+					So(errorResponse["error"], ShouldEqual, "employee positions not found")
+				})
+			})
+
+			Convey("When the repository fails", func() {
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), s.db, employeeID).
+					Return(nil, errors.New("database error"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodGet,
+					"/employee/123/positions",
+					nil,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to list employee positions")
+				})
+			})
+		})
+	})
+}
@@ -1,10 +1,15 @@
 package employee
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
@@ -13,15 +18,20 @@ import (
 ////////////////////////////////////////////////////////////////////////////////
 
 type PromoteRequest struct {
-	Position   string  `json:"position"   binding:"required"`
-	Department string  `json:"department" binding:"required"`
-	Salary     float64 `json:"salary"     binding:"required"`
-	StartDate  int64   `json:"start_date" binding:"required"`
+	Position     string `json:"position"      binding:"required"`
+	DepartmentID int64  `json:"department_id" binding:"required"`
+	// Salary is monthly, a bare number is in the default currency
+	Salary    money.Money `json:"salary"`
+	StartDate int64       `json:"start_date"    binding:"required"`
 }
 
 type PromoteResponse struct {
 	PositionID int64  `json:"position_id"`
 	StartDate  string `json:"start_date"`
+	// Pending is true when the position takes effect in the future
+	Pending bool `json:"pending"`
+	// SalaryBand is null when no band applies or the policy is off
+	SalaryBand *dtos.SalaryBandCheckV1Response `json:"salary_band"`
 }
 
 func (c *Controller) Promote(ctx *gin.Context) {
@@ -44,31 +54,78 @@ func (c *Controller) Promote(ctx *gin.Context) {
 		ctx.JSON(400, gin.H{"error": err.Error()})
 		return
 	}
+	salary, err := resolveSalary(req.Salary, c.cfg.DefaultCurrency)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
 
 	////////////////////////////////////////////////////////////////////////////
 
 	employeePosition := &models.EmployeePosition{
-		EmployeeID: employeeID,
-		Position:   req.Position,
-		Department: req.Department,
-		Salary:     req.Salary,
-		StartDate:  time.Unix(req.StartDate, 0),
+		EmployeeID:   employeeID,
+		Position:     req.Position,
+		DepartmentID: req.DepartmentID,
+		Salary:       salary,
+		StartDate:    time.Unix(req.StartDate, 0),
 	}
 	nowTime := c.timeModule.Now()
-	if err := c.employeePositionRepo.Create(ctx, c.db, employeePosition, nowTime); err != nil {
-		ctx.JSON(500, gin.H{"error": "failed to create employee position"})
+	pending := employeePosition.StartDate.After(nowTime)
+
+	var employeeInfo *models.EmployeeInfo
+	var salaryBand *dtos.SalaryBandCheckV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err = c.employeeInfoRepo.MustGet(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(404, "employee not found")
+		}
+		if !matchIfMatch(ctx, employeeInfo) {
+			return errPreconditionFailed
+		}
+
+		// A promotion changes the employee representation, so bump its version
+		if err := c.employeeInfoRepo.Save(ctx, tx.DB, employeeInfo); err != nil {
+			if errors.Is(err, employeeinforepo.ErrVersionConflict) {
+				return errPreconditionFailed
+			}
+			return utils.NewHttpError(500, "failed to update employee info")
+		}
+
+		if err := c.setPositionDepartment(ctx, tx.DB, employeePosition); err != nil {
+			return err
+		}
+		salaryBand, err = c.checkSalaryBand(ctx, tx.DB, employeePosition)
+		if err != nil {
+			return err
+		}
+		if err := c.employeePositionRepo.Create(ctx, tx.DB, employeePosition, nowTime); err != nil {
+			return utils.NewHttpError(500, "failed to create employee position")
+		}
+
+		tx.AfterCommit(func() {
+			if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
+				logger.Error().Err(err).Msg("Failed to delete employee detail cache")
+			}
+
+			// Refresh the cache again once a future-dated position takes effect
+			if pending {
+				c.scheduleActivation(employeePosition)
+			}
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to promote employee")
 		return
 	}
 
 	////////////////////////////////////////////////////////////////////////////
 
-	if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
-		logger.Error().Err(err).Msg("Failed to delete employee detail cache")
-	}
-
 	response := PromoteResponse{
 		PositionID: employeePosition.ID,
-		StartDate:  utils.FormatedTime(employeePosition.StartDate),
+		StartDate:  utils.GetTimeFormatter(ctx).Time(employeePosition.StartDate),
+		Pending:    pending,
+		SalaryBand: salaryBand,
 	}
+	ctx.Header(utils.ETagHeader, employeeInfoETag(employeeInfo))
 	ctx.JSON(200, response)
 }
@@ -7,6 +7,12 @@ import (
 	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
+	"github.com/WangWilly/labs-hr-go/pkgs/taskmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/tasks"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
 )
@@ -21,34 +27,74 @@ func TestPromote(t *testing.T) {
 
 			// Position data for promotion
 			newPosition := &models.EmployeePosition{
-				ID:         456,
-				EmployeeID: employeeID,
-				Position:   "Senior Manager",
-				Department: "Operations",
-				Salary:     120000.00,
-				StartDate:  time.Unix(startDate, 0),
+				ID:           456,
+				EmployeeID:   employeeID,
+				Position:     "Senior Manager",
+				DepartmentID: 5,
+				Department:   "Operations",
+				Salary:       money.New(12000000, "USD"),
+				StartDate:    time.Unix(startDate, 0),
+			}
+
+			// Employee being promoted
+			employeeInfo := &models.EmployeeInfo{
+				ID:        employeeID,
+				Name:      "Jane Smith",
+				Version:   2,
+				UpdatedAt: nowTime.Add(-12 * time.Hour),
 			}
 
 			// Promotion request
 			req := PromoteRequest{
-				Position:   newPosition.Position,
-				Department: newPosition.Department,
-				Salary:     newPosition.Salary,
-				StartDate:  startDate,
+				Position:     newPosition.Position,
+				DepartmentID: newPosition.DepartmentID,
+				Salary:       newPosition.Salary,
+				StartDate:    startDate,
+			}
+
+			band := &models.SalaryBand{
+				ID:            7,
+				Position:      "Senior Manager",
+				Currency:      "USD",
+				EffectiveDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				Min:           8000000,
+				Mid:           10000000,
+				Max:           11000000,
 			}
+			s.controller.cfg.SalaryBandPolicy = salaryband.PolicyFlag
 
 			Convey("When promoting the employee to a new position", func(c C) {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
 				// Set up expectations
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(nil)
+
 				s.timeModule.EXPECT().Now().Return(nowTime)
 
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), newPosition.DepartmentID).
+					Return(&models.Department{ID: newPosition.DepartmentID, Name: newPosition.Department}, nil)
+
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), "Senior Manager", "USD", newPosition.StartDate).
+					Return(band, nil)
+
 				s.employeePositionRepo.EXPECT().
-					Create(gomock.Any(), s.db, gomock.Any(), nowTime).
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), nowTime).
 					DoAndReturn(func(_ interface{}, _ interface{}, position *models.EmployeePosition, _ time.Time) error {
 						// Verify the position data
 						c.So(position.EmployeeID, ShouldEqual, employeeID)
 						c.So(position.Position, ShouldEqual, req.Position)
-						c.So(position.Department, ShouldEqual, req.Department)
-						c.So(position.Salary, ShouldEqual, req.Salary)
+						c.So(position.DepartmentID, ShouldEqual, req.DepartmentID)
+						c.So(position.Department, ShouldEqual, newPosition.Department)
+						c.So(position.Salary, ShouldResemble, req.Salary)
 						c.So(position.StartDate, ShouldEqual, time.Unix(req.StartDate, 0))
 
 						// Set the ID for the response
@@ -61,9 +107,18 @@ func TestPromote(t *testing.T) {
 					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
 					Return(nil)
 
+				// Expect the activation of the future-dated position to be scheduled
+				s.taskPool.EXPECT().GetCtx().Return(t.Context())
+				s.taskPool.EXPECT().
+					SubmitTask(gomock.Any()).
+					Do(func(task taskmanager.Task) {
+						c.So(task.GetID(), ShouldEqual, tasks.PositionActivationTaskID(newPosition.ID))
+					})
+
 				// Expected response
 				expectedResponse := PromoteResponse{
 					PositionID: newPosition.ID,
+					Pending:    true,
 				}
 
 				// Make the request and verify response
@@ -79,6 +134,53 @@ func TestPromote(t *testing.T) {
 
 				Convey("Then the response should contain the correct position ID", func() {
 					So(actualResponse.PositionID, ShouldEqual, expectedResponse.PositionID)
+					So(actualResponse.Pending, ShouldEqual, expectedResponse.Pending)
+				})
+
+				Convey("Then the salary above its band should be flagged", func() {
+					So(actualResponse.SalaryBand, ShouldNotBeNil)
+					So(actualResponse.SalaryBand.Status, ShouldEqual, salaryband.StatusAbove)
+					So(actualResponse.SalaryBand.CompaRatio, ShouldEqual, 1.2)
+					So(actualResponse.SalaryBand.Max, ShouldResemble, money.New(11000000, "USD"))
+				})
+			})
+
+			Convey("When the salary is below its band under the reject policy", func() {
+				s.controller.cfg.SalaryBandPolicy = salaryband.PolicyReject
+				band.Min, band.Mid, band.Max = 13000000, 14000000, 15000000
+
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(nil)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), newPosition.DepartmentID).
+					Return(&models.Department{ID: newPosition.DepartmentID, Name: newPosition.Department}, nil)
+
+				s.salaryBandRepo.EXPECT().
+					GetEffective(gomock.Any(), gomock.Any(), "Senior Manager", "USD", newPosition.StartDate).
+					Return(band, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/promote/123",
+					req,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the promotion should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "salary is below the salary band minimum of 130000.00 USD")
 				})
 			})
 
@@ -123,11 +225,29 @@ func TestPromote(t *testing.T) {
 			})
 
 			Convey("When creating the position record fails", func() {
+				// Without a policy no band is looked up
+				s.controller.cfg.SalaryBandPolicy = salaryband.PolicyOff
+
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
 				// Set up expectations for failure
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(nil)
+
 				s.timeModule.EXPECT().Now().Return(nowTime)
 
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), newPosition.DepartmentID).
+					Return(&models.Department{ID: newPosition.DepartmentID, Name: newPosition.Department}, nil)
+
 				s.employeePositionRepo.EXPECT().
-					Create(gomock.Any(), s.db, gomock.Any(), nowTime).
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), nowTime).
 					Return(errors.New("database error"))
 
 					// Cache deletion should not be called when database operation fails
@@ -147,6 +267,119 @@ func TestPromote(t *testing.T) {
 					So(errorResponse["error"], ShouldEqual, "failed to create employee position")
 				})
 			})
+
+			Convey("When the department does not exist", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(nil)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), newPosition.DepartmentID).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/promote/123",
+					req,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate the missing department", func() {
+					So(errorResponse["error"], ShouldEqual, "department not found")
+				})
+			})
+
+			Convey("When the If-Match header does not match the employee", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/promote/123",
+					http.Header{utils.IfMatchHeader: []string{employeeETag(1, utils.FormatedTime(employeeInfo.UpdatedAt))}},
+					req,
+					&errorResponse,
+					http.StatusPreconditionFailed,
+				)
+
+				Convey("Then the response should indicate a failed precondition", func() {
+					So(errorResponse["error"], ShouldEqual, "employee has been modified")
+				})
+			})
+
+			Convey("When the employee is modified concurrently", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(employeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), employeeInfo).
+					Return(employeeinforepo.ErrVersionConflict)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/promote/123",
+					http.Header{utils.IfMatchHeader: []string{employeeInfoETag(employeeInfo)}},
+					req,
+					&errorResponse,
+					http.StatusPreconditionFailed,
+				)
+
+				Convey("Then the response should indicate a failed precondition", func() {
+					So(errorResponse["error"], ShouldEqual, "employee has been modified")
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, errors.New("employee info not found"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/promote/123",
+					req,
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate employee not found", func() {
+					So(errorResponse["error"], ShouldEqual, "employee not found")
+				})
+			})
 		})
 	})
 }
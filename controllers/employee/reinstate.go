@@ -0,0 +1,59 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ReinstateResponse struct {
+	EmployeeID int64 `json:"employee_id"`
+}
+
+func (c *Controller) Reinstate(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	id := ctx.Param("id")
+	// Convert id to int64
+	employeeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var employeeInfo *models.EmployeeInfo
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err = c.employeeInfoRepo.Reinstate(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to reinstate employee")
+		}
+		if employeeInfo == nil {
+			return utils.NewHttpError(http.StatusNotFound, "terminated employee not found")
+		}
+
+		tx.AfterCommit(func() {
+			if err := c.cacheManager.DeleteEmployeeDetailV1(ctx, employeeID); err != nil {
+				logger.Error().Err(err).Msg("Failed to delete employee detail cache")
+			}
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to reinstate employee")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, ReinstateResponse{
+		EmployeeID: employeeInfo.ID,
+	})
+}
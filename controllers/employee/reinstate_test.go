@@ -0,0 +1,95 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReinstate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a terminated employee", t, func() {
+			employeeID := int64(123)
+
+			Convey("When reinstating the employee", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.employeeInfoRepo.EXPECT().
+					Reinstate(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+
+				s.cacheManager.EXPECT().
+					DeleteEmployeeDetailV1(gomock.Any(), employeeID).
+					Return(nil)
+
+				var actualResponse ReinstateResponse
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/123/reinstate",
+					nil,
+					&actualResponse,
+					http.StatusOK,
+				)
+
+				Convey("Then the response should contain the employee ID", func() {
+					So(actualResponse.EmployeeID, ShouldEqual, employeeID)
+				})
+			})
+
+			Convey("When the employee is not terminated", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					Reinstate(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/123/reinstate",
+					nil,
+					&errorResponse,
+					http.StatusNotFound,
+				)
+
+				Convey("Then the response should indicate not found", func() {
+					So(errorResponse["error"], ShouldEqual, "terminated employee not found")
+				})
+			})
+
+			Convey("When the repository fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					Reinstate(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, errors.New("database error"))
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPost,
+					"/employee/123/reinstate",
+					nil,
+					&errorResponse,
+					http.StatusInternalServerError,
+				)
+
+				Convey("Then the response should indicate a server error", func() {
+					So(errorResponse["error"], ShouldEqual, "failed to reinstate employee")
+				})
+			})
+		})
+	})
+}
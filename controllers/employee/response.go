@@ -0,0 +1,35 @@
+package employee
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newEmployeeV1Response(
+	employeeInfo *models.EmployeeInfo,
+	employeePosition *models.EmployeePosition,
+	formatter utils.TimeFormatter,
+) dtos.EmployeeV1Response {
+	return dtos.EmployeeV1Response{
+		EmployeeID: employeeInfo.ID,
+		Name:       employeeInfo.Name,
+		Age:        employeeInfo.Age,
+		Phone:      employeeInfo.Phone,
+		Email:      employeeInfo.Email,
+		Address:    employeeInfo.Address,
+		TimeZone:   employeeInfo.TimeZone,
+		CreatedAt:  formatter.Time(employeeInfo.CreatedAt),
+		UpdatedAt:  formatter.Time(employeeInfo.UpdatedAt),
+		Version:    employeeInfo.Version,
+
+		PositionID:   employeePosition.ID,
+		Position:     employeePosition.Position,
+		DepartmentID: employeePosition.DepartmentID,
+		Department:   employeePosition.Department,
+		Salary:       employeePosition.Salary,
+		StartDate:    formatter.Time(employeePosition.StartDate),
+	}
+}
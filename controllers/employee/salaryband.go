@@ -0,0 +1,74 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// checkSalaryBand places the salary of a position in the band of its title
+// and currency in effect on its start date. It returns nil when the policy is
+// off or no band applies. Under the reject policy a salary out of its band is
+// refused, and so is a salary in a currency the title has no band in while it
+// has bands in others; any other policy only reports it. The returned error is
+// an HttpError meant for the client.
+func (c *Controller) checkSalaryBand(ctx context.Context, tx *gorm.DB, employeePosition *models.EmployeePosition) (*dtos.SalaryBandCheckV1Response, error) {
+	if c.cfg.SalaryBandPolicy == salaryband.PolicyOff {
+		return nil, nil
+	}
+
+	band, err := c.salaryBandRepo.GetEffective(
+		ctx, tx, employeePosition.Position, employeePosition.Salary.Currency, employeePosition.StartDate,
+	)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to get salary band")
+	}
+	if band == nil {
+		if c.cfg.SalaryBandPolicy == salaryband.PolicyReject {
+			return nil, c.checkOtherCurrencies(ctx, tx, employeePosition)
+		}
+		return nil, nil
+	}
+
+	check := salaryband.NewCheckV1Response(band, employeePosition.Salary)
+	if c.cfg.SalaryBandPolicy != salaryband.PolicyReject {
+		return &check, nil
+	}
+	switch check.Status {
+	case salaryband.StatusBelow:
+		return nil, utils.NewHttpError(http.StatusBadRequest, fmt.Sprintf("salary is below the salary band minimum of %s", check.Min))
+	case salaryband.StatusAbove:
+		return nil, utils.NewHttpError(http.StatusBadRequest, fmt.Sprintf("salary is above the salary band maximum of %s", check.Max))
+	}
+	return &check, nil
+}
+
+// checkOtherCurrencies refuses a salary escaping the bands of its title by
+// switching currency.
+func (c *Controller) checkOtherCurrencies(ctx context.Context, tx *gorm.DB, employeePosition *models.EmployeePosition) error {
+	bands, err := c.salaryBandRepo.List(ctx, tx, employeePosition.Position)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to list salary bands")
+	}
+
+	currencies := lo.Uniq(lo.FilterMap(bands, func(band *models.SalaryBand, _ int) (string, bool) {
+		return band.Currency, !band.EffectiveDate.After(employeePosition.StartDate)
+	}))
+	if len(currencies) == 0 {
+		return nil
+	}
+	return utils.NewHttpError(http.StatusBadRequest, fmt.Sprintf(
+		"no salary band of the position in %s, its bands are in %s",
+		employeePosition.Salary.Currency, strings.Join(currencies, ", "),
+	))
+}
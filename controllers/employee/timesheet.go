@@ -0,0 +1,67 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/timesheet"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type TimesheetRequest struct {
+	// Period is one of day, week or month, defaults to week
+	Period string `form:"period"`
+	// Start is the first day of the period, defaults to the current period
+	Start string `form:"start"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Timesheet(ctx *gin.Context) {
+	id := ctx.Param("id")
+	// Convert id to int64
+	employeeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req TimesheetRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employeeInfo, err := c.employeeInfoRepo.Get(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee"})
+		return
+	}
+	if employeeInfo == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	// The days are the calendar days of the employee's zone
+	nowTime := c.timeModule.Now()
+	period, from, to, err := timesheet.ParseWindow(req.Period, req.Start, nowTime, employeeInfo.Location())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	attendances, err := c.employeeAttendanceRepo.ListByEmployeeIDs(ctx, c.db, []int64{employeeID}, from, to)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list attendance"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	sheet := timesheet.Aggregate(attendances, from, to, nowTime)
+	ctx.JSON(http.StatusOK, timesheet.NewV1Response(employeeID, period, sheet))
+}
@@ -0,0 +1,144 @@
+package employee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTimesheet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with attendance sessions", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2025, 5, 8, 12, 0, 0, 0, time.UTC)
+			weekStart := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+
+			employeeInfo := &models.EmployeeInfo{ID: employeeID, Name: "John Doe", TimeZone: models.DefaultTimeZone}
+			attendances := []*models.EmployeeAttendance{
+				{
+					EmployeeID: employeeID,
+					ClockIn:    weekStart.Add(22 * time.Hour),
+					ClockOut:   lo.ToPtr(weekStart.Add(30 * time.Hour)),
+					Status:     models.AttendanceStatusClosed,
+				},
+				{
+					EmployeeID: employeeID,
+					ClockIn:    nowTime.Add(-2 * time.Hour),
+					Status:     models.AttendanceStatusOpen,
+				},
+			}
+
+			Convey("When getting the timesheet of the current week", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), s.db, employeeID).
+					Return(employeeInfo, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{employeeID}, weekStart, weekStart.AddDate(0, 0, 7)).
+					Return(attendances, nil)
+
+				var resp dtos.TimesheetV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/123/timesheet", nil, &resp, http.StatusOK)
+
+				Convey("Then it should hold the worked hours per day", func() {
+					So(resp.EmployeeID, ShouldEqual, employeeID)
+					So(resp.Period, ShouldEqual, "week")
+					So(resp.Start, ShouldEqual, "2025-05-05")
+					So(resp.End, ShouldEqual, "2025-05-11")
+					So(resp.Days, ShouldHaveLength, 7)
+					So(resp.Days[0].WorkedHours, ShouldEqual, 2)
+					So(resp.Days[1].WorkedHours, ShouldEqual, 6)
+					So(resp.Days[3].WorkedHours, ShouldEqual, 2)
+					So(resp.Days[3].Open, ShouldBeTrue)
+					So(resp.WorkedSeconds, ShouldEqual, int64(10*3600))
+					So(resp.Open, ShouldBeTrue)
+				})
+			})
+
+			Convey("When getting a month from an explicit start", func() {
+				monthStart := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), s.db, employeeID).
+					Return(employeeInfo, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{employeeID}, monthStart, monthStart.AddDate(0, 1, 0)).
+					Return(nil, nil)
+
+				var resp dtos.TimesheetV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/123/timesheet?period=month&start=2025-04-01", nil, &resp, http.StatusOK)
+
+				Convey("Then every day of the month should be listed", func() {
+					So(resp.Days, ShouldHaveLength, 30)
+					So(resp.End, ShouldEqual, "2025-04-30")
+					So(resp.WorkedSeconds, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the employee works in another zone", func() {
+				taipei := &models.EmployeeInfo{ID: employeeID, Name: "John Doe", TimeZone: "Asia/Taipei"}
+				loc := taipei.Location()
+				dayStart := time.Date(2025, 5, 6, 0, 0, 0, 0, loc)
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), s.db, employeeID).
+					Return(taipei, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{employeeID}, dayStart, dayStart.AddDate(0, 0, 1)).
+					Return(attendances[:1], nil)
+
+				var resp dtos.TimesheetV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/123/timesheet?period=day&start=2025-05-06", nil, &resp, http.StatusOK)
+
+				Convey("Then the days should be the days of the zone", func() {
+					// The night shift runs from 06:00 to 14:00 in Taipei
+					So(resp.Start, ShouldEqual, "2025-05-06")
+					So(resp.Days, ShouldHaveLength, 1)
+					So(resp.Days[0].WorkedHours, ShouldEqual, 8)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), s.db, employeeID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/123/timesheet", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When listing attendance fails", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), s.db, employeeID).
+					Return(employeeInfo, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/123/timesheet", nil, nil, http.StatusInternalServerError)
+			})
+
+			Convey("When the request is invalid", func() {
+				Convey("With a non-numeric ID", func() {
+					s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/abc/timesheet", nil, nil, http.StatusBadRequest)
+				})
+
+				Convey("With an unknown period", func() {
+					s.employeeInfoRepo.EXPECT().
+						Get(gomock.Any(), s.db, employeeID).
+						Return(employeeInfo, nil)
+					s.timeModule.EXPECT().Now().Return(nowTime)
+					s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/123/timesheet?period=year", nil, nil, http.StatusBadRequest)
+				})
+			})
+		})
+	})
+}
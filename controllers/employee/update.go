@@ -1,35 +1,43 @@
 package employee
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// UpdateRequest is the full representation of the employee info. Every field
+// must be present, while an empty string is accepted to clear the optional ones.
+// The time zone may be left out by clients predating it.
 type UpdateRequest struct {
-	Name    string `json:"name"   `
-	Age     int    `json:"age"    `
-	Address string `json:"address"`
-	Phone   string `json:"phone"  `
-	Email   string `json:"email"  `
+	Name     *string `json:"name"      binding:"required,min=1"`
+	Age      *int    `json:"age"       binding:"required,gt=0"`
+	Address  *string `json:"address"   binding:"required"`
+	Phone    *string `json:"phone"     binding:"required"`
+	Email    *string `json:"email"     binding:"required,min=1"`
+	TimeZone *string `json:"time_zone" binding:"omitempty,timezone"`
 }
 
 type UpdateResponse struct {
-	ID      int64  `json:"id"`
-	Name    string `json:"name"`
-	Age     int    `json:"age"`
-	Address string `json:"address"`
-	Phone   string `json:"phone"`
-	Email   string `json:"email"`
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Age      int    `json:"age"`
+	Address  string `json:"address"`
+	Phone    string `json:"phone"`
+	Email    string `json:"email"`
+	TimeZone string `json:"time_zone"`
 }
 
 func (c *Controller) Update(ctx *gin.Context) {
-	logger := log.Ctx(ctx.Request.Context())
-
 	var req UpdateRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -46,58 +54,117 @@ func (c *Controller) Update(ctx *gin.Context) {
 
 	////////////////////////////////////////////////////////////////////////////
 
-	employeeInfo, err := c.employeeInfoRepo.MustGet(ctx, c.db, employeeID)
-	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+	var employeeInfo *models.EmployeeInfo
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err = c.employeeInfoRepo.MustGet(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusNotFound, "employee not found")
+		}
+		if !matchIfMatch(ctx, employeeInfo) {
+			return errPreconditionFailed
+		}
+
+		applyUpdateRequest(employeeInfo, req)
+		if err := c.employeeInfoRepo.Save(ctx, tx.DB, employeeInfo); err != nil {
+			if errors.Is(err, employeeinforepo.ErrVersionConflict) {
+				return errPreconditionFailed
+			}
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to update employee info")
+		}
+
+		tx.AfterCommit(func() {
+			c.refreshEmployeeDetailCache(ctx, employeeInfo)
+		})
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to update employee info")
 		return
 	}
 
-	if req.Name != "" {
-		employeeInfo.Name = req.Name
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.Header(utils.ETagHeader, employeeInfoETag(employeeInfo))
+	ctx.JSON(http.StatusOK, newUpdateResponse(employeeInfo))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newUpdateRequest(employeeInfo *models.EmployeeInfo) UpdateRequest {
+	return UpdateRequest{
+		Name:     &employeeInfo.Name,
+		Age:      &employeeInfo.Age,
+		Address:  &employeeInfo.Address,
+		Phone:    &employeeInfo.Phone,
+		Email:    &employeeInfo.Email,
+		TimeZone: &employeeInfo.TimeZone,
+	}
+}
+
+func newUpdateResponse(employeeInfo *models.EmployeeInfo) UpdateResponse {
+	return UpdateResponse{
+		ID:       employeeInfo.ID,
+		Name:     employeeInfo.Name,
+		Age:      employeeInfo.Age,
+		Address:  employeeInfo.Address,
+		Phone:    employeeInfo.Phone,
+		Email:    employeeInfo.Email,
+		TimeZone: employeeInfo.TimeZone,
+	}
+}
+
+// applyUpdateRequest copies a validated request onto the employee info and
+// returns the json names of the fields whose value changed.
+func applyUpdateRequest(employeeInfo *models.EmployeeInfo, req UpdateRequest) []string {
+	changed := []string{}
+	if *req.Name != employeeInfo.Name {
+		employeeInfo.Name = *req.Name
+		changed = append(changed, "name")
 	}
-	if req.Age != 0 {
-		employeeInfo.Age = req.Age
+	if *req.Age != employeeInfo.Age {
+		employeeInfo.Age = *req.Age
+		changed = append(changed, "age")
 	}
-	if req.Address != "" {
-		employeeInfo.Address = req.Address
+	if *req.Address != employeeInfo.Address {
+		employeeInfo.Address = *req.Address
+		changed = append(changed, "address")
 	}
-	if req.Phone != "" {
-		employeeInfo.Phone = req.Phone
+	if *req.Phone != employeeInfo.Phone {
+		employeeInfo.Phone = *req.Phone
+		changed = append(changed, "phone")
 	}
-	if req.Email != "" {
-		employeeInfo.Email = req.Email
+	if *req.Email != employeeInfo.Email {
+		employeeInfo.Email = *req.Email
+		changed = append(changed, "email")
 	}
-
-	if err := c.employeeInfoRepo.Save(ctx, c.db, employeeInfo); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update employee info"})
-		return
+	if req.TimeZone != nil && *req.TimeZone != employeeInfo.TimeZone {
+		employeeInfo.TimeZone = *req.TimeZone
+		changed = append(changed, "time_zone")
 	}
 
-	////////////////////////////////////////////////////////////////////////////
+	return changed
+}
+
+func (c *Controller) refreshEmployeeDetailCache(ctx *gin.Context, employeeInfo *models.EmployeeInfo) {
+	logger := log.Ctx(ctx.Request.Context())
 
-	// Update the cache
-	employeeDetail, err := c.cacheManager.GetEmployeeDetailV1(ctx, employeeID)
+	employeeDetail, err := c.cacheManager.GetEmployeeDetailV1(ctx, employeeInfo.ID)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to get employee detail from cache")
+		return
 	}
-	if err == nil && employeeDetail != nil {
-		employeeDetail.Name = employeeInfo.Name
-		employeeDetail.Age = employeeInfo.Age
-		employeeDetail.Address = employeeInfo.Address
-		employeeDetail.Phone = employeeInfo.Phone
-		employeeDetail.Email = employeeInfo.Email
-
-		if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeID, *employeeDetail, 0); err != nil {
-			logger.Error().Err(err).Msg("Failed to cache employee detail")
-		}
+	if employeeDetail == nil {
+		return
 	}
 
-	ctx.JSON(http.StatusOK, UpdateResponse{
-		ID:      employeeInfo.ID,
-		Name:    employeeInfo.Name,
-		Age:     employeeInfo.Age,
-		Address: employeeInfo.Address,
-		Phone:   employeeInfo.Phone,
-		Email:   employeeInfo.Email,
-	})
+	employeeDetail.Name = employeeInfo.Name
+	employeeDetail.Age = employeeInfo.Age
+	employeeDetail.Address = employeeInfo.Address
+	employeeDetail.Phone = employeeInfo.Phone
+	employeeDetail.Email = employeeInfo.Email
+	employeeDetail.TimeZone = employeeInfo.TimeZone
+	employeeDetail.UpdatedAt = utils.FormatedTime(employeeInfo.UpdatedAt)
+	employeeDetail.Version = employeeInfo.Version
+	if err := c.cacheManager.SetEmployeeDetailV1(ctx, employeeInfo.ID, *employeeDetail, 0); err != nil {
+		logger.Error().Err(err).Msg("Failed to cache employee detail")
+	}
 }
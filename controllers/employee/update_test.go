@@ -8,7 +8,10 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/samber/lo"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
 )
@@ -28,35 +31,40 @@ func TestUpdate(t *testing.T) {
 				Address:   "456 Oak Avenue",
 				Phone:     "555-5678",
 				Email:     "jane.smith@example.com",
+				TimeZone:  models.DefaultTimeZone,
 				CreatedAt: nowTime.Add(-24 * time.Hour),
 				UpdatedAt: nowTime.Add(-12 * time.Hour),
 			}
 
 			// Updated employee data
 			updatedInfo := UpdateRequest{
-				Name:    "Jane Doe",
-				Age:     29,
-				Address: "789 Pine Street",
-				Phone:   "555-9876",
-				Email:   "jane.doe@example.com",
+				Name:    lo.ToPtr("Jane Doe"),
+				Age:     lo.ToPtr(29),
+				Address: lo.ToPtr("789 Pine Street"),
+				Phone:   lo.ToPtr("555-9876"),
+				Email:   lo.ToPtr("jane.doe@example.com"),
 			}
 
 			Convey("When updating the employee information and cache exists", func(c C) {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
 				// Set up expectations for successful update
 				s.employeeInfoRepo.EXPECT().
-					MustGet(gomock.Any(), s.db, employeeID).
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
 					Return(existingEmployeeInfo, nil)
 
 				s.employeeInfoRepo.EXPECT().
-					Save(gomock.Any(), s.db, gomock.Any()).
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
 						// Check that the employee info was updated correctly
 						c.So(info.ID, ShouldEqual, employeeID)
-						c.So(info.Name, ShouldEqual, updatedInfo.Name)
-						c.So(info.Age, ShouldEqual, updatedInfo.Age)
-						c.So(info.Address, ShouldEqual, updatedInfo.Address)
-						c.So(info.Phone, ShouldEqual, updatedInfo.Phone)
-						c.So(info.Email, ShouldEqual, updatedInfo.Email)
+						c.So(info.Name, ShouldEqual, *updatedInfo.Name)
+						c.So(info.Age, ShouldEqual, *updatedInfo.Age)
+						c.So(info.Address, ShouldEqual, *updatedInfo.Address)
+						c.So(info.Phone, ShouldEqual, *updatedInfo.Phone)
+						c.So(info.Email, ShouldEqual, *updatedInfo.Email)
 						return nil
 					})
 
@@ -73,7 +81,7 @@ func TestUpdate(t *testing.T) {
 					PositionID: 456,
 					Position:   "Senior Developer",
 					Department: "Engineering",
-					Salary:     95000.00,
+					Salary:     money.New(9500000, "USD"),
 					StartDate:  "2023-01-01T00:00:00Z",
 				}
 
@@ -87,17 +95,17 @@ func TestUpdate(t *testing.T) {
 					SetEmployeeDetailV1(gomock.Any(), employeeID, gomock.Any(), time.Duration(0)).
 					DoAndReturn(func(_ interface{}, _ interface{}, updatedCache dtos.EmployeeV1Response, _ time.Duration) error {
 						// Verify the cache was updated correctly
-						c.So(updatedCache.Name, ShouldEqual, updatedInfo.Name)
-						c.So(updatedCache.Age, ShouldEqual, updatedInfo.Age)
-						c.So(updatedCache.Address, ShouldEqual, updatedInfo.Address)
-						c.So(updatedCache.Phone, ShouldEqual, updatedInfo.Phone)
-						c.So(updatedCache.Email, ShouldEqual, updatedInfo.Email)
+						c.So(updatedCache.Name, ShouldEqual, *updatedInfo.Name)
+						c.So(updatedCache.Age, ShouldEqual, *updatedInfo.Age)
+						c.So(updatedCache.Address, ShouldEqual, *updatedInfo.Address)
+						c.So(updatedCache.Phone, ShouldEqual, *updatedInfo.Phone)
+						c.So(updatedCache.Email, ShouldEqual, *updatedInfo.Email)
 						// Verify the other fields remain unchanged
 						c.So(updatedCache.EmployeeID, ShouldEqual, cachedEmployeeDetail.EmployeeID)
 						c.So(updatedCache.PositionID, ShouldEqual, cachedEmployeeDetail.PositionID)
 						c.So(updatedCache.Position, ShouldEqual, cachedEmployeeDetail.Position)
 						c.So(updatedCache.Department, ShouldEqual, cachedEmployeeDetail.Department)
-						c.So(updatedCache.Salary, ShouldEqual, cachedEmployeeDetail.Salary)
+						c.So(updatedCache.Salary, ShouldResemble, cachedEmployeeDetail.Salary)
 						c.So(updatedCache.StartDate, ShouldEqual, cachedEmployeeDetail.StartDate)
 						return nil
 					})
@@ -124,21 +132,25 @@ func TestUpdate(t *testing.T) {
 			})
 
 			Convey("When updating the employee information and cache misses", func(c C) {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
 				// Set up expectations for successful update
 				s.employeeInfoRepo.EXPECT().
-					MustGet(gomock.Any(), s.db, employeeID).
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
 					Return(existingEmployeeInfo, nil)
 
 				s.employeeInfoRepo.EXPECT().
-					Save(gomock.Any(), s.db, gomock.Any()).
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
 						// Check that the employee info was updated correctly
 						c.So(info.ID, ShouldEqual, employeeID)
-						c.So(info.Name, ShouldEqual, updatedInfo.Name)
-						c.So(info.Age, ShouldEqual, updatedInfo.Age)
-						c.So(info.Address, ShouldEqual, updatedInfo.Address)
-						c.So(info.Phone, ShouldEqual, updatedInfo.Phone)
-						c.So(info.Email, ShouldEqual, updatedInfo.Email)
+						c.So(info.Name, ShouldEqual, *updatedInfo.Name)
+						c.So(info.Age, ShouldEqual, *updatedInfo.Age)
+						c.So(info.Address, ShouldEqual, *updatedInfo.Address)
+						c.So(info.Phone, ShouldEqual, *updatedInfo.Phone)
+						c.So(info.Email, ShouldEqual, *updatedInfo.Email)
 						return nil
 					})
 
@@ -169,21 +181,25 @@ func TestUpdate(t *testing.T) {
 			})
 
 			Convey("When updating the employee information and cache update fails", func(c C) {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
 				// Set up expectations for successful update
 				s.employeeInfoRepo.EXPECT().
-					MustGet(gomock.Any(), s.db, employeeID).
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
 					Return(existingEmployeeInfo, nil)
 
 				s.employeeInfoRepo.EXPECT().
-					Save(gomock.Any(), s.db, gomock.Any()).
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, _ interface{}, info *models.EmployeeInfo) error {
 						// Check that the employee info was updated correctly
 						c.So(info.ID, ShouldEqual, employeeID)
-						c.So(info.Name, ShouldEqual, updatedInfo.Name)
-						c.So(info.Age, ShouldEqual, updatedInfo.Age)
-						c.So(info.Address, ShouldEqual, updatedInfo.Address)
-						c.So(info.Phone, ShouldEqual, updatedInfo.Phone)
-						c.So(info.Email, ShouldEqual, updatedInfo.Email)
+						c.So(info.Name, ShouldEqual, *updatedInfo.Name)
+						c.So(info.Age, ShouldEqual, *updatedInfo.Age)
+						c.So(info.Address, ShouldEqual, *updatedInfo.Address)
+						c.So(info.Phone, ShouldEqual, *updatedInfo.Phone)
+						c.So(info.Email, ShouldEqual, *updatedInfo.Email)
 						return nil
 					})
 
@@ -227,10 +243,67 @@ func TestUpdate(t *testing.T) {
 				})
 			})
 
+			Convey("When the If-Match header does not match the employee", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPut,
+					"/employee/123",
+					http.Header{utils.IfMatchHeader: []string{`"stale"`}},
+					updatedInfo,
+					&errorResponse,
+					http.StatusPreconditionFailed,
+				)
+
+				Convey("Then the response should indicate a failed precondition", func() {
+					So(errorResponse["error"], ShouldEqual, "employee has been modified")
+				})
+			})
+
+			Convey("When the employee is modified concurrently", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
+					Return(existingEmployeeInfo, nil)
+				s.employeeInfoRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(employeeinforepo.ErrVersionConflict)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPut,
+					"/employee/123",
+					http.Header{utils.IfMatchHeader: []string{employeeInfoETag(existingEmployeeInfo)}},
+					updatedInfo,
+					&errorResponse,
+					http.StatusPreconditionFailed,
+				)
+
+				Convey("Then the response should indicate a failed precondition", func() {
+					So(errorResponse["error"], ShouldEqual, "employee has been modified")
+				})
+			})
+
 			Convey("When employee info is not found", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
 				// Set up expectations for failure case
 				s.employeeInfoRepo.EXPECT().
-					MustGet(gomock.Any(), s.db, employeeID).
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
 					Return(nil, errors.New("employee not found"))
 
 				// Make the request and verify error response
@@ -250,13 +323,17 @@ func TestUpdate(t *testing.T) {
 			})
 
 			Convey("When saving employee info fails", func() {
+				// Run the unit of work
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
 				// Set up expectations for failure during save
 				s.employeeInfoRepo.EXPECT().
-					MustGet(gomock.Any(), s.db, employeeID).
+					MustGet(gomock.Any(), gomock.Any(), employeeID).
 					Return(existingEmployeeInfo, nil)
 
 				s.employeeInfoRepo.EXPECT().
-					Save(gomock.Any(), s.db, gomock.Any()).
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("database error"))
 
 				// Make the request and verify error response
@@ -292,6 +369,28 @@ func TestUpdate(t *testing.T) {
 				})
 			})
 
+			Convey("When a field is missing from the replacement", func() {
+				// PUT replaces the whole resource, so every field is required
+				partialRequest := map[string]interface{}{
+					"name": "Jane Doe",
+				}
+
+				// Make the request and verify error response
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(
+					t,
+					http.MethodPut,
+					"/employee/123",
+					partialRequest,
+					&errorResponse,
+					http.StatusBadRequest,
+				)
+
+				Convey("Then the response should indicate a validation error", func() {
+					So(errorResponse["error"], ShouldNotBeEmpty)
+				})
+			})
+
 			Convey("When request data is invalid", func() {
 				// Invalid request with unexpected types
 				invalidRequest := map[string]interface{}{
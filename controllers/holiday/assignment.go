@@ -0,0 +1,169 @@
+package holiday
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/holiday"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type AssignRequest struct {
+	// Exactly one of EmployeeID and DepartmentID is set
+	EmployeeID   *int64 `json:"employee_id"   binding:"omitempty,gt=0"`
+	DepartmentID *int64 `json:"department_id" binding:"omitempty,gt=0"`
+}
+
+type ListAssignmentsResponse struct {
+	Items []dtos.HolidayCalendarAssignmentV1Response `json:"items"`
+}
+
+type UnassignResponse struct {
+	AssignmentID int64 `json:"assignment_id"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Assign makes an employee or a department follow the calendar. An employee
+// or a department follows one calendar, assigning another one replaces it.
+func (c *Controller) Assign(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req AssignRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if (req.EmployeeID == nil) == (req.DepartmentID == nil) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "exactly one of employee_id and department_id is required"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var assignment *models.HolidayCalendarAssignment
+	status := http.StatusOK
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if _, err := c.getCalendar(ctx, tx, calendarID); err != nil {
+			return err
+		}
+
+		if req.EmployeeID != nil {
+			employeeInfo, err := c.employeeInfoRepo.Get(ctx, tx.DB, *req.EmployeeID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee")
+			}
+			if employeeInfo == nil {
+				return utils.NewHttpError(http.StatusBadRequest, "employee not found")
+			}
+		}
+		if req.DepartmentID != nil {
+			department, err := c.departmentRepo.Get(ctx, tx.DB, *req.DepartmentID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
+			}
+			if department == nil {
+				return utils.NewHttpError(http.StatusBadRequest, "department not found")
+			}
+		}
+
+		assignment, err = c.holidayRepo.GetAssignmentByTarget(ctx, tx.DB, req.EmployeeID, req.DepartmentID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get holiday calendar assignment")
+		}
+		if assignment == nil {
+			status = http.StatusCreated
+			assignment = &models.HolidayCalendarAssignment{
+				EmployeeID:   req.EmployeeID,
+				DepartmentID: req.DepartmentID,
+			}
+		}
+		assignment.CalendarID = calendarID
+
+		if err := c.holidayRepo.SaveAssignment(ctx, tx.DB, assignment); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save holiday calendar assignment")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to save holiday calendar assignment")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(status, holiday.NewAssignmentV1Response(assignment))
+}
+
+func (c *Controller) ListAssignments(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	calendar, err := c.holidayRepo.GetCalendar(ctx, c.db, calendarID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get holiday calendar"})
+		return
+	}
+	if calendar == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "holiday calendar not found"})
+		return
+	}
+
+	assignments, err := c.holidayRepo.ListAssignmentsByCalendarID(ctx, c.db, calendarID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list holiday calendar assignments"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ListAssignmentsResponse{
+		Items: lo.Map(assignments, func(assignment *models.HolidayCalendarAssignment, _ int) dtos.HolidayCalendarAssignmentV1Response {
+			return holiday.NewAssignmentV1Response(assignment)
+		}),
+	})
+}
+
+func (c *Controller) Unassign(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+	assignmentID, err := strconv.ParseInt(ctx.Param("assignment_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid assignment_id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	deleted, err := c.holidayRepo.DeleteAssignment(ctx, c.db, calendarID, assignmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete holiday calendar assignment"})
+		return
+	}
+	if !deleted {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "holiday calendar assignment not found"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, UnassignResponse{
+		AssignmentID: assignmentID,
+	})
+}
@@ -0,0 +1,171 @@
+package holiday
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAssign(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday calendar", t, func() {
+			calendarID := int64(1)
+			employeeID := int64(123)
+			departmentID := int64(10)
+			calendar := &models.HolidayCalendar{ID: calendarID, Name: "Taiwan"}
+
+			Convey("When assigning it to a department without a calendar", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), departmentID).
+					Return(&models.Department{ID: departmentID}, nil)
+				s.holidayRepo.EXPECT().
+					GetAssignmentByTarget(gomock.Any(), gomock.Any(), nil, lo.ToPtr(departmentID)).
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					SaveAssignment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, assignment *models.HolidayCalendarAssignment) error {
+						c.So(assignment.CalendarID, ShouldEqual, calendarID)
+						assignment.ID = 1
+						return nil
+					})
+
+				var resp dtos.HolidayCalendarAssignmentV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/assignments", AssignRequest{DepartmentID: lo.ToPtr(departmentID)}, &resp, http.StatusCreated)
+
+				Convey("Then the assignment should be created", func() {
+					So(resp.AssignmentID, ShouldEqual, 1)
+					So(resp.EmployeeID, ShouldBeNil)
+					So(*resp.DepartmentID, ShouldEqual, departmentID)
+				})
+			})
+
+			Convey("When assigning it to an employee following another calendar", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+				s.holidayRepo.EXPECT().
+					GetAssignmentByTarget(gomock.Any(), gomock.Any(), lo.ToPtr(employeeID), nil).
+					Return(&models.HolidayCalendarAssignment{ID: 5, CalendarID: 2, EmployeeID: lo.ToPtr(employeeID)}, nil)
+				s.holidayRepo.EXPECT().
+					SaveAssignment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, assignment *models.HolidayCalendarAssignment) error {
+						c.So(assignment.ID, ShouldEqual, 5)
+						c.So(assignment.CalendarID, ShouldEqual, calendarID)
+						return nil
+					})
+
+				var resp dtos.HolidayCalendarAssignmentV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/assignments", AssignRequest{EmployeeID: lo.ToPtr(employeeID)}, &resp, http.StatusOK)
+
+				Convey("Then the assignment should be moved to the calendar", func() {
+					So(resp.AssignmentID, ShouldEqual, 5)
+					So(resp.CalendarID, ShouldEqual, calendarID)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/assignments", AssignRequest{EmployeeID: lo.ToPtr(employeeID)}, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "employee not found")
+				})
+			})
+
+			Convey("When both targets are given", func() {
+				req := AssignRequest{EmployeeID: lo.ToPtr(employeeID), DepartmentID: lo.ToPtr(departmentID)}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/assignments", req, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func TestListAssignments(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an assigned holiday calendar", t, func() {
+			calendarID := int64(1)
+
+			Convey("When listing its assignments", func() {
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(&models.HolidayCalendar{ID: calendarID}, nil)
+				s.holidayRepo.EXPECT().
+					ListAssignmentsByCalendarID(gomock.Any(), s.db, calendarID).
+					Return([]*models.HolidayCalendarAssignment{
+						{ID: 1, CalendarID: calendarID, DepartmentID: lo.ToPtr(int64(10))},
+						{ID: 2, CalendarID: calendarID, EmployeeID: lo.ToPtr(int64(123))},
+					}, nil)
+
+				var resp ListAssignmentsResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1/assignments", nil, &resp, http.StatusOK)
+
+				Convey("Then every assignment should be returned", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(*resp.Items[1].EmployeeID, ShouldEqual, 123)
+				})
+			})
+
+			Convey("When the calendar does not exist", func() {
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1/assignments", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func TestUnassign(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an assignment of a holiday calendar", t, func() {
+			Convey("When removing it", func() {
+				s.holidayRepo.EXPECT().
+					DeleteAssignment(gomock.Any(), s.db, int64(1), int64(2)).
+					Return(true, nil)
+
+				var resp UnassignResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1/assignments/2", nil, &resp, http.StatusOK)
+
+				Convey("Then it should be removed", func() {
+					So(resp.AssignmentID, ShouldEqual, 2)
+				})
+			})
+
+			Convey("When it does not exist", func() {
+				s.holidayRepo.EXPECT().
+					DeleteAssignment(gomock.Any(), s.db, int64(1), int64(2)).
+					Return(false, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1/assignments/2", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,214 @@
+package holiday
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/holiday"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CalendarRequest struct {
+	Name        string `json:"name"        binding:"required,max=100"`
+	Description string `json:"description" binding:"max=255"`
+}
+
+type ListCalendarsResponse struct {
+	Items []dtos.HolidayCalendarV1Response `json:"items"`
+}
+
+type DeleteCalendarResponse struct {
+	CalendarID int64 `json:"calendar_id"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) CreateCalendar(ctx *gin.Context) {
+	var req CalendarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	calendar := &models.HolidayCalendar{
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if err := c.checkCalendarName(ctx, tx, calendar); err != nil {
+			return err
+		}
+
+		if err := c.holidayRepo.CreateCalendar(ctx, tx.DB, calendar); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create holiday calendar")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create holiday calendar")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, holiday.NewCalendarV1Response(calendar, utils.GetTimeFormatter(ctx)))
+}
+
+func (c *Controller) ListCalendars(ctx *gin.Context) {
+	calendars, err := c.holidayRepo.ListCalendars(ctx, c.db)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list holiday calendars"})
+		return
+	}
+
+	formatter := utils.GetTimeFormatter(ctx)
+	ctx.JSON(http.StatusOK, ListCalendarsResponse{
+		Items: lo.Map(calendars, func(calendar *models.HolidayCalendar, _ int) dtos.HolidayCalendarV1Response {
+			return holiday.NewCalendarV1Response(calendar, formatter)
+		}),
+	})
+}
+
+func (c *Controller) GetCalendar(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	calendar, err := c.holidayRepo.GetCalendar(ctx, c.db, calendarID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get holiday calendar"})
+		return
+	}
+	if calendar == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "holiday calendar not found"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, holiday.NewCalendarV1Response(calendar, utils.GetTimeFormatter(ctx)))
+}
+
+func (c *Controller) UpdateCalendar(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req CalendarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var calendar *models.HolidayCalendar
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		calendar, err = c.holidayRepo.GetCalendar(ctx, tx.DB, calendarID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get holiday calendar")
+		}
+		if calendar == nil {
+			return utils.NewHttpError(http.StatusNotFound, "holiday calendar not found")
+		}
+
+		calendar.Name = strings.TrimSpace(req.Name)
+		calendar.Description = strings.TrimSpace(req.Description)
+		if err := c.checkCalendarName(ctx, tx, calendar); err != nil {
+			return err
+		}
+
+		if err := c.holidayRepo.SaveCalendar(ctx, tx.DB, calendar); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save holiday calendar")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to save holiday calendar")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, holiday.NewCalendarV1Response(calendar, utils.GetTimeFormatter(ctx)))
+}
+
+// DeleteCalendar removes a calendar along with its holidays. A calendar still
+// assigned cannot be deleted.
+func (c *Controller) DeleteCalendar(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		assignments, err := c.holidayRepo.ListAssignmentsByCalendarID(ctx, tx.DB, calendarID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list holiday calendar assignments")
+		}
+		if len(assignments) > 0 {
+			return utils.NewHttpError(http.StatusConflict, "holiday calendar is assigned")
+		}
+
+		deleted, err := c.holidayRepo.DeleteCalendar(ctx, tx.DB, calendarID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to delete holiday calendar")
+		}
+		if !deleted {
+			return utils.NewHttpError(http.StatusNotFound, "holiday calendar not found")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to delete holiday calendar")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, DeleteCalendarResponse{
+		CalendarID: calendarID,
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// checkCalendarName makes sure no other calendar uses the name.
+func (c *Controller) checkCalendarName(ctx *gin.Context, tx *txmanager.Tx, calendar *models.HolidayCalendar) error {
+	existing, err := c.holidayRepo.GetCalendarByName(ctx, tx.DB, calendar.Name)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to get holiday calendar")
+	}
+	if existing != nil && existing.ID != calendar.ID {
+		return utils.NewHttpError(http.StatusConflict, "holiday calendar already exists")
+	}
+	return nil
+}
+
+// getCalendar returns the calendar, or an HttpError when it does not exist.
+func (c *Controller) getCalendar(ctx *gin.Context, tx *txmanager.Tx, calendarID int64) (*models.HolidayCalendar, error) {
+	calendar, err := c.holidayRepo.GetCalendar(ctx, tx.DB, calendarID)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to get holiday calendar")
+	}
+	if calendar == nil {
+		return nil, utils.NewHttpError(http.StatusNotFound, "holiday calendar not found")
+	}
+	return calendar, nil
+}
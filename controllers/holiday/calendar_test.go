@@ -0,0 +1,243 @@
+package holiday
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCalendar(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a new holiday calendar", t, func() {
+			req := CalendarRequest{
+				Name:        " Taiwan ",
+				Description: "National holidays",
+			}
+
+			Convey("When the name is free", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					GetCalendarByName(gomock.Any(), gomock.Any(), "Taiwan").
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					CreateCalendar(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, calendar *models.HolidayCalendar) error {
+						calendar.ID = 1
+						return nil
+					})
+
+				var resp dtos.HolidayCalendarV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars", req, &resp, http.StatusCreated)
+
+				Convey("Then the calendar should be created", func() {
+					So(resp.CalendarID, ShouldEqual, 1)
+					So(resp.Name, ShouldEqual, "Taiwan")
+					So(resp.Description, ShouldEqual, "National holidays")
+				})
+			})
+
+			Convey("When the name is taken", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.holidayRepo.EXPECT().
+					GetCalendarByName(gomock.Any(), gomock.Any(), "Taiwan").
+					Return(&models.HolidayCalendar{ID: 2, Name: "Taiwan"}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "holiday calendar already exists")
+				})
+			})
+
+			Convey("When the name is missing", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars", CalendarRequest{}, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func TestListCalendars(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given holiday calendars", t, func() {
+			Convey("When listing them", func() {
+				s.holidayRepo.EXPECT().
+					ListCalendars(gomock.Any(), s.db).
+					Return([]*models.HolidayCalendar{
+						{ID: 1, Name: "Japan"},
+						{ID: 2, Name: "Taiwan"},
+					}, nil)
+
+				var resp ListCalendarsResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars", nil, &resp, http.StatusOK)
+
+				Convey("Then every calendar should be returned", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.Items[0].Name, ShouldEqual, "Japan")
+					So(resp.Items[1].CalendarID, ShouldEqual, 2)
+				})
+			})
+		})
+	})
+}
+
+func TestGetCalendar(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday calendar", t, func() {
+			calendarID := int64(1)
+
+			Convey("When it exists", func() {
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(&models.HolidayCalendar{ID: calendarID, Name: "Taiwan"}, nil)
+
+				var resp dtos.HolidayCalendarV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1", nil, &resp, http.StatusOK)
+
+				Convey("Then it should be returned", func() {
+					So(resp.Name, ShouldEqual, "Taiwan")
+				})
+			})
+
+			Convey("When it does not exist", func() {
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func TestUpdateCalendar(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday calendar", t, func() {
+			calendarID := int64(1)
+			req := CalendarRequest{Name: "Taiwan (ROC)"}
+
+			Convey("When renaming it", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(&models.HolidayCalendar{ID: calendarID, Name: "Taiwan", Description: "National holidays"}, nil)
+				s.holidayRepo.EXPECT().
+					GetCalendarByName(gomock.Any(), gomock.Any(), "Taiwan (ROC)").
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					SaveCalendar(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, calendar *models.HolidayCalendar) error {
+						c.So(calendar.Name, ShouldEqual, "Taiwan (ROC)")
+						return nil
+					})
+
+				var resp dtos.HolidayCalendarV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/holiday/calendars/1", req, &resp, http.StatusOK)
+
+				Convey("Then the calendar should be renamed and the description cleared", func() {
+					So(resp.Name, ShouldEqual, "Taiwan (ROC)")
+					So(resp.Description, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When it keeps its own name", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(&models.HolidayCalendar{ID: calendarID, Name: "Taiwan (ROC)"}, nil)
+				s.holidayRepo.EXPECT().
+					GetCalendarByName(gomock.Any(), gomock.Any(), "Taiwan (ROC)").
+					Return(&models.HolidayCalendar{ID: calendarID, Name: "Taiwan (ROC)"}, nil)
+				s.holidayRepo.EXPECT().
+					SaveCalendar(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/holiday/calendars/1", req, nil, http.StatusOK)
+			})
+
+			Convey("When it does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/holiday/calendars/1", req, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func TestDeleteCalendar(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday calendar", t, func() {
+			calendarID := int64(1)
+
+			Convey("When it is not assigned", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					ListAssignmentsByCalendarID(gomock.Any(), gomock.Any(), calendarID).
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					DeleteCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(true, nil)
+
+				var resp DeleteCalendarResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1", nil, &resp, http.StatusOK)
+
+				Convey("Then it should be deleted", func() {
+					So(resp.CalendarID, ShouldEqual, calendarID)
+				})
+			})
+
+			Convey("When it is assigned", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.holidayRepo.EXPECT().
+					ListAssignmentsByCalendarID(gomock.Any(), gomock.Any(), calendarID).
+					Return([]*models.HolidayCalendarAssignment{{ID: 1, CalendarID: calendarID}}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1", nil, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "holiday calendar is assigned")
+				})
+			})
+
+			Convey("When it does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.holidayRepo.EXPECT().
+					ListAssignmentsByCalendarID(gomock.Any(), gomock.Any(), calendarID).
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					DeleteCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(false, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package holiday
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+	ImportMaxBytes int64 `env:"HOLIDAY_IMPORT_MAX_BYTES,default=1048576"`
+	// ImportHorizonYears bounds the repetition of yearly events without an
+	// end, counted from the current year
+	ImportHorizonYears int `env:"HOLIDAY_IMPORT_HORIZON_YEARS,default=2"`
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager            TxManager
+	timeModule           TimeModule
+	holidayRepo          HolidayRepo
+	employeeInfoRepo     EmployeeInfoRepo
+	employeePositionRepo EmployeePositionRepo
+	departmentRepo       DepartmentRepo
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	holidayRepo HolidayRepo,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+	departmentRepo DepartmentRepo,
+) *Controller {
+	return &Controller{
+		cfg:                  cfg,
+		db:                   db,
+		txManager:            txManager,
+		timeModule:           timeModule,
+		holidayRepo:          holidayRepo,
+		employeeInfoRepo:     employeeInfoRepo,
+		employeePositionRepo: employeePositionRepo,
+		departmentRepo:       departmentRepo,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// calendars
+	r.POST("/holiday/calendars", c.CreateCalendar)
+	r.GET("/holiday/calendars", c.ListCalendars)
+	r.GET("/holiday/calendars/:id", c.GetCalendar)
+	r.PUT("/holiday/calendars/:id", c.UpdateCalendar)
+	r.DELETE("/holiday/calendars/:id", c.DeleteCalendar)
+
+	////////////////////////////////////////////////////////////////////////////
+	// holidays of a calendar
+	r.GET("/holiday/calendars/:id/holidays", c.ListHolidays)
+	r.POST("/holiday/calendars/:id/holidays", c.CreateHoliday)
+	r.DELETE("/holiday/calendars/:id/holidays/:holiday_id", c.DeleteHoliday)
+	r.POST("/holiday/calendars/:id/import", c.Import)
+
+	////////////////////////////////////////////////////////////////////////////
+	// calendar assignments
+	r.POST("/holiday/calendars/:id/assignments", c.Assign)
+	r.GET("/holiday/calendars/:id/assignments", c.ListAssignments)
+	r.DELETE("/holiday/calendars/:id/assignments/:assignment_id", c.Unassign)
+
+	////////////////////////////////////////////////////////////////////////////
+	// holidays of an employee
+	r.GET("/holiday/employee/:id", c.EmployeeHolidays)
+}
@@ -0,0 +1,70 @@
+package holiday
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/holiday"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// EmployeeHolidays lists the holidays the employee has over a range, from the
+// calendar assigned to the employee or else to the department held each day.
+func (c *Controller) EmployeeHolidays(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req RangeRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := parseRange(req, c.timeModule.Now())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	// Every employee holds at least one position, so none means no employee
+	positions, err := c.employeePositionRepo.ListByEmployeeIDs(ctx, c.db, []int64{employeeID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+	if len(positions) == 0 {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	departmentIDs := lo.Uniq(lo.Map(positions, func(position *models.EmployeePosition, _ int) int64 {
+		return position.DepartmentID
+	}))
+	assignments, err := c.holidayRepo.ListAssignments(ctx, c.db, []int64{employeeID}, departmentIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list holiday calendar assignments"})
+		return
+	}
+	calendarIDs := lo.Uniq(lo.Map(assignments, func(assignment *models.HolidayCalendarAssignment, _ int) int64 {
+		return assignment.CalendarID
+	}))
+	holidays, err := c.holidayRepo.ListHolidays(ctx, c.db, calendarIDs, from, to)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list holidays"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	holidays = holiday.ForEmployee(employeeID, positions, assignments, holidays)
+	ctx.JSON(http.StatusOK, holiday.NewListV1Response(from, to, holidays))
+}
@@ -0,0 +1,92 @@
+package holiday
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEmployeeHolidays(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee who moved between departments", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+			from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, DepartmentID: 10, StartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{ID: 2, EmployeeID: employeeID, DepartmentID: 20, StartDate: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+			}
+			assignments := []*models.HolidayCalendarAssignment{
+				{ID: 1, CalendarID: 1, DepartmentID: lo.ToPtr(int64(10))},
+				{ID: 2, CalendarID: 2, DepartmentID: lo.ToPtr(int64(20))},
+			}
+			holidays := []*models.Holiday{
+				{ID: 1, CalendarID: 1, Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year's Day"},
+				{ID: 2, CalendarID: 2, Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Neujahr"},
+				{ID: 3, CalendarID: 1, Date: time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC), Name: "National Day"},
+				{ID: 4, CalendarID: 2, Date: time.Date(2024, 10, 3, 0, 0, 0, 0, time.UTC), Name: "Tag der Deutschen Einheit"},
+			}
+
+			Convey("When listing the holidays of the year", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{employeeID}).
+					Return(positions, nil)
+				s.holidayRepo.EXPECT().
+					ListAssignments(gomock.Any(), s.db, []int64{employeeID}, []int64{10, 20}).
+					Return(assignments, nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(gomock.Any(), s.db, []int64{1, 2}, from, to).
+					Return(holidays, nil)
+
+				var resp dtos.HolidaysV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/employee/123", nil, &resp, http.StatusOK)
+
+				Convey("Then each day should follow the calendar of the department held", func() {
+					So(lo.Map(resp.Items, func(item dtos.HolidayV1Response, _ int) string {
+						return item.Name
+					}), ShouldResemble, []string{"New Year's Day", "Tag der Deutschen Einheit"})
+				})
+			})
+
+			Convey("When the employee has a calendar of their own", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{employeeID}).
+					Return(positions, nil)
+				s.holidayRepo.EXPECT().
+					ListAssignments(gomock.Any(), s.db, []int64{employeeID}, []int64{10, 20}).
+					Return(append(assignments, &models.HolidayCalendarAssignment{ID: 3, CalendarID: 2, EmployeeID: lo.ToPtr(employeeID)}), nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(gomock.Any(), s.db, []int64{1, 2}, from, to).
+					Return(holidays, nil)
+
+				var resp dtos.HolidaysV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/employee/123", nil, &resp, http.StatusOK)
+
+				Convey("Then it should win over the department ones", func() {
+					So(lo.Map(resp.Items, func(item dtos.HolidayV1Response, _ int) string {
+						return item.Name
+					}), ShouldResemble, []string{"Neujahr", "Tag der Deutschen Einheit"})
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), s.db, []int64{employeeID}).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/employee/123", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
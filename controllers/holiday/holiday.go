@@ -0,0 +1,197 @@
+package holiday
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/holiday"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// maxRangeDays bounds the date ranges holidays are listed over
+const maxRangeDays = 366 * 3
+
+type RangeRequest struct {
+	// Start is the first day of the range, defaults to the first day of the
+	// current year
+	Start string `form:"start"`
+	// End is the last day of the range, inclusive, defaults to the last day
+	// of the year of start
+	End string `form:"end"`
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" binding:"required"`
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type DeleteHolidayResponse struct {
+	HolidayID int64 `json:"holiday_id"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) ListHolidays(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req RangeRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := parseRange(req, c.timeModule.Now())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	calendar, err := c.holidayRepo.GetCalendar(ctx, c.db, calendarID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get holiday calendar"})
+		return
+	}
+	if calendar == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "holiday calendar not found"})
+		return
+	}
+
+	holidays, err := c.holidayRepo.ListHolidays(ctx, c.db, []int64{calendarID}, from, to)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list holidays"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, holiday.NewListV1Response(from, to, holidays))
+}
+
+func (c *Controller) CreateHoliday(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req CreateHolidayRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	date, err := time.Parse(holiday.DateLayout, req.Date)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid date"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	created := &models.Holiday{
+		CalendarID: calendarID,
+		Date:       date,
+		Name:       strings.TrimSpace(req.Name),
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if _, err := c.getCalendar(ctx, tx, calendarID); err != nil {
+			return err
+		}
+
+		existing, err := c.holidayRepo.GetHolidayByDate(ctx, tx.DB, calendarID, date)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get holiday")
+		}
+		if existing != nil {
+			return utils.NewHttpError(http.StatusConflict, "holiday already exists on date")
+		}
+
+		if err := c.holidayRepo.CreateHoliday(ctx, tx.DB, created); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create holiday")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create holiday")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, holiday.NewV1Response(created))
+}
+
+func (c *Controller) DeleteHoliday(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+	holidayID, err := strconv.ParseInt(ctx.Param("holiday_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid holiday_id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	deleted, err := c.holidayRepo.DeleteHoliday(ctx, c.db, calendarID, holidayID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete holiday"})
+		return
+	}
+	if !deleted {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "holiday not found"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, DeleteHolidayResponse{
+		HolidayID: holidayID,
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// parseRange returns the range of the request as [from, to).
+func parseRange(req RangeRequest, nowTime time.Time) (time.Time, time.Time, error) {
+	from := time.Date(nowTime.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	if req.Start != "" {
+		start, err := time.Parse(holiday.DateLayout, req.Start)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid start")
+		}
+		from = start
+	}
+
+	to := time.Date(from.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	if req.End != "" {
+		end, err := time.Parse(holiday.DateLayout, req.End)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid end")
+		}
+		to = end.AddDate(0, 0, 1)
+	}
+
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, errors.New("end must not be before start")
+	}
+	if to.After(from.AddDate(0, 0, maxRangeDays)) {
+		return time.Time{}, time.Time{}, errors.New("range is too long")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,183 @@
+package holiday
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListHolidays(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday calendar", t, func() {
+			calendarID := int64(1)
+			nowTime := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+			calendar := &models.HolidayCalendar{ID: calendarID, Name: "Taiwan"}
+
+			Convey("When listing without a range", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(calendar, nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(
+						gomock.Any(), s.db, []int64{calendarID},
+						time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					).
+					Return([]*models.Holiday{
+						{ID: 1, CalendarID: calendarID, Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year's Day"},
+						{ID: 2, CalendarID: calendarID, Date: time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC), Name: "National Day"},
+					}, nil)
+
+				var resp dtos.HolidaysV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1/holidays", nil, &resp, http.StatusOK)
+
+				Convey("Then the holidays of the current year should be returned", func() {
+					So(resp.Start, ShouldEqual, "2024-01-01")
+					So(resp.End, ShouldEqual, "2024-12-31")
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.Items[1].Date, ShouldEqual, "2024-10-10")
+					So(resp.Items[1].Name, ShouldEqual, "National Day")
+				})
+			})
+
+			Convey("When listing over a range", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(calendar, nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(
+						gomock.Any(), s.db, []int64{calendarID},
+						time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+						time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+					).
+					Return(nil, nil)
+
+				var resp dtos.HolidaysV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1/holidays?start=2024-02-01&end=2024-02-29", nil, &resp, http.StatusOK)
+
+				Convey("Then the range should be inclusive", func() {
+					So(resp.End, ShouldEqual, "2024-02-29")
+					So(resp.Items, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the range ends before it starts", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1/holidays?start=2024-02-01&end=2024-01-31", nil, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "end must not be before start")
+				})
+			})
+
+			Convey("When the calendar does not exist", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), s.db, calendarID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/holiday/calendars/1/holidays", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func TestCreateHoliday(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday calendar", t, func() {
+			calendarID := int64(1)
+			date := time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC)
+			calendar := &models.HolidayCalendar{ID: calendarID, Name: "Taiwan"}
+			req := CreateHolidayRequest{Date: "2024-10-10", Name: "National Day"}
+
+			Convey("When adding a holiday on a free date", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.holidayRepo.EXPECT().
+					GetHolidayByDate(gomock.Any(), gomock.Any(), calendarID, date).
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					CreateHoliday(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, holiday *models.Holiday) error {
+						c.So(holiday.Date, ShouldEqual, date)
+						holiday.ID = 1
+						return nil
+					})
+
+				var resp dtos.HolidayV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/holidays", req, &resp, http.StatusCreated)
+
+				Convey("Then the holiday should be created", func() {
+					So(resp.HolidayID, ShouldEqual, 1)
+					So(resp.Date, ShouldEqual, "2024-10-10")
+					So(resp.UID, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the date already has a holiday", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.holidayRepo.EXPECT().
+					GetHolidayByDate(gomock.Any(), gomock.Any(), calendarID, date).
+					Return(&models.Holiday{ID: 1}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/holidays", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "holiday already exists on date")
+				})
+			})
+
+			Convey("When the date is invalid", func() {
+				badReq := req
+				badReq.Date = "10/10/2024"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/holidays", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func TestDeleteHoliday(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a holiday of a calendar", t, func() {
+			Convey("When deleting it", func() {
+				s.holidayRepo.EXPECT().
+					DeleteHoliday(gomock.Any(), s.db, int64(1), int64(2)).
+					Return(true, nil)
+
+				var resp DeleteHolidayResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1/holidays/2", nil, &resp, http.StatusOK)
+
+				Convey("Then it should be deleted", func() {
+					So(resp.HolidayID, ShouldEqual, 2)
+				})
+			})
+
+			Convey("When it does not exist", func() {
+				s.holidayRepo.EXPECT().
+					DeleteHoliday(gomock.Any(), s.db, int64(1), int64(2)).
+					Return(false, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/holiday/calendars/1/holidays/2", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,167 @@
+package holiday
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/holiday"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const importFileField = "file"
+
+type ImportRequest struct {
+	// Until is the last day yearly events without an end repeat up to,
+	// defaults to the end of the import horizon
+	Until string `form:"until"`
+}
+
+type ImportSkippedEvent struct {
+	// Line is the line of BEGIN:VEVENT in the file
+	Line    int    `json:"line"`
+	UID     string `json:"uid"`
+	Summary string `json:"summary"`
+	Error   string `json:"error"`
+}
+
+type ImportResponse struct {
+	CalendarID int64                `json:"calendar_id"`
+	Created    int                  `json:"created"`
+	Updated    int                  `json:"updated"`
+	Skipped    []ImportSkippedEvent `json:"skipped"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Import adds the events of an iCalendar file to the calendar, one holiday
+// per day. A day already in the calendar is overwritten, so importing the
+// same file again is harmless.
+func (c *Controller) Import(ctx *gin.Context) {
+	calendarID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ImportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	nowTime := c.timeModule.Now()
+	horizon := time.Date(nowTime.Year()+c.cfg.ImportHorizonYears, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	if req.Until != "" {
+		horizon, err = time.Parse(holiday.DateLayout, req.Until)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid until"})
+			return
+		}
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, c.cfg.ImportMaxBytes)
+	file, err := openImportFile(ctx)
+	if err != nil {
+		respondImportFileError(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	events, skipped, err := holiday.ParseICS(file)
+	if err != nil {
+		respondImportFileError(ctx, err)
+		return
+	}
+	holidays, err := holiday.Expand(calendarID, events, horizon)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	response := ImportResponse{
+		CalendarID: calendarID,
+		Skipped: lo.Map(skipped, func(event holiday.SkippedEvent, _ int) ImportSkippedEvent {
+			return ImportSkippedEvent{
+				Line:    event.Line,
+				UID:     event.UID,
+				Summary: event.Summary,
+				Error:   event.Reason,
+			}
+		}),
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if _, err := c.getCalendar(ctx, tx, calendarID); err != nil {
+			return err
+		}
+		if len(holidays) == 0 {
+			return nil
+		}
+
+		// The holidays are sorted, the existing ones lie between the first
+		// and the last
+		from, to := holidays[0].Date, holidays[len(holidays)-1].Date.AddDate(0, 0, 1)
+		existing, err := c.holidayRepo.ListHolidays(ctx, tx.DB, []int64{calendarID}, from, to)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list holidays")
+		}
+		existingDates := lo.SliceToMap(existing, func(holiday *models.Holiday) (time.Time, bool) {
+			return holiday.Date, true
+		})
+		response.Updated = lo.CountBy(holidays, func(holiday *models.Holiday) bool {
+			return existingDates[holiday.Date]
+		})
+		response.Created = len(holidays) - response.Updated
+
+		if err := c.holidayRepo.UpsertHolidays(ctx, tx.DB, holidays); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save holidays")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to import holidays")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// openImportFile returns the iCalendar file sent either as the "file" field
+// of a multipart form or as the raw request body.
+func openImportFile(ctx *gin.Context) (io.ReadCloser, error) {
+	if ctx.ContentType() == binding.MIMEMultipartPOSTForm {
+		header, err := ctx.FormFile(importFileField)
+		if err != nil {
+			return nil, fmt.Errorf("missing ics file: %w", err)
+		}
+		return header.Open()
+	}
+
+	return ctx.Request.Body, nil
+}
+
+func respondImportFileError(ctx *gin.Context, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "ics file is too large"})
+		return
+	}
+	ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,188 @@
+package holiday
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImport(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an iCalendar file", t, func() {
+			calendarID := int64(1)
+			nowTime := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+			calendar := &models.HolidayCalendar{ID: calendarID, Name: "Taiwan"}
+			header := http.Header{"Content-Type": []string{"text/calendar"}}
+
+			icsFile := strings.Join([]string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"BEGIN:VEVENT",
+				"UID:new-year@example.com",
+				"SUMMARY:New Year's Day",
+				"DTSTART;VALUE=DATE:20240101",
+				"RRULE:FREQ=YEARLY",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"UID:lunar@example.com",
+				"SUMMARY:Lunar New Year",
+				"DTSTART;VALUE=DATE:20240209",
+				"DTEND;VALUE=DATE:20240211",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"UID:monthly@example.com",
+				"SUMMARY:Payday",
+				"DTSTART;VALUE=DATE:20240105",
+				"RRULE:FREQ=MONTHLY",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			}, "\r\n")
+
+			Convey("When importing it into a calendar holding one of the days", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(
+						gomock.Any(), gomock.Any(), []int64{calendarID},
+						time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+					).
+					Return([]*models.Holiday{
+						{ID: 1, CalendarID: calendarID, Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+					}, nil)
+				s.holidayRepo.EXPECT().
+					UpsertHolidays(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, holidays []*models.Holiday) error {
+						c.So(holidays, ShouldHaveLength, 4)
+						c.So(holidays[1].Date, ShouldEqual, time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC))
+						c.So(holidays[2].Name, ShouldEqual, "Lunar New Year")
+						c.So(holidays[3].Date, ShouldEqual, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+						return nil
+					})
+
+				var resp ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/holiday/calendars/1/import?until=2025-12-31",
+					header,
+					icsFile,
+					&resp,
+					http.StatusOK,
+				)
+
+				Convey("Then the days should be counted and the unsupported event skipped", func() {
+					So(resp.CalendarID, ShouldEqual, calendarID)
+					So(resp.Created, ShouldEqual, 3)
+					So(resp.Updated, ShouldEqual, 1)
+					So(resp.Skipped, ShouldResemble, []ImportSkippedEvent{
+						{Line: 15, UID: "monthly@example.com", Summary: "Payday", Error: "unsupported RRULE frequency MONTHLY"},
+					})
+				})
+			})
+
+			Convey("When sending it as a multipart form", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(calendar, nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(gomock.Any(), gomock.Any(), []int64{calendarID}, gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				s.holidayRepo.EXPECT().
+					UpsertHolidays(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				var body bytes.Buffer
+				writer := multipart.NewWriter(&body)
+				part, err := writer.CreateFormFile(importFileField, "holidays.ics")
+				So(err, ShouldBeNil)
+				_, err = part.Write([]byte(icsFile))
+				So(err, ShouldBeNil)
+				So(writer.Close(), ShouldBeNil)
+
+				var resp ImportResponse
+				s.testServer.MustDoWithHeaderAndMatchCode(
+					t,
+					http.MethodPost,
+					"/holiday/calendars/1/import",
+					http.Header{"Content-Type": []string{writer.FormDataContentType()}},
+					body.Bytes(),
+					&resp,
+					http.StatusOK,
+				)
+
+				Convey("Then the yearly event should repeat up to the end of next year", func() {
+					So(resp.Created, ShouldEqual, 4)
+					So(resp.Updated, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the file is not an iCalendar file", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoWithHeaderAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/import", header, "name,date\n", &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "not an iCalendar file")
+				})
+			})
+
+			Convey("When the file is too large", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				large := icsFile + strings.Repeat("X-FILLER:"+strings.Repeat("x", 60)+"\r\n", 20000)
+				s.testServer.MustDoWithHeaderAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/import", header, large, nil, http.StatusRequestEntityTooLarge)
+			})
+
+			Convey("When the events cover too many days", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				long := strings.Join([]string{
+					"BEGIN:VCALENDAR",
+					"BEGIN:VEVENT",
+					"DTSTART;VALUE=DATE:20240101",
+					"DURATION:P31D",
+					"RRULE:FREQ=YEARLY;COUNT=1000",
+					"END:VEVENT",
+					"END:VCALENDAR",
+				}, "\r\n")
+				s.testServer.MustDoWithHeaderAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/import", header, long, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the calendar does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.holidayRepo.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any(), calendarID).
+					Return(nil, nil)
+
+				s.testServer.MustDoWithHeaderAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/import", header, icsFile, nil, http.StatusNotFound)
+			})
+
+			Convey("When until is invalid", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+
+				s.testServer.MustDoWithHeaderAndMatchCode(t, http.MethodPost, "/holiday/calendars/1/import?until=soon", header, icsFile, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
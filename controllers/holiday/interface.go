@@ -0,0 +1,52 @@
+package holiday
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=holiday
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type HolidayRepo interface {
+	CreateCalendar(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendar) error
+	GetCalendar(ctx context.Context, tx *gorm.DB, id int64) (*models.HolidayCalendar, error)
+	GetCalendarByName(ctx context.Context, tx *gorm.DB, name string) (*models.HolidayCalendar, error)
+	ListCalendars(ctx context.Context, tx *gorm.DB) ([]*models.HolidayCalendar, error)
+	SaveCalendar(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendar) error
+	DeleteCalendar(ctx context.Context, tx *gorm.DB, id int64) (bool, error)
+
+	CreateHoliday(ctx context.Context, tx *gorm.DB, data *models.Holiday) error
+	GetHolidayByDate(ctx context.Context, tx *gorm.DB, calendarID int64, date time.Time) (*models.Holiday, error)
+	UpsertHolidays(ctx context.Context, tx *gorm.DB, data []*models.Holiday) error
+	DeleteHoliday(ctx context.Context, tx *gorm.DB, calendarID int64, id int64) (bool, error)
+	ListHolidays(ctx context.Context, tx *gorm.DB, calendarIDs []int64, from, to time.Time) ([]*models.Holiday, error)
+
+	SaveAssignment(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendarAssignment) error
+	GetAssignmentByTarget(ctx context.Context, tx *gorm.DB, employeeID *int64, departmentID *int64) (*models.HolidayCalendarAssignment, error)
+	DeleteAssignment(ctx context.Context, tx *gorm.DB, calendarID int64, id int64) (bool, error)
+	ListAssignmentsByCalendarID(ctx context.Context, tx *gorm.DB, calendarID int64) ([]*models.HolidayCalendarAssignment, error)
+	ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs []int64, departmentIDs []int64) ([]*models.HolidayCalendarAssignment, error)
+}
+
+type EmployeeInfoRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64) ([]*models.EmployeePosition, error)
+}
+
+type DepartmentRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+}
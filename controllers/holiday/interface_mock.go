@@ -0,0 +1,473 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=holiday
+//
+
+// Package holiday is a generated GoMock package.
+package holiday
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockHolidayRepo is a mock of HolidayRepo interface.
+type MockHolidayRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidayRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockHolidayRepoMockRecorder is the mock recorder for MockHolidayRepo.
+type MockHolidayRepoMockRecorder struct {
+	mock *MockHolidayRepo
+}
+
+// NewMockHolidayRepo creates a new mock instance.
+func NewMockHolidayRepo(ctrl *gomock.Controller) *MockHolidayRepo {
+	mock := &MockHolidayRepo{ctrl: ctrl}
+	mock.recorder = &MockHolidayRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidayRepo) EXPECT() *MockHolidayRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendar mocks base method.
+func (m *MockHolidayRepo) CreateCalendar(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockHolidayRepoMockRecorder) CreateCalendar(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockHolidayRepo)(nil).CreateCalendar), ctx, tx, data)
+}
+
+// CreateHoliday mocks base method.
+func (m *MockHolidayRepo) CreateHoliday(ctx context.Context, tx *gorm.DB, data *models.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoliday", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHoliday indicates an expected call of CreateHoliday.
+func (mr *MockHolidayRepoMockRecorder) CreateHoliday(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoliday", reflect.TypeOf((*MockHolidayRepo)(nil).CreateHoliday), ctx, tx, data)
+}
+
+// DeleteAssignment mocks base method.
+func (m *MockHolidayRepo) DeleteAssignment(ctx context.Context, tx *gorm.DB, calendarID, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssignment", ctx, tx, calendarID, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAssignment indicates an expected call of DeleteAssignment.
+func (mr *MockHolidayRepoMockRecorder) DeleteAssignment(ctx, tx, calendarID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssignment", reflect.TypeOf((*MockHolidayRepo)(nil).DeleteAssignment), ctx, tx, calendarID, id)
+}
+
+// DeleteCalendar mocks base method.
+func (m *MockHolidayRepo) DeleteCalendar(ctx context.Context, tx *gorm.DB, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendar", ctx, tx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCalendar indicates an expected call of DeleteCalendar.
+func (mr *MockHolidayRepoMockRecorder) DeleteCalendar(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendar", reflect.TypeOf((*MockHolidayRepo)(nil).DeleteCalendar), ctx, tx, id)
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockHolidayRepo) DeleteHoliday(ctx context.Context, tx *gorm.DB, calendarID, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, tx, calendarID, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockHolidayRepoMockRecorder) DeleteHoliday(ctx, tx, calendarID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockHolidayRepo)(nil).DeleteHoliday), ctx, tx, calendarID, id)
+}
+
+// GetAssignmentByTarget mocks base method.
+func (m *MockHolidayRepo) GetAssignmentByTarget(ctx context.Context, tx *gorm.DB, employeeID, departmentID *int64) (*models.HolidayCalendarAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentByTarget", ctx, tx, employeeID, departmentID)
+	ret0, _ := ret[0].(*models.HolidayCalendarAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentByTarget indicates an expected call of GetAssignmentByTarget.
+func (mr *MockHolidayRepoMockRecorder) GetAssignmentByTarget(ctx, tx, employeeID, departmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentByTarget", reflect.TypeOf((*MockHolidayRepo)(nil).GetAssignmentByTarget), ctx, tx, employeeID, departmentID)
+}
+
+// GetCalendar mocks base method.
+func (m *MockHolidayRepo) GetCalendar(ctx context.Context, tx *gorm.DB, id int64) (*models.HolidayCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, tx, id)
+	ret0, _ := ret[0].(*models.HolidayCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockHolidayRepoMockRecorder) GetCalendar(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockHolidayRepo)(nil).GetCalendar), ctx, tx, id)
+}
+
+// GetCalendarByName mocks base method.
+func (m *MockHolidayRepo) GetCalendarByName(ctx context.Context, tx *gorm.DB, name string) (*models.HolidayCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarByName", ctx, tx, name)
+	ret0, _ := ret[0].(*models.HolidayCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarByName indicates an expected call of GetCalendarByName.
+func (mr *MockHolidayRepoMockRecorder) GetCalendarByName(ctx, tx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarByName", reflect.TypeOf((*MockHolidayRepo)(nil).GetCalendarByName), ctx, tx, name)
+}
+
+// GetHolidayByDate mocks base method.
+func (m *MockHolidayRepo) GetHolidayByDate(ctx context.Context, tx *gorm.DB, calendarID int64, date time.Time) (*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolidayByDate", ctx, tx, calendarID, date)
+	ret0, _ := ret[0].(*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHolidayByDate indicates an expected call of GetHolidayByDate.
+func (mr *MockHolidayRepoMockRecorder) GetHolidayByDate(ctx, tx, calendarID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolidayByDate", reflect.TypeOf((*MockHolidayRepo)(nil).GetHolidayByDate), ctx, tx, calendarID, date)
+}
+
+// ListAssignments mocks base method.
+func (m *MockHolidayRepo) ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs, departmentIDs []int64) ([]*models.HolidayCalendarAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignments", ctx, tx, employeeIDs, departmentIDs)
+	ret0, _ := ret[0].([]*models.HolidayCalendarAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssignments indicates an expected call of ListAssignments.
+func (mr *MockHolidayRepoMockRecorder) ListAssignments(ctx, tx, employeeIDs, departmentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignments", reflect.TypeOf((*MockHolidayRepo)(nil).ListAssignments), ctx, tx, employeeIDs, departmentIDs)
+}
+
+// ListAssignmentsByCalendarID mocks base method.
+func (m *MockHolidayRepo) ListAssignmentsByCalendarID(ctx context.Context, tx *gorm.DB, calendarID int64) ([]*models.HolidayCalendarAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignmentsByCalendarID", ctx, tx, calendarID)
+	ret0, _ := ret[0].([]*models.HolidayCalendarAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssignmentsByCalendarID indicates an expected call of ListAssignmentsByCalendarID.
+func (mr *MockHolidayRepoMockRecorder) ListAssignmentsByCalendarID(ctx, tx, calendarID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignmentsByCalendarID", reflect.TypeOf((*MockHolidayRepo)(nil).ListAssignmentsByCalendarID), ctx, tx, calendarID)
+}
+
+// ListCalendars mocks base method.
+func (m *MockHolidayRepo) ListCalendars(ctx context.Context, tx *gorm.DB) ([]*models.HolidayCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendars", ctx, tx)
+	ret0, _ := ret[0].([]*models.HolidayCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendars indicates an expected call of ListCalendars.
+func (mr *MockHolidayRepoMockRecorder) ListCalendars(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendars", reflect.TypeOf((*MockHolidayRepo)(nil).ListCalendars), ctx, tx)
+}
+
+// ListHolidays mocks base method.
+func (m *MockHolidayRepo) ListHolidays(ctx context.Context, tx *gorm.DB, calendarIDs []int64, from, to time.Time) ([]*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolidays", ctx, tx, calendarIDs, from, to)
+	ret0, _ := ret[0].([]*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolidays indicates an expected call of ListHolidays.
+func (mr *MockHolidayRepoMockRecorder) ListHolidays(ctx, tx, calendarIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolidays", reflect.TypeOf((*MockHolidayRepo)(nil).ListHolidays), ctx, tx, calendarIDs, from, to)
+}
+
+// SaveAssignment mocks base method.
+func (m *MockHolidayRepo) SaveAssignment(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendarAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAssignment", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAssignment indicates an expected call of SaveAssignment.
+func (mr *MockHolidayRepoMockRecorder) SaveAssignment(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAssignment", reflect.TypeOf((*MockHolidayRepo)(nil).SaveAssignment), ctx, tx, data)
+}
+
+// SaveCalendar mocks base method.
+func (m *MockHolidayRepo) SaveCalendar(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCalendar", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCalendar indicates an expected call of SaveCalendar.
+func (mr *MockHolidayRepoMockRecorder) SaveCalendar(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCalendar", reflect.TypeOf((*MockHolidayRepo)(nil).SaveCalendar), ctx, tx, data)
+}
+
+// UpsertHolidays mocks base method.
+func (m *MockHolidayRepo) UpsertHolidays(ctx context.Context, tx *gorm.DB, data []*models.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertHolidays", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertHolidays indicates an expected call of UpsertHolidays.
+func (mr *MockHolidayRepoMockRecorder) UpsertHolidays(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertHolidays", reflect.TypeOf((*MockHolidayRepo)(nil).UpsertHolidays), ctx, tx, data)
+}
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByEmployeeIDs mocks base method.
+func (m *MockEmployeePositionRepo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeIDs", ctx, tx, employeeIDs)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeIDs indicates an expected call of ListByEmployeeIDs.
+func (mr *MockEmployeePositionRepoMockRecorder) ListByEmployeeIDs(ctx, tx, employeeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeIDs", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListByEmployeeIDs), ctx, tx, employeeIDs)
+}
+
+// MockDepartmentRepo is a mock of DepartmentRepo interface.
+type MockDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepoMockRecorder is the mock recorder for MockDepartmentRepo.
+type MockDepartmentRepoMockRecorder struct {
+	mock *MockDepartmentRepo
+}
+
+// NewMockDepartmentRepo creates a new mock instance.
+func NewMockDepartmentRepo(ctrl *gomock.Controller) *MockDepartmentRepo {
+	mock := &MockDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepo) EXPECT() *MockDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDepartmentRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepartmentRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepartmentRepo)(nil).Get), ctx, tx, id)
+}
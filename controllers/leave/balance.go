@@ -0,0 +1,174 @@
+package leave
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type AdjustBalanceRequest struct {
+	LeaveTypeID int64 `json:"leave_type_id" binding:"required"`
+	// Days is added to the balance, negative to take days off it
+	Days float64 `json:"days" binding:"required,min=-365,max=365"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Balances returns the balances of the leave types tracking one, accrued up
+// to today. The accrual is stored by the next write to the balance.
+func (c *Controller) Balances(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("employee_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	hireDate, err := c.hireDate(ctx, c.db, employeeID)
+	if err != nil {
+		utils.RespondError(ctx, err, "failed to get employee positions")
+		return
+	}
+
+	leaveTypes, err := c.leaveRepo.ListTypes(ctx, c.db)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list leave types"})
+		return
+	}
+	balances, err := c.leaveRepo.ListBalancesByEmployeeID(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list leave balances"})
+		return
+	}
+	pendingDays, err := c.leaveRepo.ListPendingDays(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list pending leave"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	nowTime := c.timeModule.Now()
+	items := make([]dtos.LeaveBalanceV1Response, 0, len(leaveTypes))
+	for _, leaveType := range leaveTypes {
+		if !leaveType.TracksBalance {
+			continue
+		}
+
+		balance, ok := balances[leaveType.ID]
+		if !ok {
+			balance = leave.NewBalance(employeeID, leaveType.ID, hireDate)
+		}
+		leave.Accrue(balance, leaveType, nowTime)
+		items = append(items, leave.NewBalanceV1Response(leaveType, balance, pendingDays[leaveType.ID]))
+	}
+
+	ctx.JSON(http.StatusOK, dtos.LeaveBalancesV1Response{
+		EmployeeID: employeeID,
+		Items:      items,
+	})
+}
+
+// AdjustBalance credits or debits a balance by hand, e.g. for carried over
+// days. The balance may not go below zero.
+func (c *Controller) AdjustBalance(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("employee_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req AdjustBalanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var resp dtos.LeaveBalanceV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		hireDate, err := c.hireDate(ctx, tx.DB, employeeID)
+		if err != nil {
+			return err
+		}
+
+		leaveType, err := c.leaveRepo.GetType(ctx, tx.DB, req.LeaveTypeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave type")
+		}
+		if leaveType == nil {
+			return utils.NewHttpError(http.StatusNotFound, "leave type not found")
+		}
+		if !leaveType.TracksBalance {
+			return utils.NewHttpError(http.StatusBadRequest, "leave type does not track a balance")
+		}
+
+		balance, err := c.lockBalance(ctx, tx.DB, employeeID, leaveType, hireDate, c.timeModule.Now())
+		if err != nil {
+			return err
+		}
+		balance.BalanceDays = leave.RoundDays(balance.BalanceDays + req.Days)
+		if balance.BalanceDays < 0 {
+			return utils.NewHttpError(http.StatusConflict, "insufficient leave balance")
+		}
+		if err := c.leaveRepo.SaveBalance(ctx, tx.DB, balance); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save leave balance")
+		}
+
+		pendingDays, err := c.leaveRepo.ListPendingDays(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list pending leave")
+		}
+		resp = leave.NewBalanceV1Response(leaveType, balance, pendingDays[leaveType.ID])
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to adjust leave balance")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// hireDate returns the start date of the first position of the employee,
+// from which the balances accrue.
+func (c *Controller) hireDate(ctx context.Context, tx *gorm.DB, employeeID int64) (time.Time, error) {
+	positions, err := c.employeePositionRepo.ListByEmployeeID(ctx, tx, employeeID)
+	if err != nil {
+		return time.Time{}, utils.NewHttpError(http.StatusInternalServerError, "failed to get employee positions")
+	}
+	if len(positions) == 0 {
+		return time.Time{}, utils.NewHttpError(http.StatusNotFound, "employee not found")
+	}
+	return positions[0].StartDate, nil
+}
+
+// lockBalance returns the locked balance of the employee accrued up to nowTime,
+// or a new one when nothing was ever credited.
+func (c *Controller) lockBalance(ctx context.Context, tx *gorm.DB, employeeID int64, leaveType *models.LeaveType, hireDate time.Time, nowTime time.Time) (*models.LeaveBalance, error) {
+	balance, err := c.leaveRepo.GetBalanceForUpdate(ctx, tx, employeeID, leaveType.ID)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to get leave balance")
+	}
+	if balance == nil {
+		balance = leave.NewBalance(employeeID, leaveType.ID, hireDate)
+	}
+	leave.Accrue(balance, leaveType, nowTime)
+	return balance, nil
+}
@@ -0,0 +1,175 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBalances(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee hired in January", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2025, 4, 15, 9, 0, 0, 0, time.UTC)
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+			}
+			annual := &models.LeaveType{ID: 1, Code: models.LeaveTypeCodeAnnual, TracksBalance: true, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 30}
+			sick := &models.LeaveType{ID: 2, Code: models.LeaveTypeCodeSick, TracksBalance: true, AccrualDaysPerMonth: 1}
+			unpaid := &models.LeaveType{ID: 3, Code: models.LeaveTypeCodeUnpaid}
+
+			Convey("When getting the balances", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					ListTypes(gomock.Any(), gomock.Any()).
+					Return([]*models.LeaveType{annual, sick, unpaid}, nil)
+				s.leaveRepo.EXPECT().
+					ListBalancesByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(map[int64]*models.LeaveBalance{
+						// Credited for February, with 2 days adjusted in
+						1: {ID: 7, EmployeeID: employeeID, LeaveTypeID: 1, BalanceDays: 3.75, AccruedThrough: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+					}, nil)
+				s.leaveRepo.EXPECT().
+					ListPendingDays(gomock.Any(), gomock.Any(), employeeID).
+					Return(map[int64]float64{1: 2}, nil)
+
+				var resp dtos.LeaveBalancesV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/balances/123", nil, &resp, http.StatusOK)
+
+				Convey("Then the balances should be accrued up to today", func() {
+					So(resp.EmployeeID, ShouldEqual, employeeID)
+					So(resp.Items, ShouldHaveLength, 2)
+
+					So(resp.Items[0].Code, ShouldEqual, models.LeaveTypeCodeAnnual)
+					So(resp.Items[0].BalanceDays, ShouldEqual, 7.25)
+					So(resp.Items[0].PendingDays, ShouldEqual, 2)
+					So(resp.Items[0].AvailableDays, ShouldEqual, 5.25)
+					So(resp.Items[0].NextAccrual, ShouldEqual, "2025-05-01")
+
+					So(resp.Items[1].Code, ShouldEqual, models.LeaveTypeCodeSick)
+					So(resp.Items[1].BalanceDays, ShouldEqual, 3)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), int64(999)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/balances/999", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/balances/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func TestAdjustBalance(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with an annual balance", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2025, 4, 15, 9, 0, 0, 0, time.UTC)
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+			}
+			annual := &models.LeaveType{ID: 1, Code: models.LeaveTypeCodeAnnual, TracksBalance: true, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 30}
+			balance := func() *models.LeaveBalance {
+				return &models.LeaveBalance{
+					ID:             7,
+					EmployeeID:     employeeID,
+					LeaveTypeID:    1,
+					BalanceDays:    5.25,
+					AccruedThrough: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+				}
+			}
+
+			Convey("When carrying over days", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(1)).
+					Return(annual, nil)
+				s.leaveRepo.EXPECT().
+					GetBalanceForUpdate(gomock.Any(), gomock.Any(), employeeID, int64(1)).
+					Return(balance(), nil)
+				s.leaveRepo.EXPECT().
+					SaveBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, saved *models.LeaveBalance) error {
+						c.So(saved.BalanceDays, ShouldEqual, 8.25)
+						return nil
+					})
+				s.leaveRepo.EXPECT().
+					ListPendingDays(gomock.Any(), gomock.Any(), employeeID).
+					Return(map[int64]float64{}, nil)
+
+				req := AdjustBalanceRequest{LeaveTypeID: 1, Days: 3}
+				var resp dtos.LeaveBalanceV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/balances/123/adjust", req, &resp, http.StatusOK)
+
+				Convey("Then the new balance should be returned", func() {
+					So(resp.BalanceDays, ShouldEqual, 8.25)
+					So(resp.AvailableDays, ShouldEqual, 8.25)
+				})
+			})
+
+			Convey("When taking more days than the balance", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(1)).
+					Return(annual, nil)
+				s.leaveRepo.EXPECT().
+					GetBalanceForUpdate(gomock.Any(), gomock.Any(), employeeID, int64(1)).
+					Return(balance(), nil)
+
+				req := AdjustBalanceRequest{LeaveTypeID: 1, Days: -6}
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/balances/123/adjust", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the adjustment should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "insufficient leave balance")
+				})
+			})
+
+			Convey("When the type does not track a balance", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(3)).
+					Return(&models.LeaveType{ID: 3, Code: models.LeaveTypeCodeUnpaid}, nil)
+
+				req := AdjustBalanceRequest{LeaveTypeID: 3, Days: 1}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/balances/123/adjust", req, nil, http.StatusBadRequest)
+			})
+
+			Convey("When no days are given", func() {
+				req := AdjustBalanceRequest{LeaveTypeID: 1}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/balances/123/adjust", req, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
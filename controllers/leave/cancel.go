@@ -0,0 +1,82 @@
+package leave
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CancelRequest struct {
+	EmployeeID int64 `json:"employee_id" binding:"required"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Cancel withdraws a request of the employee. Approved leave can be cancelled
+// until it starts, and its days go back to the balance.
+func (c *Controller) Cancel(ctx *gin.Context) {
+	requestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req CancelRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var resp dtos.LeaveRequestV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		request, err := c.leaveRepo.GetRequestForUpdate(ctx, tx.DB, requestID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave request")
+		}
+		if request == nil {
+			return utils.NewHttpError(http.StatusNotFound, "leave request not found")
+		}
+		if request.EmployeeID != req.EmployeeID {
+			return utils.NewHttpError(http.StatusForbidden, "leave request belongs to another employee")
+		}
+
+		nowTime := c.timeModule.Now()
+		switch request.Status {
+		case models.LeaveStatusPending:
+		case models.LeaveStatusApproved:
+			if !request.StartDate.After(leave.Today(nowTime)) {
+				return utils.NewHttpError(http.StatusConflict, "leave already started")
+			}
+			if err := c.refundDays(ctx, tx.DB, request, nowTime); err != nil {
+				return err
+			}
+		default:
+			return utils.NewHttpError(http.StatusConflict, "leave request already closed")
+		}
+
+		request.Status = models.LeaveStatusCancelled
+		if err := c.leaveRepo.SaveRequest(ctx, tx.DB, request); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save leave request")
+		}
+
+		resp = leave.NewRequestV1Response(request, utils.GetTimeFormatter(ctx))
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to cancel leave request")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,140 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCancel(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a leave request starting on 2025-05-05", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2025, 4, 15, 9, 0, 0, 0, time.UTC)
+
+			request := models.DummyLeaveRequest(s.faker)
+			request.ID = 1
+			request.EmployeeID = employeeID
+			request.LeaveTypeID = 1
+
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+			}
+			annual := &models.LeaveType{ID: 1, Code: models.LeaveTypeCodeAnnual, TracksBalance: true, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 30}
+			req := CancelRequest{EmployeeID: employeeID}
+
+			Convey("When cancelling it while pending", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(request, nil)
+				s.leaveRepo.EXPECT().
+					SaveRequest(gomock.Any(), gomock.Any(), request).
+					Return(nil)
+
+				var resp dtos.LeaveRequestV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/cancel", req, &resp, http.StatusOK)
+
+				Convey("Then the request should be cancelled", func() {
+					So(resp.Status, ShouldEqual, models.LeaveStatusCancelled)
+				})
+			})
+
+			Convey("When cancelling it once approved", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				approved := *request
+				approved.Status = models.LeaveStatusApproved
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&approved, nil)
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(1)).
+					Return(annual, nil)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetBalanceForUpdate(gomock.Any(), gomock.Any(), employeeID, int64(1)).
+					Return(&models.LeaveBalance{
+						ID:             7,
+						EmployeeID:     employeeID,
+						LeaveTypeID:    1,
+						BalanceDays:    2.25,
+						AccruedThrough: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+				s.leaveRepo.EXPECT().
+					SaveBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, balance *models.LeaveBalance) error {
+						c.So(balance.BalanceDays, ShouldEqual, 7.25)
+						return nil
+					})
+				s.leaveRepo.EXPECT().
+					SaveRequest(gomock.Any(), gomock.Any(), &approved).
+					Return(nil)
+
+				var resp dtos.LeaveRequestV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/cancel", req, &resp, http.StatusOK)
+
+				Convey("Then the days should be refunded", func() {
+					So(resp.Status, ShouldEqual, models.LeaveStatusCancelled)
+				})
+			})
+
+			Convey("When the approved leave already started", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				approved := *request
+				approved.Status = models.LeaveStatusApproved
+				s.timeModule.EXPECT().Now().Return(time.Date(2025, 5, 5, 9, 0, 0, 0, time.UTC))
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&approved, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/cancel", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the cancellation should be refused", func() {
+					So(errorResponse["error"], ShouldEqual, "leave already started")
+				})
+			})
+
+			Convey("When another employee cancels it", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(request, nil)
+
+				otherReq := CancelRequest{EmployeeID: 456}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/cancel", otherReq, nil, http.StatusForbidden)
+			})
+
+			Convey("When the request was rejected", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				rejected := *request
+				rejected.Status = models.LeaveStatusRejected
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&rejected, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/cancel", req, nil, http.StatusConflict)
+			})
+		})
+	})
+}
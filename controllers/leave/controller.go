@@ -0,0 +1,64 @@
+package leave
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager            TxManager
+	timeModule           TimeModule
+	leaveRepo            LeaveRepo
+	employeePositionRepo EmployeePositionRepo
+	departmentRepo       DepartmentRepo
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	leaveRepo LeaveRepo,
+	employeePositionRepo EmployeePositionRepo,
+	departmentRepo DepartmentRepo,
+) *Controller {
+	return &Controller{
+		cfg:                  cfg,
+		db:                   db,
+		txManager:            txManager,
+		timeModule:           timeModule,
+		leaveRepo:            leaveRepo,
+		employeePositionRepo: employeePositionRepo,
+		departmentRepo:       departmentRepo,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// leave types and their accrual rules
+	r.POST("/leave/types", c.CreateType)
+	r.GET("/leave/types", c.ListTypes)
+	r.PUT("/leave/types/:id", c.UpdateType)
+
+	////////////////////////////////////////////////////////////////////////////
+	// balances
+	r.GET("/leave/balances/:employee_id", c.Balances)
+	r.POST("/leave/balances/:employee_id/adjust", c.AdjustBalance)
+
+	////////////////////////////////////////////////////////////////////////////
+	// requests
+	r.POST("/leave/requests", c.Create)
+	r.GET("/leave/requests", c.List)
+	r.GET("/leave/requests/:id", c.Get)
+	r.POST("/leave/requests/:id/approve", c.Approve)
+	r.POST("/leave/requests/:id/reject", c.Reject)
+	r.POST("/leave/requests/:id/cancel", c.Cancel)
+}
@@ -0,0 +1,70 @@
+package leave
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/sethvargo/go-envconfig"
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type testSuite struct {
+	db     *gorm.DB
+	mockDB sqlmock.Sqlmock
+
+	timeModule           *MockTimeModule
+	leaveRepo            *MockLeaveRepo
+	employeePositionRepo *MockEmployeePositionRepo
+	departmentRepo       *MockDepartmentRepo
+
+	controller *Controller
+	testServer testutils.TestHttpServer
+	faker      *gofakeit.Faker
+}
+
+func testInit(t *testing.T, test func(*testSuite)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gormDB, mockDB := testutils.GetMockDB(t)
+
+	timeModule := NewMockTimeModule(ctrl)
+	leaveRepo := NewMockLeaveRepo(ctrl)
+	employeePositionRepo := NewMockEmployeePositionRepo(ctrl)
+	departmentRepo := NewMockDepartmentRepo(ctrl)
+
+	cfg := Config{}
+	if err := envconfig.Process(t.Context(), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	controller := NewController(
+		cfg,
+		gormDB,
+		txmanager.New(gormDB),
+		timeModule,
+		leaveRepo,
+		employeePositionRepo,
+		departmentRepo,
+	)
+	testServer := testutils.NewTestHttpServer(controller)
+	faker := gofakeit.New(0)
+	suite := &testSuite{
+		db:                   gormDB,
+		mockDB:               mockDB,
+		timeModule:           timeModule,
+		leaveRepo:            leaveRepo,
+		employeePositionRepo: employeePositionRepo,
+		departmentRepo:       departmentRepo,
+		controller:           controller,
+		testServer:           testServer,
+		faker:                faker,
+	}
+
+	test(suite)
+}
@@ -0,0 +1,117 @@
+package leave
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CreateRequest struct {
+	EmployeeID  int64  `json:"employee_id"   binding:"required"`
+	LeaveTypeID int64  `json:"leave_type_id" binding:"required"`
+	StartDate   string `json:"start_date"    binding:"required"`
+	EndDate     string `json:"end_date"      binding:"required"`
+	Reason      string `json:"reason"        binding:"max=255"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Create files a pending leave request. The days are reserved against the
+// balance until the request is reviewed or cancelled.
+func (c *Controller) Create(ctx *gin.Context) {
+	var req CreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, err := time.Parse(leave.DateLayout, req.StartDate)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid start_date"})
+		return
+	}
+	endDate, err := time.Parse(leave.DateLayout, req.EndDate)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid end_date"})
+		return
+	}
+	if endDate.Before(startDate) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "end_date must not be before start_date"})
+		return
+	}
+	days := leave.WorkingDays(startDate, endDate)
+	if days == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "leave covers no working day"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	request := &models.LeaveRequest{
+		EmployeeID:  req.EmployeeID,
+		LeaveTypeID: req.LeaveTypeID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Days:        days,
+		Reason:      strings.TrimSpace(req.Reason),
+		Status:      models.LeaveStatusPending,
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		hireDate, err := c.hireDate(ctx, tx.DB, req.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		leaveType, err := c.leaveRepo.GetType(ctx, tx.DB, req.LeaveTypeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave type")
+		}
+		if leaveType == nil {
+			return utils.NewHttpError(http.StatusBadRequest, "leave type not found")
+		}
+
+		overlapping, err := c.leaveRepo.ListActiveOverlapping(ctx, tx.DB, req.EmployeeID, startDate, endDate)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list leave requests")
+		}
+		if len(overlapping) > 0 {
+			return utils.NewHttpError(http.StatusConflict, "leave overlaps another request")
+		}
+
+		if leaveType.TracksBalance {
+			// Lock the balance so concurrent requests do not both reserve
+			// the last days
+			balance, err := c.lockBalance(ctx, tx.DB, req.EmployeeID, leaveType, hireDate, c.timeModule.Now())
+			if err != nil {
+				return err
+			}
+			pendingDays, err := c.leaveRepo.ListPendingDays(ctx, tx.DB, req.EmployeeID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to list pending leave")
+			}
+			if balance.BalanceDays-pendingDays[leaveType.ID] < days {
+				return utils.NewHttpError(http.StatusConflict, "insufficient leave balance")
+			}
+		}
+
+		if err := c.leaveRepo.CreateRequest(ctx, tx.DB, request); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create leave request")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create leave request")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, leave.NewRequestV1Response(request, utils.GetTimeFormatter(ctx)))
+}
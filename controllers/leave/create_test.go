@@ -0,0 +1,167 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with annual leave accrued", t, func() {
+			employeeID := int64(123)
+			nowTime := time.Date(2025, 4, 15, 9, 0, 0, 0, time.UTC)
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+			}
+			annual := &models.LeaveType{ID: 1, Code: models.LeaveTypeCodeAnnual, TracksBalance: true, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 30}
+			balance := &models.LeaveBalance{
+				ID:             7,
+				EmployeeID:     employeeID,
+				LeaveTypeID:    1,
+				BalanceDays:    5.25,
+				AccruedThrough: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+			}
+
+			// Monday to Sunday, 5 working days
+			req := CreateRequest{
+				EmployeeID:  employeeID,
+				LeaveTypeID: 1,
+				StartDate:   "2025-05-05",
+				EndDate:     "2025-05-11",
+				Reason:      "Family trip",
+			}
+			startDate := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+			endDate := time.Date(2025, 5, 11, 0, 0, 0, 0, time.UTC)
+
+			expectType := func() {
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(1)).
+					Return(annual, nil)
+			}
+			expectBalance := func(pendingDays float64) {
+				s.leaveRepo.EXPECT().
+					ListActiveOverlapping(gomock.Any(), gomock.Any(), employeeID, startDate, endDate).
+					Return(nil, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.leaveRepo.EXPECT().
+					GetBalanceForUpdate(gomock.Any(), gomock.Any(), employeeID, int64(1)).
+					Return(balance, nil)
+				s.leaveRepo.EXPECT().
+					ListPendingDays(gomock.Any(), gomock.Any(), employeeID).
+					Return(map[int64]float64{1: pendingDays}, nil)
+			}
+
+			Convey("When the balance covers the request", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				expectType()
+				expectBalance(0)
+				s.leaveRepo.EXPECT().
+					CreateRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, request *models.LeaveRequest) error {
+						c.So(request.Status, ShouldEqual, models.LeaveStatusPending)
+						request.ID = 1
+						return nil
+					})
+
+				var resp dtos.LeaveRequestV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", req, &resp, http.StatusCreated)
+
+				Convey("Then the pending request should count the working days", func() {
+					So(resp.LeaveRequestID, ShouldEqual, 1)
+					So(resp.Days, ShouldEqual, 5)
+					So(resp.StartDate, ShouldEqual, "2025-05-05")
+					So(resp.EndDate, ShouldEqual, "2025-05-11")
+					So(resp.Status, ShouldEqual, models.LeaveStatusPending)
+				})
+			})
+
+			Convey("When pending requests already reserve the balance", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				expectType()
+				expectBalance(1)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "insufficient leave balance")
+				})
+			})
+
+			Convey("When the leave overlaps another request", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				expectType()
+				s.leaveRepo.EXPECT().
+					ListActiveOverlapping(gomock.Any(), gomock.Any(), employeeID, startDate, endDate).
+					Return([]*models.LeaveRequest{{ID: 2}}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "leave overlaps another request")
+				})
+			})
+
+			Convey("When the type does not track a balance", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(3)).
+					Return(&models.LeaveType{ID: 3, Code: models.LeaveTypeCodeUnpaid}, nil)
+				s.leaveRepo.EXPECT().
+					ListActiveOverlapping(gomock.Any(), gomock.Any(), employeeID, startDate, endDate).
+					Return(nil, nil)
+				s.leaveRepo.EXPECT().
+					CreateRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				unpaidReq := req
+				unpaidReq.LeaveTypeID = 3
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", unpaidReq, nil, http.StatusCreated)
+			})
+
+			Convey("When the leave covers a weekend only", func() {
+				weekendReq := req
+				weekendReq.StartDate = "2025-05-10"
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", weekendReq, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "leave covers no working day")
+				})
+			})
+
+			Convey("When the end is before the start", func() {
+				badReq := req
+				badReq.EndDate = "2025-05-01"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", badReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When a date is malformed", func() {
+				badReq := req
+				badReq.StartDate = "05/05/2025"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
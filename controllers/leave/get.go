@@ -0,0 +1,36 @@
+package leave
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Get(ctx *gin.Context) {
+	requestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	request, err := c.leaveRepo.GetRequest(ctx, c.db, requestID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get leave request"})
+		return
+	}
+	if request == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "leave request not found"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, leave.NewRequestV1Response(request, utils.GetTimeFormatter(ctx)))
+}
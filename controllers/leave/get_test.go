@@ -0,0 +1,49 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a leave request", t, func() {
+			request := models.DummyLeaveRequest(s.faker)
+			request.ID = 1
+
+			Convey("When getting it", func() {
+				s.leaveRepo.EXPECT().
+					GetRequest(gomock.Any(), gomock.Any(), int64(1)).
+					Return(request, nil)
+
+				var resp dtos.LeaveRequestV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/requests/1", nil, &resp, http.StatusOK)
+
+				Convey("Then the request should be returned", func() {
+					So(resp.LeaveRequestID, ShouldEqual, 1)
+					So(resp.StartDate, ShouldEqual, "2025-05-05")
+					So(resp.EndDate, ShouldEqual, "2025-05-09")
+					So(resp.Days, ShouldEqual, 5)
+					So(resp.ReviewedAt, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When it does not exist", func() {
+				s.leaveRepo.EXPECT().
+					GetRequest(gomock.Any(), gomock.Any(), int64(2)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/requests/2", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/requests/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
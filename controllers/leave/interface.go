@@ -0,0 +1,50 @@
+package leave
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/leaverepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=leave
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type LeaveRepo interface {
+	CreateType(ctx context.Context, tx *gorm.DB, data *models.LeaveType) error
+	GetType(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveType, error)
+	GetTypeByCode(ctx context.Context, tx *gorm.DB, code string) (*models.LeaveType, error)
+	ListTypes(ctx context.Context, tx *gorm.DB) ([]*models.LeaveType, error)
+	SaveType(ctx context.Context, tx *gorm.DB, data *models.LeaveType) error
+
+	GetBalance(ctx context.Context, tx *gorm.DB, employeeID int64, leaveTypeID int64) (*models.LeaveBalance, error)
+	GetBalanceForUpdate(ctx context.Context, tx *gorm.DB, employeeID int64, leaveTypeID int64) (*models.LeaveBalance, error)
+	ListBalancesByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) (map[int64]*models.LeaveBalance, error)
+	SaveBalance(ctx context.Context, tx *gorm.DB, data *models.LeaveBalance) error
+
+	CreateRequest(ctx context.Context, tx *gorm.DB, data *models.LeaveRequest) error
+	GetRequest(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveRequest, error)
+	GetRequestForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveRequest, error)
+	SaveRequest(ctx context.Context, tx *gorm.DB, data *models.LeaveRequest) error
+	ListRequests(ctx context.Context, tx *gorm.DB, params leaverepo.ListRequestsParams) ([]*models.LeaveRequest, error)
+	ListActiveOverlapping(ctx context.Context, tx *gorm.DB, employeeID int64, startDate, endDate time.Time) ([]*models.LeaveRequest, error)
+	ListPendingDays(ctx context.Context, tx *gorm.DB, employeeID int64) (map[int64]float64, error)
+}
+
+type EmployeePositionRepo interface {
+	ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error)
+	GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error)
+}
+
+type DepartmentRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+}
@@ -0,0 +1,450 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=leave
+//
+
+// Package leave is a generated GoMock package.
+package leave
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	leaverepo "github.com/WangWilly/labs-hr-go/pkgs/repos/leaverepo"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockLeaveRepo is a mock of LeaveRepo interface.
+type MockLeaveRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockLeaveRepoMockRecorder is the mock recorder for MockLeaveRepo.
+type MockLeaveRepoMockRecorder struct {
+	mock *MockLeaveRepo
+}
+
+// NewMockLeaveRepo creates a new mock instance.
+func NewMockLeaveRepo(ctrl *gomock.Controller) *MockLeaveRepo {
+	mock := &MockLeaveRepo{ctrl: ctrl}
+	mock.recorder = &MockLeaveRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveRepo) EXPECT() *MockLeaveRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateRequest mocks base method.
+func (m *MockLeaveRepo) CreateRequest(ctx context.Context, tx *gorm.DB, data *models.LeaveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequest", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRequest indicates an expected call of CreateRequest.
+func (mr *MockLeaveRepoMockRecorder) CreateRequest(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockLeaveRepo)(nil).CreateRequest), ctx, tx, data)
+}
+
+// CreateType mocks base method.
+func (m *MockLeaveRepo) CreateType(ctx context.Context, tx *gorm.DB, data *models.LeaveType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateType", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateType indicates an expected call of CreateType.
+func (mr *MockLeaveRepoMockRecorder) CreateType(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateType", reflect.TypeOf((*MockLeaveRepo)(nil).CreateType), ctx, tx, data)
+}
+
+// GetBalance mocks base method.
+func (m *MockLeaveRepo) GetBalance(ctx context.Context, tx *gorm.DB, employeeID, leaveTypeID int64) (*models.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, tx, employeeID, leaveTypeID)
+	ret0, _ := ret[0].(*models.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLeaveRepoMockRecorder) GetBalance(ctx, tx, employeeID, leaveTypeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLeaveRepo)(nil).GetBalance), ctx, tx, employeeID, leaveTypeID)
+}
+
+// GetBalanceForUpdate mocks base method.
+func (m *MockLeaveRepo) GetBalanceForUpdate(ctx context.Context, tx *gorm.DB, employeeID, leaveTypeID int64) (*models.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceForUpdate", ctx, tx, employeeID, leaveTypeID)
+	ret0, _ := ret[0].(*models.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceForUpdate indicates an expected call of GetBalanceForUpdate.
+func (mr *MockLeaveRepoMockRecorder) GetBalanceForUpdate(ctx, tx, employeeID, leaveTypeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceForUpdate", reflect.TypeOf((*MockLeaveRepo)(nil).GetBalanceForUpdate), ctx, tx, employeeID, leaveTypeID)
+}
+
+// GetRequest mocks base method.
+func (m *MockLeaveRepo) GetRequest(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequest", ctx, tx, id)
+	ret0, _ := ret[0].(*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequest indicates an expected call of GetRequest.
+func (mr *MockLeaveRepoMockRecorder) GetRequest(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequest", reflect.TypeOf((*MockLeaveRepo)(nil).GetRequest), ctx, tx, id)
+}
+
+// GetRequestForUpdate mocks base method.
+func (m *MockLeaveRepo) GetRequestForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestForUpdate indicates an expected call of GetRequestForUpdate.
+func (mr *MockLeaveRepoMockRecorder) GetRequestForUpdate(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestForUpdate", reflect.TypeOf((*MockLeaveRepo)(nil).GetRequestForUpdate), ctx, tx, id)
+}
+
+// GetType mocks base method.
+func (m *MockLeaveRepo) GetType(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType", ctx, tx, id)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockLeaveRepoMockRecorder) GetType(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockLeaveRepo)(nil).GetType), ctx, tx, id)
+}
+
+// GetTypeByCode mocks base method.
+func (m *MockLeaveRepo) GetTypeByCode(ctx context.Context, tx *gorm.DB, code string) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTypeByCode", ctx, tx, code)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTypeByCode indicates an expected call of GetTypeByCode.
+func (mr *MockLeaveRepoMockRecorder) GetTypeByCode(ctx, tx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTypeByCode", reflect.TypeOf((*MockLeaveRepo)(nil).GetTypeByCode), ctx, tx, code)
+}
+
+// ListActiveOverlapping mocks base method.
+func (m *MockLeaveRepo) ListActiveOverlapping(ctx context.Context, tx *gorm.DB, employeeID int64, startDate, endDate time.Time) ([]*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveOverlapping", ctx, tx, employeeID, startDate, endDate)
+	ret0, _ := ret[0].([]*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveOverlapping indicates an expected call of ListActiveOverlapping.
+func (mr *MockLeaveRepoMockRecorder) ListActiveOverlapping(ctx, tx, employeeID, startDate, endDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveOverlapping", reflect.TypeOf((*MockLeaveRepo)(nil).ListActiveOverlapping), ctx, tx, employeeID, startDate, endDate)
+}
+
+// ListBalancesByEmployeeID mocks base method.
+func (m *MockLeaveRepo) ListBalancesByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) (map[int64]*models.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalancesByEmployeeID", ctx, tx, employeeID)
+	ret0, _ := ret[0].(map[int64]*models.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalancesByEmployeeID indicates an expected call of ListBalancesByEmployeeID.
+func (mr *MockLeaveRepoMockRecorder) ListBalancesByEmployeeID(ctx, tx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalancesByEmployeeID", reflect.TypeOf((*MockLeaveRepo)(nil).ListBalancesByEmployeeID), ctx, tx, employeeID)
+}
+
+// ListPendingDays mocks base method.
+func (m *MockLeaveRepo) ListPendingDays(ctx context.Context, tx *gorm.DB, employeeID int64) (map[int64]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingDays", ctx, tx, employeeID)
+	ret0, _ := ret[0].(map[int64]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingDays indicates an expected call of ListPendingDays.
+func (mr *MockLeaveRepoMockRecorder) ListPendingDays(ctx, tx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingDays", reflect.TypeOf((*MockLeaveRepo)(nil).ListPendingDays), ctx, tx, employeeID)
+}
+
+// ListRequests mocks base method.
+func (m *MockLeaveRepo) ListRequests(ctx context.Context, tx *gorm.DB, params leaverepo.ListRequestsParams) ([]*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequests", ctx, tx, params)
+	ret0, _ := ret[0].([]*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequests indicates an expected call of ListRequests.
+func (mr *MockLeaveRepoMockRecorder) ListRequests(ctx, tx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequests", reflect.TypeOf((*MockLeaveRepo)(nil).ListRequests), ctx, tx, params)
+}
+
+// ListTypes mocks base method.
+func (m *MockLeaveRepo) ListTypes(ctx context.Context, tx *gorm.DB) ([]*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTypes", ctx, tx)
+	ret0, _ := ret[0].([]*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTypes indicates an expected call of ListTypes.
+func (mr *MockLeaveRepoMockRecorder) ListTypes(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTypes", reflect.TypeOf((*MockLeaveRepo)(nil).ListTypes), ctx, tx)
+}
+
+// SaveBalance mocks base method.
+func (m *MockLeaveRepo) SaveBalance(ctx context.Context, tx *gorm.DB, data *models.LeaveBalance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBalance", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalance indicates an expected call of SaveBalance.
+func (mr *MockLeaveRepoMockRecorder) SaveBalance(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalance", reflect.TypeOf((*MockLeaveRepo)(nil).SaveBalance), ctx, tx, data)
+}
+
+// SaveRequest mocks base method.
+func (m *MockLeaveRepo) SaveRequest(ctx context.Context, tx *gorm.DB, data *models.LeaveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRequest", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRequest indicates an expected call of SaveRequest.
+func (mr *MockLeaveRepoMockRecorder) SaveRequest(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRequest", reflect.TypeOf((*MockLeaveRepo)(nil).SaveRequest), ctx, tx, data)
+}
+
+// SaveType mocks base method.
+func (m *MockLeaveRepo) SaveType(ctx context.Context, tx *gorm.DB, data *models.LeaveType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveType", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveType indicates an expected call of SaveType.
+func (mr *MockLeaveRepoMockRecorder) SaveType(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveType", reflect.TypeOf((*MockLeaveRepo)(nil).SaveType), ctx, tx, data)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetCurrentByEmployeeID mocks base method.
+func (m *MockEmployeePositionRepo) GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentByEmployeeID", ctx, tx, employeeID, nowtime)
+	ret0, _ := ret[0].(*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentByEmployeeID indicates an expected call of GetCurrentByEmployeeID.
+func (mr *MockEmployeePositionRepoMockRecorder) GetCurrentByEmployeeID(ctx, tx, employeeID, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).GetCurrentByEmployeeID), ctx, tx, employeeID, nowtime)
+}
+
+// ListByEmployeeID mocks base method.
+func (m *MockEmployeePositionRepo) ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeID", ctx, tx, employeeID)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeID indicates an expected call of ListByEmployeeID.
+func (mr *MockEmployeePositionRepoMockRecorder) ListByEmployeeID(ctx, tx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListByEmployeeID), ctx, tx, employeeID)
+}
+
+// MockDepartmentRepo is a mock of DepartmentRepo interface.
+type MockDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepoMockRecorder is the mock recorder for MockDepartmentRepo.
+type MockDepartmentRepoMockRecorder struct {
+	mock *MockDepartmentRepo
+}
+
+// NewMockDepartmentRepo creates a new mock instance.
+func NewMockDepartmentRepo(ctrl *gomock.Controller) *MockDepartmentRepo {
+	mock := &MockDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepo) EXPECT() *MockDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDepartmentRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepartmentRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepartmentRepo)(nil).Get), ctx, tx, id)
+}
@@ -0,0 +1,79 @@
+package leave
+
+import (
+	"net/http"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/leaverepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const defaultListLimit = 50
+
+type ListRequest struct {
+	EmployeeID int64  `form:"employee_id" binding:"omitempty,gt=0"`
+	Status     string `form:"status" binding:"omitempty,oneof=pending approved rejected cancelled"`
+	Cursor     string `form:"cursor"`
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+type ListResponse = dtos.PageV1Response[dtos.LeaveRequestV1Response]
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) List(ctx *gin.Context) {
+	var req ListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cursor, err := utils.DecodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	params := leaverepo.ListRequestsParams{
+		EmployeeID: req.EmployeeID,
+		Status:     req.Status,
+		Cursor:     cursor,
+		// Fetch one extra row to know whether there is a next page
+		Limit: limit + 1,
+	}
+	requests, err := c.leaveRepo.ListRequests(ctx, c.db, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list leave requests"})
+		return
+	}
+
+	nextCursor := ""
+	if len(requests) > limit {
+		requests = requests[:limit]
+		nextCursor = utils.EncodeCursor(leaverepo.ListRequestsCursor(requests[limit-1]))
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	formatter := utils.GetTimeFormatter(ctx)
+	items := make([]dtos.LeaveRequestV1Response, 0, len(requests))
+	for _, request := range requests {
+		items = append(items, leave.NewRequestV1Response(request, formatter))
+	}
+
+	ctx.JSON(http.StatusOK, ListResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	})
+}
@@ -0,0 +1,54 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/leaverepo"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given three pending requests of an employee", t, func() {
+			requests := make([]*models.LeaveRequest, 0, 3)
+			for i := 1; i <= 3; i++ {
+				request := models.DummyLeaveRequest(s.faker)
+				request.ID = int64(i)
+				request.EmployeeID = 123
+				requests = append(requests, request)
+			}
+
+			Convey("When listing a page of two", func(c C) {
+				s.leaveRepo.EXPECT().
+					ListRequests(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, params leaverepo.ListRequestsParams) ([]*models.LeaveRequest, error) {
+						c.So(params.EmployeeID, ShouldEqual, 123)
+						c.So(params.Status, ShouldEqual, models.LeaveStatusPending)
+						c.So(params.Cursor, ShouldBeNil)
+						c.So(params.Limit, ShouldEqual, 3)
+						return requests, nil
+					})
+
+				var resp ListResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/requests?employee_id=123&status=pending&limit=2", nil, &resp, http.StatusOK)
+
+				Convey("Then a next cursor should be returned", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.NextCursor, ShouldEqual, utils.EncodeCursor(utils.Cursor{ID: 2}))
+				})
+			})
+
+			Convey("When the status is unknown", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/requests?status=done", nil, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the cursor is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/requests?cursor=!!", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,157 @@
+package leave
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/approval"
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ReviewRequest struct {
+	ReviewerID int64  `json:"reviewer_id" binding:"required"`
+	Note       string `json:"note" binding:"max=255"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Approve takes the days of the request from the balance.
+func (c *Controller) Approve(ctx *gin.Context) {
+	c.review(ctx, models.LeaveStatusApproved)
+}
+
+// Reject releases the days reserved by the request.
+func (c *Controller) Reject(ctx *gin.Context) {
+	c.review(ctx, models.LeaveStatusRejected)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) review(ctx *gin.Context, status string) {
+	requestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ReviewRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var resp dtos.LeaveRequestV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		// Lock the request so it is reviewed only once
+		request, err := c.leaveRepo.GetRequestForUpdate(ctx, tx.DB, requestID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave request")
+		}
+		if request == nil {
+			return utils.NewHttpError(http.StatusNotFound, "leave request not found")
+		}
+		if request.Status != models.LeaveStatusPending {
+			return utils.NewHttpError(http.StatusConflict, "leave request already reviewed")
+		}
+
+		nowTime := c.timeModule.Now()
+		if err := c.checkReviewer(ctx, tx.DB, request.EmployeeID, req.ReviewerID, nowTime); err != nil {
+			return err
+		}
+
+		if status == models.LeaveStatusApproved {
+			if err := c.takeDays(ctx, tx.DB, request, nowTime); err != nil {
+				return err
+			}
+		}
+
+		request.Status = status
+		request.ReviewerID = &req.ReviewerID
+		request.ReviewNote = req.Note
+		request.ReviewedAt = &nowTime
+		if err := c.leaveRepo.SaveRequest(ctx, tx.DB, request); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save leave request")
+		}
+
+		resp = leave.NewRequestV1Response(request, utils.GetTimeFormatter(ctx))
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to review leave request")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// checkReviewer allows the heads of the employee's current department and of
+// its ancestors to review, never the employee themself.
+func (c *Controller) checkReviewer(ctx *gin.Context, tx *gorm.DB, employeeID int64, reviewerID int64, nowTime time.Time) error {
+	if reviewerID == employeeID {
+		return utils.NewHttpError(http.StatusForbidden, "employees cannot review their own leave")
+	}
+
+	isManager, err := approval.IsManager(ctx, tx, c.employeePositionRepo, c.departmentRepo, employeeID, reviewerID, nowTime)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to check reviewer")
+	}
+	if !isManager {
+		return utils.NewHttpError(http.StatusForbidden, "reviewer is not a manager of the employee")
+	}
+
+	return nil
+}
+
+// takeDays debits the days of the request from the balance of its type, if
+// the type tracks one.
+func (c *Controller) takeDays(ctx *gin.Context, tx *gorm.DB, request *models.LeaveRequest, nowTime time.Time) error {
+	return c.moveDays(ctx, tx, request, -request.Days, nowTime)
+}
+
+// refundDays credits the days of the request back to the balance.
+func (c *Controller) refundDays(ctx *gin.Context, tx *gorm.DB, request *models.LeaveRequest, nowTime time.Time) error {
+	return c.moveDays(ctx, tx, request, request.Days, nowTime)
+}
+
+func (c *Controller) moveDays(ctx *gin.Context, tx *gorm.DB, request *models.LeaveRequest, days float64, nowTime time.Time) error {
+	leaveType, err := c.leaveRepo.GetType(ctx, tx, request.LeaveTypeID)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave type")
+	}
+	if leaveType == nil || !leaveType.TracksBalance {
+		return nil
+	}
+
+	hireDate, err := c.hireDate(ctx, tx, request.EmployeeID)
+	if err != nil {
+		return err
+	}
+	balance, err := c.lockBalance(ctx, tx, request.EmployeeID, leaveType, hireDate, nowTime)
+	if err != nil {
+		return err
+	}
+
+	balance.BalanceDays = leave.RoundDays(balance.BalanceDays + days)
+	if balance.BalanceDays < 0 {
+		return utils.NewHttpError(http.StatusConflict, "insufficient leave balance")
+	}
+	if err := c.leaveRepo.SaveBalance(ctx, tx, balance); err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to save leave balance")
+	}
+
+	return nil
+}
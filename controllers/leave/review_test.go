@@ -0,0 +1,175 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReview(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a pending annual leave request", t, func() {
+			employeeID := int64(123)
+			managerID := int64(456)
+			departmentID := int64(10)
+			nowTime := time.Date(2025, 4, 15, 9, 0, 0, 0, time.UTC)
+
+			request := models.DummyLeaveRequest(s.faker)
+			request.ID = 1
+			request.EmployeeID = employeeID
+			request.LeaveTypeID = 1
+
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, DepartmentID: departmentID, StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+			}
+			annual := &models.LeaveType{ID: 1, Code: models.LeaveTypeCodeAnnual, TracksBalance: true, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 30}
+
+			expectRequest := func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(request, nil)
+			}
+			expectManager := func() {
+				s.employeePositionRepo.EXPECT().
+					GetCurrentByEmployeeID(gomock.Any(), gomock.Any(), employeeID, nowTime).
+					Return(positions[0], nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), departmentID).
+					Return(&models.Department{ID: departmentID, HeadEmployeeID: lo.ToPtr(managerID)}, nil)
+			}
+			expectBalance := func(balanceDays float64) {
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(1)).
+					Return(annual, nil)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(positions, nil)
+				s.leaveRepo.EXPECT().
+					GetBalanceForUpdate(gomock.Any(), gomock.Any(), employeeID, int64(1)).
+					Return(&models.LeaveBalance{
+						ID:             7,
+						EmployeeID:     employeeID,
+						LeaveTypeID:    1,
+						BalanceDays:    balanceDays,
+						AccruedThrough: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			}
+
+			Convey("When the manager approves it", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				expectRequest()
+				expectManager()
+				expectBalance(7.25)
+				s.leaveRepo.EXPECT().
+					SaveBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, balance *models.LeaveBalance) error {
+						c.So(balance.BalanceDays, ShouldEqual, 2.25)
+						return nil
+					})
+				s.leaveRepo.EXPECT().
+					SaveRequest(gomock.Any(), gomock.Any(), request).
+					Return(nil)
+
+				req := ReviewRequest{ReviewerID: managerID, Note: "Enjoy"}
+				var resp dtos.LeaveRequestV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/approve", req, &resp, http.StatusOK)
+
+				Convey("Then the request should be approved", func() {
+					So(resp.Status, ShouldEqual, models.LeaveStatusApproved)
+					So(*resp.ReviewerID, ShouldEqual, managerID)
+					So(resp.ReviewNote, ShouldEqual, "Enjoy")
+					So(resp.ReviewedAt, ShouldEqual, "2025-04-15 09:00:00")
+				})
+			})
+
+			Convey("When the balance was adjusted below the request", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				expectRequest()
+				expectManager()
+				expectBalance(3)
+
+				req := ReviewRequest{ReviewerID: managerID}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/approve", req, nil, http.StatusConflict)
+			})
+
+			Convey("When the manager rejects it", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				expectRequest()
+				expectManager()
+				s.leaveRepo.EXPECT().
+					SaveRequest(gomock.Any(), gomock.Any(), request).
+					Return(nil)
+
+				req := ReviewRequest{ReviewerID: managerID}
+				var resp dtos.LeaveRequestV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/reject", req, &resp, http.StatusOK)
+
+				Convey("Then the balance should be untouched", func() {
+					So(resp.Status, ShouldEqual, models.LeaveStatusRejected)
+				})
+			})
+
+			Convey("When the reviewer is not a manager", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				expectRequest()
+				expectManager()
+
+				req := ReviewRequest{ReviewerID: 789}
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/approve", req, &errorResponse, http.StatusForbidden)
+
+				Convey("Then the review should be refused", func() {
+					So(errorResponse["error"], ShouldEqual, "reviewer is not a manager of the employee")
+				})
+			})
+
+			Convey("When the employee reviews their own request", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				expectRequest()
+
+				req := ReviewRequest{ReviewerID: employeeID}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/approve", req, nil, http.StatusForbidden)
+			})
+
+			Convey("When the request was already reviewed", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				reviewed := *request
+				reviewed.Status = models.LeaveStatusRejected
+				s.leaveRepo.EXPECT().
+					GetRequestForUpdate(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&reviewed, nil)
+
+				req := ReviewRequest{ReviewerID: managerID}
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/approve", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the review should be refused", func() {
+					So(errorResponse["error"], ShouldEqual, "leave request already reviewed")
+				})
+			})
+
+			Convey("When the reviewer is missing", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/requests/1/approve", ReviewRequest{}, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,147 @@
+package leave
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/leave"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type TypeRequest struct {
+	Code          string `json:"code"           binding:"required,max=32"`
+	Name          string `json:"name"           binding:"required,max=100"`
+	Paid          bool   `json:"paid"`
+	TracksBalance bool   `json:"tracks_balance"`
+	// AccrualDaysPerMonth is credited on the first day of every month
+	AccrualDaysPerMonth float64 `json:"accrual_days_per_month" binding:"min=0,max=31"`
+	// MaxBalanceDays caps the accrued balance, zero for no cap
+	MaxBalanceDays float64 `json:"max_balance_days" binding:"min=0,max=3650"`
+}
+
+type ListTypesResponse struct {
+	Items []dtos.LeaveTypeV1Response `json:"items"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) CreateType(ctx *gin.Context) {
+	var req TypeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	leaveType := &models.LeaveType{}
+	applyTypeRequest(leaveType, req)
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if err := c.checkTypeCode(ctx, tx, leaveType); err != nil {
+			return err
+		}
+
+		if err := c.leaveRepo.CreateType(ctx, tx.DB, leaveType); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create leave type")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create leave type")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, leave.NewTypeV1Response(leaveType))
+}
+
+func (c *Controller) ListTypes(ctx *gin.Context) {
+	leaveTypes, err := c.leaveRepo.ListTypes(ctx, c.db)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list leave types"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ListTypesResponse{
+		Items: lo.Map(leaveTypes, func(leaveType *models.LeaveType, _ int) dtos.LeaveTypeV1Response {
+			return leave.NewTypeV1Response(leaveType)
+		}),
+	})
+}
+
+// UpdateType replaces a leave type. A new accrual rule applies to the months
+// not credited yet.
+func (c *Controller) UpdateType(ctx *gin.Context) {
+	leaveTypeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req TypeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var leaveType *models.LeaveType
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		leaveType, err = c.leaveRepo.GetType(ctx, tx.DB, leaveTypeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave type")
+		}
+		if leaveType == nil {
+			return utils.NewHttpError(http.StatusNotFound, "leave type not found")
+		}
+
+		applyTypeRequest(leaveType, req)
+		if err := c.checkTypeCode(ctx, tx, leaveType); err != nil {
+			return err
+		}
+
+		if err := c.leaveRepo.SaveType(ctx, tx.DB, leaveType); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save leave type")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to save leave type")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, leave.NewTypeV1Response(leaveType))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func applyTypeRequest(leaveType *models.LeaveType, req TypeRequest) {
+	leaveType.Code = strings.ToLower(strings.TrimSpace(req.Code))
+	leaveType.Name = strings.TrimSpace(req.Name)
+	leaveType.Paid = req.Paid
+	leaveType.TracksBalance = req.TracksBalance
+	leaveType.AccrualDaysPerMonth = leave.RoundDays(req.AccrualDaysPerMonth)
+	leaveType.MaxBalanceDays = leave.RoundDays(req.MaxBalanceDays)
+}
+
+// checkTypeCode makes sure no other leave type uses the code.
+func (c *Controller) checkTypeCode(ctx *gin.Context, tx *txmanager.Tx, leaveType *models.LeaveType) error {
+	existing, err := c.leaveRepo.GetTypeByCode(ctx, tx.DB, leaveType.Code)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to get leave type")
+	}
+	if existing != nil && existing.ID != leaveType.ID {
+		return utils.NewHttpError(http.StatusConflict, "leave type already exists")
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package leave
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateType(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a new leave type", t, func() {
+			req := TypeRequest{
+				Code:                " Parental ",
+				Name:                "Parental leave",
+				Paid:                true,
+				TracksBalance:       true,
+				AccrualDaysPerMonth: 0.5,
+				MaxBalanceDays:      10,
+			}
+
+			Convey("When creating it", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.leaveRepo.EXPECT().
+					GetTypeByCode(gomock.Any(), gomock.Any(), "parental").
+					Return(nil, nil)
+				s.leaveRepo.EXPECT().
+					CreateType(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, leaveType *models.LeaveType) error {
+						c.So(leaveType.Paid, ShouldBeTrue)
+						leaveType.ID = 4
+						return nil
+					})
+
+				var resp dtos.LeaveTypeV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/types", req, &resp, http.StatusCreated)
+
+				Convey("Then the normalized type should be returned", func() {
+					So(resp.LeaveTypeID, ShouldEqual, 4)
+					So(resp.Code, ShouldEqual, "parental")
+					So(resp.AccrualDaysPerMonth, ShouldEqual, 0.5)
+					So(resp.MaxBalanceDays, ShouldEqual, 10)
+				})
+			})
+
+			Convey("When the code is taken", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.leaveRepo.EXPECT().
+					GetTypeByCode(gomock.Any(), gomock.Any(), "parental").
+					Return(&models.LeaveType{ID: 1, Code: "parental"}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/types", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the type should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "leave type already exists")
+				})
+			})
+
+			Convey("When the accrual is negative", func() {
+				badReq := req
+				badReq.AccrualDaysPerMonth = -1
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/leave/types", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func TestUpdateType(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an existing leave type", t, func() {
+			leaveType := &models.LeaveType{
+				ID:                  1,
+				Code:                models.LeaveTypeCodeAnnual,
+				Name:                "Annual leave",
+				Paid:                true,
+				TracksBalance:       true,
+				AccrualDaysPerMonth: 1.75,
+				MaxBalanceDays:      30,
+			}
+			req := TypeRequest{
+				Code:                models.LeaveTypeCodeAnnual,
+				Name:                "Annual leave",
+				Paid:                true,
+				TracksBalance:       true,
+				AccrualDaysPerMonth: 2,
+			}
+
+			Convey("When changing its accrual", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(1)).
+					Return(leaveType, nil)
+				s.leaveRepo.EXPECT().
+					GetTypeByCode(gomock.Any(), gomock.Any(), models.LeaveTypeCodeAnnual).
+					Return(leaveType, nil)
+				s.leaveRepo.EXPECT().
+					SaveType(gomock.Any(), gomock.Any(), leaveType).
+					Return(nil)
+
+				var resp dtos.LeaveTypeV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/leave/types/1", req, &resp, http.StatusOK)
+
+				Convey("Then the new rule should be returned", func() {
+					So(resp.AccrualDaysPerMonth, ShouldEqual, 2)
+					So(resp.MaxBalanceDays, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the type does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.leaveRepo.EXPECT().
+					GetType(gomock.Any(), gomock.Any(), int64(9)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/leave/types/9", req, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func TestListTypes(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given the seeded leave types", t, func() {
+			s.leaveRepo.EXPECT().
+				ListTypes(gomock.Any(), gomock.Any()).
+				Return([]*models.LeaveType{
+					{ID: 1, Code: models.LeaveTypeCodeAnnual},
+					{ID: 2, Code: models.LeaveTypeCodeSick},
+				}, nil)
+
+			var resp ListTypesResponse
+			s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/leave/types", nil, &resp, http.StatusOK)
+
+			Convey("Then every type should be listed", func() {
+				So(resp.Items, ShouldHaveLength, 2)
+				So(resp.Items[1].Code, ShouldEqual, models.LeaveTypeCodeSick)
+			})
+		})
+	})
+}
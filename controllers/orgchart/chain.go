@@ -0,0 +1,67 @@
+package orgchart
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/orgchart"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Chain returns the managers above the employee, from the direct manager up
+// to the top of the chart.
+func (c *Controller) Chain(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ChartRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	asOf, err := c.parseAsOf(req.AsOf)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employeeInfo, err := c.employeeInfoRepo.Get(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee"})
+		return
+	}
+	if employeeInfo == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	chart, err := c.loadChart(ctx, asOf, employeeID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to load org chart")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load org chart"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	chain := orgchart.Chain(chart.managers, employeeID)
+	ctx.JSON(http.StatusOK, dtos.OrgChartChainV1Response{
+		AsOf:       asOf.Format(orgchart.DateLayout),
+		EmployeeID: employeeID,
+		Chain: lo.Map(chain, func(managerID int64, _ int) dtos.OrgChartEmployeeV1Response {
+			return orgchart.NewEmployeeV1Response(managerID, chart.employeeInfos, chart.employeePositions)
+		}),
+	})
+}
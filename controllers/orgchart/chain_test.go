@@ -0,0 +1,60 @@
+package orgchart
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChain(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee two levels below the top", t, func() {
+			asOf := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			Convey("When fetching the chain of the employee", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(3)).
+					Return(&models.EmployeeInfo{ID: 3, Name: "Carol"}, nil)
+				expectChart(s, asOf)
+
+				var resp dtos.OrgChartChainV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/3/chain?as_of=2025-06-01", nil, &resp, http.StatusOK)
+
+				Convey("Then the chain should go from the direct manager to the top", func() {
+					So(resp.EmployeeID, ShouldEqual, 3)
+					So(resp.Chain, ShouldResemble, []dtos.OrgChartEmployeeV1Response{
+						{EmployeeID: 2, Name: "Bob", Position: "Engineering Manager", Department: "Engineering"},
+						{EmployeeID: 1, Name: "Alice", Position: "CTO", Department: "Engineering"},
+					})
+				})
+			})
+
+			Convey("When fetching the chain of the top manager", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&models.EmployeeInfo{ID: 1, Name: "Alice"}, nil)
+				expectChart(s, asOf)
+
+				var resp dtos.OrgChartChainV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/1/chain?as_of=2025-06-01", nil, &resp, http.StatusOK)
+
+				Convey("Then the chain should be empty", func() {
+					So(resp.Chain, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(9)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/9/chain?as_of=2025-06-01", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package orgchart
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/orgchart"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var errInvalidAsOf = errors.New("invalid as_of")
+
+// chart is the reporting tree on a date with the employees in it.
+type chart struct {
+	asOf              time.Time
+	managers          map[int64]int64
+	employeeInfos     map[int64]*models.EmployeeInfo
+	employeePositions map[int64]*models.EmployeePosition
+}
+
+// parseAsOf returns the day of the chart, today when empty.
+func (c *Controller) parseAsOf(asOf string) (time.Time, error) {
+	if asOf == "" {
+		return c.timeModule.Now().UTC().Truncate(24 * time.Hour), nil
+	}
+	date, err := time.ParseInLocation(orgchart.DateLayout, asOf, time.UTC)
+	if err != nil {
+		return time.Time{}, errInvalidAsOf
+	}
+	return date, nil
+}
+
+// loadChart returns the reporting tree on the date. Terminated employees are
+// left out of it, the employees reporting to them being at the top of the
+// chart until they get a new manager. The extra employees are loaded even
+// when in no reporting line.
+func (c *Controller) loadChart(ctx *gin.Context, asOf time.Time, extraIDs ...int64) (*chart, error) {
+	lines, err := c.reportingLineRepo.ListEffective(ctx, c.db, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reporting lines: %w", err)
+	}
+	managers := orgchart.Managers(lines, asOf)
+
+	employeeIDs := lo.Uniq(append(orgchart.EmployeeIDs(managers), extraIDs...))
+	employeeInfos, err := c.employeeInfoRepo.ListByIDs(ctx, c.db, employeeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employees: %w", err)
+	}
+	infos := lo.KeyBy(employeeInfos, func(employeeInfo *models.EmployeeInfo) int64 {
+		return employeeInfo.ID
+	})
+	managers = orgchart.Prune(managers, func(employeeID int64) bool {
+		_, ok := infos[employeeID]
+		return ok
+	})
+
+	activeIDs := lo.Keys(infos)
+	slices.Sort(activeIDs)
+	positions, err := c.employeePositionRepo.ListCurrentByEmployeeIDs(ctx, c.db, activeIDs, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employee positions: %w", err)
+	}
+
+	return &chart{
+		asOf:              asOf,
+		managers:          managers,
+		employeeInfos:     infos,
+		employeePositions: positions,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package orgchart
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager            TxManager
+	timeModule           TimeModule
+	reportingLineRepo    ReportingLineRepo
+	employeeInfoRepo     EmployeeInfoRepo
+	employeePositionRepo EmployeePositionRepo
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	reportingLineRepo ReportingLineRepo,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+) *Controller {
+	return &Controller{
+		cfg:                  cfg,
+		db:                   db,
+		txManager:            txManager,
+		timeModule:           timeModule,
+		reportingLineRepo:    reportingLineRepo,
+		employeeInfoRepo:     employeeInfoRepo,
+		employeePositionRepo: employeePositionRepo,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// reporting lines
+	r.PUT("/employee/:id/reports-to", c.SetReportsTo)
+	r.GET("/employee/:id/reports-to", c.ListReportsTo)
+
+	////////////////////////////////////////////////////////////////////////////
+	// org chart
+	r.GET("/employee/:id/reports", c.Reports)
+	r.GET("/employee/:id/chain", c.Chain)
+	r.GET("/employee/orgchart", c.Export)
+}
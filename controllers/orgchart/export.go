@@ -0,0 +1,81 @@
+package orgchart
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/orgchart"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	exportFormatJSON = "json"
+	exportFormatDOT  = "dot"
+)
+
+type ExportRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=json dot"`
+	// AsOf is the date of the chart, today when empty
+	AsOf string `form:"as_of"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Export returns the whole org chart, rooted at the employees reporting to
+// nobody. Employees in no reporting line are not part of the chart.
+func (c *Controller) Export(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	var req ExportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Format == "" {
+		req.Format = exportFormatJSON
+	}
+	asOf, err := c.parseAsOf(req.AsOf)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	chart, err := c.loadChart(ctx, asOf)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to load org chart")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load org chart"})
+		return
+	}
+
+	resp := dtos.OrgChartV1Response{
+		AsOf: asOf.Format(orgchart.DateLayout),
+		Roots: lo.Map(orgchart.Forest(chart.managers), func(root *orgchart.Node, _ int) dtos.OrgChartNodeV1Response {
+			return orgchart.NewNodeV1Response(root, chart.employeeInfos, chart.employeePositions)
+		}),
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if req.Format == exportFormatJSON {
+		ctx.JSON(http.StatusOK, resp)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := orgchart.WriteDOT(&buf, resp); err != nil {
+		logger.Error().Err(err).Msg("Failed to render org chart")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to render org chart"})
+		return
+	}
+	filename := fmt.Sprintf("orgchart-%s.%s", resp.AsOf, exportFormatDOT)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Data(http.StatusOK, orgchart.DOTContentType, buf.Bytes())
+}
@@ -0,0 +1,54 @@
+package orgchart
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExport(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a chart with a single root", t, func() {
+			asOf := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			Convey("When exporting as JSON", func() {
+				expectChart(s, asOf)
+
+				var resp dtos.OrgChartV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/orgchart?as_of=2025-06-01", nil, &resp, http.StatusOK)
+
+				Convey("Then the chart should be rooted at the top manager", func() {
+					So(resp.AsOf, ShouldEqual, "2025-06-01")
+					So(resp.Roots, ShouldHaveLength, 1)
+					So(resp.Roots[0].EmployeeID, ShouldEqual, 1)
+					So(resp.Roots[0].Reports[0].Reports, ShouldHaveLength, 2)
+				})
+			})
+
+			Convey("When exporting as DOT", func() {
+				expectChart(s, asOf)
+
+				var resp string
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/orgchart?format=dot&as_of=2025-06-01", nil, &resp, http.StatusOK)
+
+				Convey("Then every manager should point to their reports", func() {
+					lines := strings.Split(strings.TrimSpace(resp), "\n")
+					So(lines[0], ShouldEqual, "digraph orgchart {")
+					So(resp, ShouldContainSubstring, "\t1 [label=\"Alice\\nCTO\"];\n")
+					So(resp, ShouldContainSubstring, "\t1 -> 2;\n")
+					So(resp, ShouldContainSubstring, "\t2 -> 4;\n")
+					So(resp, ShouldNotContainSubstring, "-> 5;")
+					So(lines[len(lines)-1], ShouldEqual, "}")
+				})
+			})
+
+			Convey("When the format is unknown", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/orgchart?format=svg", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
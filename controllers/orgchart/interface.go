@@ -0,0 +1,36 @@
+package orgchart
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=orgchart
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type ReportingLineRepo interface {
+	Create(ctx context.Context, tx *gorm.DB, data *models.ReportingLine) error
+	Save(ctx context.Context, tx *gorm.DB, data *models.ReportingLine) error
+	ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.ReportingLine, error)
+	ListForUpdate(ctx context.Context, tx *gorm.DB) ([]*models.ReportingLine, error)
+	ListEffective(ctx context.Context, tx *gorm.DB, date time.Time) ([]*models.ReportingLine, error)
+}
+
+type EmployeeInfoRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+	ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error)
+}
@@ -0,0 +1,287 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=orgchart
+//
+
+// Package orgchart is a generated GoMock package.
+package orgchart
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockReportingLineRepo is a mock of ReportingLineRepo interface.
+type MockReportingLineRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportingLineRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockReportingLineRepoMockRecorder is the mock recorder for MockReportingLineRepo.
+type MockReportingLineRepoMockRecorder struct {
+	mock *MockReportingLineRepo
+}
+
+// NewMockReportingLineRepo creates a new mock instance.
+func NewMockReportingLineRepo(ctrl *gomock.Controller) *MockReportingLineRepo {
+	mock := &MockReportingLineRepo{ctrl: ctrl}
+	mock.recorder = &MockReportingLineRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportingLineRepo) EXPECT() *MockReportingLineRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReportingLineRepo) Create(ctx context.Context, tx *gorm.DB, data *models.ReportingLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReportingLineRepoMockRecorder) Create(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReportingLineRepo)(nil).Create), ctx, tx, data)
+}
+
+// ListByEmployeeID mocks base method.
+func (m *MockReportingLineRepo) ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.ReportingLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeID", ctx, tx, employeeID)
+	ret0, _ := ret[0].([]*models.ReportingLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeID indicates an expected call of ListByEmployeeID.
+func (mr *MockReportingLineRepoMockRecorder) ListByEmployeeID(ctx, tx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeID", reflect.TypeOf((*MockReportingLineRepo)(nil).ListByEmployeeID), ctx, tx, employeeID)
+}
+
+// ListEffective mocks base method.
+func (m *MockReportingLineRepo) ListEffective(ctx context.Context, tx *gorm.DB, date time.Time) ([]*models.ReportingLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffective", ctx, tx, date)
+	ret0, _ := ret[0].([]*models.ReportingLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffective indicates an expected call of ListEffective.
+func (mr *MockReportingLineRepoMockRecorder) ListEffective(ctx, tx, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffective", reflect.TypeOf((*MockReportingLineRepo)(nil).ListEffective), ctx, tx, date)
+}
+
+// ListForUpdate mocks base method.
+func (m *MockReportingLineRepo) ListForUpdate(ctx context.Context, tx *gorm.DB) ([]*models.ReportingLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUpdate", ctx, tx)
+	ret0, _ := ret[0].([]*models.ReportingLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUpdate indicates an expected call of ListForUpdate.
+func (mr *MockReportingLineRepoMockRecorder) ListForUpdate(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUpdate", reflect.TypeOf((*MockReportingLineRepo)(nil).ListForUpdate), ctx, tx)
+}
+
+// Save mocks base method.
+func (m *MockReportingLineRepo) Save(ctx context.Context, tx *gorm.DB, data *models.ReportingLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReportingLineRepoMockRecorder) Save(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReportingLineRepo)(nil).Save), ctx, tx, data)
+}
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// ListByIDs mocks base method.
+func (m *MockEmployeeInfoRepo) ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, tx, ids)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockEmployeeInfoRepoMockRecorder) ListByIDs(ctx, tx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).ListByIDs), ctx, tx, ids)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListCurrentByEmployeeIDs mocks base method.
+func (m *MockEmployeePositionRepo) ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrentByEmployeeIDs", ctx, tx, employeeIDs, nowtime)
+	ret0, _ := ret[0].(map[int64]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrentByEmployeeIDs indicates an expected call of ListCurrentByEmployeeIDs.
+func (mr *MockEmployeePositionRepoMockRecorder) ListCurrentByEmployeeIDs(ctx, tx, employeeIDs, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrentByEmployeeIDs", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListCurrentByEmployeeIDs), ctx, tx, employeeIDs, nowtime)
+}
@@ -0,0 +1,70 @@
+package orgchart
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/orgchart"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ChartRequest struct {
+	// AsOf is the date of the chart, today when empty
+	AsOf string `form:"as_of"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Reports returns the employees reporting to the employee, directly or not,
+// as a tree rooted at the employee.
+func (c *Controller) Reports(ctx *gin.Context) {
+	logger := log.Ctx(ctx.Request.Context())
+
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ChartRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	asOf, err := c.parseAsOf(req.AsOf)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employeeInfo, err := c.employeeInfoRepo.Get(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee"})
+		return
+	}
+	if employeeInfo == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	chart, err := c.loadChart(ctx, asOf, employeeID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to load org chart")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load org chart"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	tree := orgchart.Tree(chart.managers, employeeID)
+	ctx.JSON(http.StatusOK, dtos.OrgChartReportsV1Response{
+		AsOf: asOf.Format(orgchart.DateLayout),
+		Tree: orgchart.NewNodeV1Response(tree, chart.employeeInfos, chart.employeePositions),
+	})
+}
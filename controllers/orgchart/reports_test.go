@@ -0,0 +1,105 @@
+package orgchart
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+// expectChart expects the loading of a chart where 2 and 5 report to 1, and
+// 3 and 4 report to 2. 5 is terminated.
+func expectChart(s *testSuite, asOf time.Time) {
+	lines := []*models.ReportingLine{
+		{ID: 1, EmployeeID: 2, ManagerID: lo.ToPtr(int64(1)), StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, EmployeeID: 3, ManagerID: lo.ToPtr(int64(2)), StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, EmployeeID: 4, ManagerID: lo.ToPtr(int64(2)), StartDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, EmployeeID: 5, ManagerID: lo.ToPtr(int64(1)), StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	employeeInfos := []*models.EmployeeInfo{
+		{ID: 1, Name: "Alice"},
+		{ID: 2, Name: "Bob"},
+		{ID: 3, Name: "Carol"},
+		{ID: 4, Name: "Dave"},
+	}
+	employeePositions := map[int64]*models.EmployeePosition{
+		1: {EmployeeID: 1, Position: "CTO", Department: "Engineering"},
+		2: {EmployeeID: 2, Position: "Engineering Manager", Department: "Engineering"},
+		3: {EmployeeID: 3, Position: "Developer", Department: "Engineering"},
+	}
+
+	s.reportingLineRepo.EXPECT().
+		ListEffective(gomock.Any(), gomock.Any(), asOf).
+		Return(lines, nil)
+	s.employeeInfoRepo.EXPECT().
+		ListByIDs(gomock.Any(), gomock.Any(), []int64{1, 2, 3, 4, 5}).
+		Return(employeeInfos, nil)
+	s.employeePositionRepo.EXPECT().
+		ListCurrentByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{1, 2, 3, 4}, asOf).
+		Return(employeePositions, nil)
+}
+
+func TestReports(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a manager with two reports", t, func() {
+			asOf := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			Convey("When fetching the reports of the top manager", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&models.EmployeeInfo{ID: 1, Name: "Alice"}, nil)
+				expectChart(s, asOf)
+
+				var resp dtos.OrgChartReportsV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/1/reports?as_of=2025-06-01", nil, &resp, http.StatusOK)
+
+				Convey("Then the reports should be a tree without terminated employees", func() {
+					So(resp.AsOf, ShouldEqual, "2025-06-01")
+					So(resp.Tree.EmployeeID, ShouldEqual, 1)
+					So(resp.Tree.Position, ShouldEqual, "CTO")
+					So(resp.Tree.Reports, ShouldHaveLength, 1)
+					So(resp.Tree.Reports[0].Name, ShouldEqual, "Bob")
+					So(resp.Tree.Reports[0].Reports, ShouldHaveLength, 2)
+					So(resp.Tree.Reports[0].Reports[0].EmployeeID, ShouldEqual, 3)
+					So(resp.Tree.Reports[0].Reports[1].EmployeeID, ShouldEqual, 4)
+					So(resp.Tree.Reports[0].Reports[1].Position, ShouldBeEmpty)
+					So(resp.Tree.Reports[0].Reports[1].Reports, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When no date is given", func() {
+				s.timeModule.EXPECT().
+					Now().
+					Return(time.Date(2025, 6, 1, 15, 30, 0, 0, time.UTC))
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(&models.EmployeeInfo{ID: 1, Name: "Alice"}, nil)
+				expectChart(s, asOf)
+
+				var resp dtos.OrgChartReportsV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/1/reports", nil, &resp, http.StatusOK)
+
+				Convey("Then the chart should be the one of today", func() {
+					So(resp.AsOf, ShouldEqual, "2025-06-01")
+				})
+			})
+
+			Convey("When the date is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/1/reports?as_of=tomorrow", nil, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(9)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/9/reports?as_of=2025-06-01", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,149 @@
+package orgchart
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/orgchart"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ReportsToRequest struct {
+	// ManagerID is null to make the employee report to nobody
+	ManagerID *int64 `json:"manager_id" binding:"omitempty,gt=0"`
+	StartDate string `json:"start_date" binding:"required"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SetReportsTo makes the employee report to the manager from the start date.
+// A line starting on the same day is replaced. The line is refused when it
+// would make an employee report to themselves, on any day from its start.
+func (c *Controller) SetReportsTo(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ReportsToRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, err := time.ParseInLocation(orgchart.DateLayout, req.StartDate, time.UTC)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid start_date"})
+		return
+	}
+	if req.ManagerID != nil && *req.ManagerID == employeeID {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "employee cannot report to themselves"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	line := &models.ReportingLine{
+		EmployeeID: employeeID,
+		ManagerID:  req.ManagerID,
+		StartDate:  startDate,
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		employeeInfo, err := c.employeeInfoRepo.Get(ctx, tx.DB, employeeID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee")
+		}
+		if employeeInfo == nil {
+			return utils.NewHttpError(http.StatusNotFound, "employee not found")
+		}
+		if req.ManagerID != nil {
+			manager, err := c.employeeInfoRepo.Get(ctx, tx.DB, *req.ManagerID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get manager")
+			}
+			if manager == nil {
+				return utils.NewHttpError(http.StatusBadRequest, "manager not found")
+			}
+		}
+
+		// Every line is locked, so two writes cannot close a cycle together
+		lines, err := c.reportingLineRepo.ListForUpdate(ctx, tx.DB)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list reporting lines")
+		}
+		if orgchart.HasCycle(lines, line) {
+			return utils.NewHttpError(http.StatusConflict, "reporting line would create a cycle")
+		}
+
+		existing, ok := lo.Find(lines, func(other *models.ReportingLine) bool {
+			return other.EmployeeID == employeeID && other.StartDate.Equal(startDate)
+		})
+		if !ok {
+			if err := c.reportingLineRepo.Create(ctx, tx.DB, line); err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to create reporting line")
+			}
+			return nil
+		}
+
+		existing.ManagerID = req.ManagerID
+		if err := c.reportingLineRepo.Save(ctx, tx.DB, existing); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save reporting line")
+		}
+		line = existing
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to set reporting line")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, orgchart.NewLineV1Response(line, utils.GetTimeFormatter(ctx)))
+}
+
+// ListReportsTo returns the reporting lines of the employee ordered by start
+// date, the future ones included.
+func (c *Controller) ListReportsTo(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	employeeInfo, err := c.employeeInfoRepo.Get(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee"})
+		return
+	}
+	if employeeInfo == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	lines, err := c.reportingLineRepo.ListByEmployeeID(ctx, c.db, employeeID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list reporting lines"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	formatter := utils.GetTimeFormatter(ctx)
+	ctx.JSON(http.StatusOK, dtos.ReportingLineListV1Response{
+		EmployeeID: employeeID,
+		Items: lo.Map(lines, func(line *models.ReportingLine, _ int) dtos.ReportingLineV1Response {
+			return orgchart.NewLineV1Response(line, formatter)
+		}),
+	})
+}
@@ -0,0 +1,190 @@
+package orgchart
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetReportsTo(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee reporting to a manager", t, func() {
+			employeeID := int64(3)
+			managerID := int64(2)
+			startDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			// 2 reports to 1 from January
+			lines := []*models.ReportingLine{
+				{ID: 1, EmployeeID: 2, ManagerID: lo.ToPtr(int64(1)), StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			}
+
+			req := ReportsToRequest{
+				ManagerID: lo.ToPtr(managerID),
+				StartDate: "2025-06-01",
+			}
+
+			Convey("When adding a line", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), managerID).
+					Return(&models.EmployeeInfo{ID: managerID}, nil)
+				s.reportingLineRepo.EXPECT().
+					ListForUpdate(gomock.Any(), gomock.Any()).
+					Return(lines, nil)
+				s.reportingLineRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, line *models.ReportingLine) error {
+						c.So(line.EmployeeID, ShouldEqual, employeeID)
+						c.So(*line.ManagerID, ShouldEqual, managerID)
+						c.So(line.StartDate, ShouldEqual, startDate)
+						line.ID = 5
+						return nil
+					})
+
+				var resp dtos.ReportingLineV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", req, &resp, http.StatusOK)
+
+				Convey("Then the line should be returned", func() {
+					So(resp.ReportingLineID, ShouldEqual, 5)
+					So(*resp.ManagerID, ShouldEqual, managerID)
+					So(resp.StartDate, ShouldEqual, "2025-06-01")
+				})
+			})
+
+			Convey("When a line already starts on the same day", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				existing := &models.ReportingLine{ID: 4, EmployeeID: employeeID, StartDate: startDate}
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), managerID).
+					Return(&models.EmployeeInfo{ID: managerID}, nil)
+				s.reportingLineRepo.EXPECT().
+					ListForUpdate(gomock.Any(), gomock.Any()).
+					Return(append(lines, existing), nil)
+				s.reportingLineRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), existing).
+					DoAndReturn(func(_ any, _ any, line *models.ReportingLine) error {
+						c.So(*line.ManagerID, ShouldEqual, managerID)
+						return nil
+					})
+
+				var resp dtos.ReportingLineV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", req, &resp, http.StatusOK)
+
+				Convey("Then the line should be replaced", func() {
+					So(resp.ReportingLineID, ShouldEqual, 4)
+				})
+			})
+
+			Convey("When the manager reports to the employee", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), managerID).
+					Return(&models.EmployeeInfo{ID: managerID}, nil)
+				// 2 moves under 3 in the summer, after the new line starts
+				s.reportingLineRepo.EXPECT().
+					ListForUpdate(gomock.Any(), gomock.Any()).
+					Return(append(lines, &models.ReportingLine{
+						ID: 6, EmployeeID: 2, ManagerID: lo.ToPtr(employeeID), StartDate: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+					}), nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", req, nil, http.StatusConflict)
+			})
+
+			Convey("When the employee reports to themselves", func() {
+				body := ReportsToRequest{ManagerID: lo.ToPtr(employeeID), StartDate: "2025-06-01"}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", body, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the start date is invalid", func() {
+				body := ReportsToRequest{ManagerID: lo.ToPtr(managerID), StartDate: "06/01/2025"}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", body, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the manager does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), managerID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", req, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPut, "/employee/3/reports-to", req, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func TestListReportsTo(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee with two lines", t, func() {
+			employeeID := int64(3)
+			lines := []*models.ReportingLine{
+				{ID: 1, EmployeeID: employeeID, ManagerID: lo.ToPtr(int64(1)), StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{ID: 2, EmployeeID: employeeID, StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+			}
+
+			Convey("When listing the lines", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(&models.EmployeeInfo{ID: employeeID}, nil)
+				s.reportingLineRepo.EXPECT().
+					ListByEmployeeID(gomock.Any(), gomock.Any(), employeeID).
+					Return(lines, nil)
+
+				var resp dtos.ReportingLineListV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/3/reports-to", nil, &resp, http.StatusOK)
+
+				Convey("Then the lines should be returned in order", func() {
+					So(resp.EmployeeID, ShouldEqual, employeeID)
+					So(resp.Items, ShouldHaveLength, 2)
+					So(*resp.Items[0].ManagerID, ShouldEqual, 1)
+					So(resp.Items[1].ManagerID, ShouldBeNil)
+					So(resp.Items[1].StartDate, ShouldEqual, "2025-06-01")
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), employeeID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/employee/3/reports-to", nil, nil, http.StatusNotFound)
+			})
+		})
+	})
+}
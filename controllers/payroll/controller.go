@@ -0,0 +1,53 @@
+package payroll
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+	// MaxPeriodDays bounds the length of the period of a run
+	MaxPeriodDays int `env:"PAYROLL_MAX_PERIOD_DAYS,default=31"`
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager            TxManager
+	timeModule           TimeModule
+	payrollRepo          PayrollRepo
+	employeeInfoRepo     EmployeeInfoRepo
+	employeePositionRepo EmployeePositionRepo
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	payrollRepo PayrollRepo,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+) *Controller {
+	return &Controller{
+		cfg:                  cfg,
+		db:                   db,
+		txManager:            txManager,
+		timeModule:           timeModule,
+		payrollRepo:          payrollRepo,
+		employeeInfoRepo:     employeeInfoRepo,
+		employeePositionRepo: employeePositionRepo,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// runs
+	r.POST("/payroll/runs", c.Create)
+	r.POST("/payroll/runs/preview", c.Preview)
+	r.GET("/payroll/runs/:id", c.Get)
+	r.POST("/payroll/runs/:id/finalize", c.Finalize)
+}
@@ -0,0 +1,127 @@
+package payroll
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/payroll"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type RunRequest struct {
+	PeriodStart string `json:"period_start" binding:"required"`
+	PeriodEnd   string `json:"period_end"   binding:"required"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Create computes the pay of the period and saves it as a draft run. The
+// lines of the run are never recomputed, a new run is created instead.
+func (c *Controller) Create(ctx *gin.Context) {
+	var req RunRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := c.parsePeriod(req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var run *models.PayrollRun
+	var lines []*models.PayrollLine
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		if err := c.checkNotFinalized(ctx, tx.DB, from, to); err != nil {
+			return err
+		}
+
+		lines, err = c.calculate(ctx, tx.DB, from, to)
+		if err != nil {
+			return err
+		}
+
+		run = payroll.NewRun(from, to, lines)
+		if err := c.payrollRepo.CreateRun(ctx, tx.DB, run); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create payroll run")
+		}
+		for _, line := range lines {
+			line.RunID = run.ID
+		}
+		if err := c.payrollRepo.CreateLines(ctx, tx.DB, lines); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create payroll lines")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create payroll run")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, payroll.NewRunV1Response(run, lines, utils.GetTimeFormatter(ctx)))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// parsePeriod returns the inclusive days of the period of the request.
+func (c *Controller) parsePeriod(req RunRequest) (time.Time, time.Time, error) {
+	from, err := time.Parse(payroll.DateLayout, req.PeriodStart)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid period_start")
+	}
+	to, err := time.Parse(payroll.DateLayout, req.PeriodEnd)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid period_end")
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("period_end must not be before period_start")
+	}
+	if payroll.Days(from, to) > c.cfg.MaxPeriodDays {
+		return time.Time{}, time.Time{}, fmt.Errorf("period spans more than %d days", c.cfg.MaxPeriodDays)
+	}
+	return from, to, nil
+}
+
+// checkNotFinalized rejects a period already paid by a finalized run.
+func (c *Controller) checkNotFinalized(ctx *gin.Context, tx *gorm.DB, from, to time.Time) error {
+	runs, err := c.payrollRepo.ListFinalizedOverlapping(ctx, tx, from, to)
+	if err != nil {
+		return utils.NewHttpError(http.StatusInternalServerError, "failed to list payroll runs")
+	}
+	if len(runs) > 0 {
+		return utils.NewHttpError(http.StatusConflict, "period overlaps a finalized payroll run")
+	}
+	return nil
+}
+
+// calculate returns the lines of the period for every employee holding a
+// position during it.
+func (c *Controller) calculate(ctx *gin.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollLine, error) {
+	positions, err := c.employeePositionRepo.ListEffectiveBetween(ctx, tx, from, to)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to list employee positions")
+	}
+
+	// Employees terminated during the period are paid up to their last day
+	employeeIDs := lo.Uniq(lo.Map(positions, func(position *models.EmployeePosition, _ int) int64 {
+		return position.EmployeeID
+	}))
+	employeeInfos, err := c.employeeInfoRepo.ListByIDsWithTerminated(ctx, tx, employeeIDs)
+	if err != nil {
+		return nil, utils.NewHttpError(http.StatusInternalServerError, "failed to list employees")
+	}
+
+	return payroll.Calculate(from, to, employeeInfos, positions), nil
+}
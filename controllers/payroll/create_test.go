@@ -0,0 +1,121 @@
+package payroll
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee promoted during June", t, func() {
+			from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+			employeeID := int64(123)
+
+			employeeInfo := &models.EmployeeInfo{ID: employeeID, Name: "John Doe"}
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, Position: "Engineer", Salary: money.New(300000, "USD"), StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{ID: 2, EmployeeID: employeeID, Position: "Senior Engineer", Salary: money.New(450000, "USD"), StartDate: time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC)},
+			}
+
+			req := RunRequest{
+				PeriodStart: "2025-06-01",
+				PeriodEnd:   "2025-06-30",
+			}
+
+			Convey("When creating a run of the month", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.payrollRepo.EXPECT().
+					ListFinalizedOverlapping(gomock.Any(), gomock.Any(), from, to).
+					Return(nil, nil)
+				s.employeePositionRepo.EXPECT().
+					ListEffectiveBetween(gomock.Any(), gomock.Any(), from, to).
+					Return(positions, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+				s.payrollRepo.EXPECT().
+					CreateRun(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, run *models.PayrollRun) error {
+						c.So(run.Status, ShouldEqual, models.PayrollRunStatusDraft)
+						run.ID = 7
+						run.CreatedAt = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+						return nil
+					})
+				s.payrollRepo.EXPECT().
+					CreateLines(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, lines []*models.PayrollLine) error {
+						c.So(lines, ShouldHaveLength, 2)
+						c.So(lines[0].RunID, ShouldEqual, 7)
+						return nil
+					})
+
+				var resp dtos.PayrollRunV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs", req, &resp, http.StatusCreated)
+
+				Convey("Then the salary should be prorated across the positions", func() {
+					So(resp.PayrollRunID, ShouldEqual, 7)
+					So(resp.Status, ShouldEqual, models.PayrollRunStatusDraft)
+					So(resp.EmployeeCount, ShouldEqual, 1)
+					So(resp.Totals, ShouldResemble, []money.Money{money.New(350000, "USD")})
+					So(resp.CreatedAt, ShouldEqual, "2025-07-01 09:00:00")
+					So(resp.Items, ShouldHaveLength, 1)
+					So(resp.Items[0].Lines, ShouldHaveLength, 2)
+					So(resp.Items[0].Lines[0].EndDate, ShouldEqual, "2025-06-20")
+					So(resp.Items[0].Lines[0].Amount, ShouldResemble, money.New(200000, "USD"))
+					So(resp.Items[0].Lines[1].Amount, ShouldResemble, money.New(150000, "USD"))
+				})
+			})
+
+			Convey("When the period is already paid", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.payrollRepo.EXPECT().
+					ListFinalizedOverlapping(gomock.Any(), gomock.Any(), from, to).
+					Return([]*models.PayrollRun{{ID: 1}}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "period overlaps a finalized payroll run")
+				})
+			})
+
+			Convey("When the period ends before it starts", func() {
+				badReq := RunRequest{PeriodStart: "2025-06-30", PeriodEnd: "2025-06-01"}
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs", badReq, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "period_end must not be before period_start")
+				})
+			})
+
+			Convey("When the period is too long", func() {
+				badReq := RunRequest{PeriodStart: "2025-06-01", PeriodEnd: "2025-07-31"}
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs", badReq, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "period spans more than 31 days")
+				})
+			})
+
+			Convey("When the period is not a date", func() {
+				badReq := RunRequest{PeriodStart: "June", PeriodEnd: "2025-06-30"}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
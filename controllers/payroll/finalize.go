@@ -0,0 +1,81 @@
+package payroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/payroll"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Finalize closes a draft run. A period is paid by a single finalized run.
+func (c *Controller) Finalize(ctx *gin.Context) {
+	runID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	var resp dtos.PayrollRunV1Response
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		run, err := c.payrollRepo.GetRun(ctx, tx.DB, runID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get payroll run")
+		}
+		if run == nil {
+			return utils.NewHttpError(http.StatusNotFound, "payroll run not found")
+		}
+
+		// Lock every run of the period, the run itself included, so two
+		// overlapping drafts are not finalized at once
+		runs, err := c.payrollRepo.ListOverlappingForUpdate(ctx, tx.DB, run.PeriodStart, run.PeriodEnd)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list payroll runs")
+		}
+		run, found := lo.Find(runs, func(overlapping *models.PayrollRun) bool {
+			return overlapping.ID == runID
+		})
+		if !found {
+			return utils.NewHttpError(http.StatusNotFound, "payroll run not found")
+		}
+		if run.Status != models.PayrollRunStatusDraft {
+			return utils.NewHttpError(http.StatusConflict, "payroll run already finalized")
+		}
+		if lo.SomeBy(runs, func(overlapping *models.PayrollRun) bool {
+			return overlapping.Status == models.PayrollRunStatusFinalized
+		}) {
+			return utils.NewHttpError(http.StatusConflict, "period overlaps a finalized payroll run")
+		}
+
+		nowTime := c.timeModule.Now()
+		run.Status = models.PayrollRunStatusFinalized
+		run.FinalizedAt = &nowTime
+		if err := c.payrollRepo.SaveRun(ctx, tx.DB, run); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to save payroll run")
+		}
+
+		lines, err := c.payrollRepo.ListLinesByRunID(ctx, tx.DB, run.ID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to list payroll lines")
+		}
+
+		resp = payroll.NewRunV1Response(run, lines, utils.GetTimeFormatter(ctx))
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to finalize payroll run")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,126 @@
+package payroll
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFinalize(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a draft run of June", t, func() {
+			runID := int64(7)
+			nowTime := time.Date(2025, 7, 2, 9, 0, 0, 0, time.UTC)
+
+			run := &models.PayrollRun{
+				ID:            runID,
+				PeriodStart:   time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+				PeriodEnd:     time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+				Status:        models.PayrollRunStatusDraft,
+				EmployeeCount: 1,
+			}
+			lines := []*models.PayrollLine{
+				{RunID: runID, EmployeeID: 123, EmployeeName: "John Doe", Pay: money.New(300000, "USD"), StartDate: run.PeriodStart, EndDate: run.PeriodEnd, Days: 30},
+			}
+
+			Convey("When finalizing the run", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				// An overlapping draft does not prevent the finalization
+				draft := *run
+				otherDraft := *run
+				otherDraft.ID = runID + 1
+				s.payrollRepo.EXPECT().
+					GetRun(gomock.Any(), gomock.Any(), runID).
+					Return(run, nil)
+				s.payrollRepo.EXPECT().
+					ListOverlappingForUpdate(gomock.Any(), gomock.Any(), run.PeriodStart, run.PeriodEnd).
+					Return([]*models.PayrollRun{&draft, &otherDraft}, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.payrollRepo.EXPECT().
+					SaveRun(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, saved *models.PayrollRun) error {
+						c.So(saved.Status, ShouldEqual, models.PayrollRunStatusFinalized)
+						c.So(*saved.FinalizedAt, ShouldEqual, nowTime)
+						return nil
+					})
+				s.payrollRepo.EXPECT().
+					ListLinesByRunID(gomock.Any(), gomock.Any(), runID).
+					Return(lines, nil)
+
+				var resp dtos.PayrollRunV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/7/finalize", nil, &resp, http.StatusOK)
+
+				Convey("Then the run should be finalized with its lines", func() {
+					So(resp.Status, ShouldEqual, models.PayrollRunStatusFinalized)
+					So(resp.FinalizedAt, ShouldEqual, "2025-07-02 09:00:00")
+					So(resp.Items, ShouldHaveLength, 1)
+					So(resp.Items[0].Totals, ShouldResemble, []money.Money{money.New(300000, "USD")})
+				})
+			})
+
+			Convey("When another run of the period is finalized", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				draft := *run
+				s.payrollRepo.EXPECT().
+					GetRun(gomock.Any(), gomock.Any(), runID).
+					Return(run, nil)
+				s.payrollRepo.EXPECT().
+					ListOverlappingForUpdate(gomock.Any(), gomock.Any(), run.PeriodStart, run.PeriodEnd).
+					Return([]*models.PayrollRun{{ID: runID - 1, Status: models.PayrollRunStatusFinalized}, &draft}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/7/finalize", nil, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "period overlaps a finalized payroll run")
+				})
+			})
+
+			Convey("When the run was finalized while waiting for its lock", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				finalized := *run
+				finalized.Status = models.PayrollRunStatusFinalized
+				s.payrollRepo.EXPECT().
+					GetRun(gomock.Any(), gomock.Any(), runID).
+					Return(run, nil)
+				s.payrollRepo.EXPECT().
+					ListOverlappingForUpdate(gomock.Any(), gomock.Any(), run.PeriodStart, run.PeriodEnd).
+					Return([]*models.PayrollRun{&finalized}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/7/finalize", nil, &errorResponse, http.StatusConflict)
+
+				Convey("Then the request should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "payroll run already finalized")
+				})
+			})
+
+			Convey("When the run does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.payrollRepo.EXPECT().
+					GetRun(gomock.Any(), gomock.Any(), runID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/7/finalize", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/abc/finalize", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,42 @@
+package payroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/payroll"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Get(ctx *gin.Context) {
+	runID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	run, err := c.payrollRepo.GetRun(ctx, c.db, runID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get payroll run"})
+		return
+	}
+	if run == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "payroll run not found"})
+		return
+	}
+
+	lines, err := c.payrollRepo.ListLinesByRunID(ctx, c.db, run.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list payroll lines"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, payroll.NewRunV1Response(run, lines, utils.GetTimeFormatter(ctx)))
+}
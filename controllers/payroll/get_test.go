@@ -0,0 +1,72 @@
+package payroll
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a finalized run with two employees", t, func() {
+			runID := int64(7)
+			finalizedAt := time.Date(2025, 7, 2, 9, 0, 0, 0, time.UTC)
+
+			run := &models.PayrollRun{
+				ID:            runID,
+				PeriodStart:   time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+				PeriodEnd:     time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+				Status:        models.PayrollRunStatusFinalized,
+				EmployeeCount: 2,
+				FinalizedAt:   &finalizedAt,
+				CreatedAt:     time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC),
+			}
+			lines := []*models.PayrollLine{
+				{RunID: runID, EmployeeID: 1, PositionID: 10, Pay: money.New(200000, "USD")},
+				{RunID: runID, EmployeeID: 1, PositionID: 11, Pay: money.New(150000, "USD")},
+				{RunID: runID, EmployeeID: 2, PositionID: 20, Pay: money.New(300000, "USD")},
+			}
+
+			Convey("When fetching the run", func() {
+				s.payrollRepo.EXPECT().
+					GetRun(gomock.Any(), gomock.Any(), runID).
+					Return(run, nil)
+				s.payrollRepo.EXPECT().
+					ListLinesByRunID(gomock.Any(), gomock.Any(), runID).
+					Return(lines, nil)
+
+				var resp dtos.PayrollRunV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/payroll/runs/7", nil, &resp, http.StatusOK)
+
+				Convey("Then the lines should be grouped by employee", func() {
+					So(resp.PayrollRunID, ShouldEqual, runID)
+					So(resp.PeriodStart, ShouldEqual, "2025-06-01")
+					So(resp.PeriodEnd, ShouldEqual, "2025-06-30")
+					So(resp.FinalizedAt, ShouldEqual, "2025-07-02 09:00:00")
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.Items[0].Totals, ShouldResemble, []money.Money{money.New(350000, "USD")})
+					So(resp.Items[0].Lines, ShouldHaveLength, 2)
+					So(resp.Items[1].Totals, ShouldResemble, []money.Money{money.New(300000, "USD")})
+				})
+			})
+
+			Convey("When the run does not exist", func() {
+				s.payrollRepo.EXPECT().
+					GetRun(gomock.Any(), gomock.Any(), runID).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/payroll/runs/7", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/payroll/runs/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,38 @@
+package payroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=payroll
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type PayrollRepo interface {
+	CreateRun(ctx context.Context, tx *gorm.DB, data *models.PayrollRun) error
+	GetRun(ctx context.Context, tx *gorm.DB, id int64) (*models.PayrollRun, error)
+	SaveRun(ctx context.Context, tx *gorm.DB, data *models.PayrollRun) error
+	ListFinalizedOverlapping(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollRun, error)
+	ListOverlappingForUpdate(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollRun, error)
+
+	CreateLines(ctx context.Context, tx *gorm.DB, data []*models.PayrollLine) error
+	ListLinesByRunID(ctx context.Context, tx *gorm.DB, runID int64) ([]*models.PayrollLine, error)
+}
+
+type EmployeeInfoRepo interface {
+	ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	ListEffectiveBetween(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.EmployeePosition, error)
+}
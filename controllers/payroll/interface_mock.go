@@ -0,0 +1,301 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=payroll
+//
+
+// Package payroll is a generated GoMock package.
+package payroll
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockPayrollRepo is a mock of PayrollRepo interface.
+type MockPayrollRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayrollRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockPayrollRepoMockRecorder is the mock recorder for MockPayrollRepo.
+type MockPayrollRepoMockRecorder struct {
+	mock *MockPayrollRepo
+}
+
+// NewMockPayrollRepo creates a new mock instance.
+func NewMockPayrollRepo(ctrl *gomock.Controller) *MockPayrollRepo {
+	mock := &MockPayrollRepo{ctrl: ctrl}
+	mock.recorder = &MockPayrollRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayrollRepo) EXPECT() *MockPayrollRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateLines mocks base method.
+func (m *MockPayrollRepo) CreateLines(ctx context.Context, tx *gorm.DB, data []*models.PayrollLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLines", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLines indicates an expected call of CreateLines.
+func (mr *MockPayrollRepoMockRecorder) CreateLines(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLines", reflect.TypeOf((*MockPayrollRepo)(nil).CreateLines), ctx, tx, data)
+}
+
+// CreateRun mocks base method.
+func (m *MockPayrollRepo) CreateRun(ctx context.Context, tx *gorm.DB, data *models.PayrollRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockPayrollRepoMockRecorder) CreateRun(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockPayrollRepo)(nil).CreateRun), ctx, tx, data)
+}
+
+// GetRun mocks base method.
+func (m *MockPayrollRepo) GetRun(ctx context.Context, tx *gorm.DB, id int64) (*models.PayrollRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRun", ctx, tx, id)
+	ret0, _ := ret[0].(*models.PayrollRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRun indicates an expected call of GetRun.
+func (mr *MockPayrollRepoMockRecorder) GetRun(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRun", reflect.TypeOf((*MockPayrollRepo)(nil).GetRun), ctx, tx, id)
+}
+
+// ListFinalizedOverlapping mocks base method.
+func (m *MockPayrollRepo) ListFinalizedOverlapping(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFinalizedOverlapping", ctx, tx, from, to)
+	ret0, _ := ret[0].([]*models.PayrollRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFinalizedOverlapping indicates an expected call of ListFinalizedOverlapping.
+func (mr *MockPayrollRepoMockRecorder) ListFinalizedOverlapping(ctx, tx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFinalizedOverlapping", reflect.TypeOf((*MockPayrollRepo)(nil).ListFinalizedOverlapping), ctx, tx, from, to)
+}
+
+// ListLinesByRunID mocks base method.
+func (m *MockPayrollRepo) ListLinesByRunID(ctx context.Context, tx *gorm.DB, runID int64) ([]*models.PayrollLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinesByRunID", ctx, tx, runID)
+	ret0, _ := ret[0].([]*models.PayrollLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinesByRunID indicates an expected call of ListLinesByRunID.
+func (mr *MockPayrollRepoMockRecorder) ListLinesByRunID(ctx, tx, runID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinesByRunID", reflect.TypeOf((*MockPayrollRepo)(nil).ListLinesByRunID), ctx, tx, runID)
+}
+
+// ListOverlappingForUpdate mocks base method.
+func (m *MockPayrollRepo) ListOverlappingForUpdate(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverlappingForUpdate", ctx, tx, from, to)
+	ret0, _ := ret[0].([]*models.PayrollRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverlappingForUpdate indicates an expected call of ListOverlappingForUpdate.
+func (mr *MockPayrollRepoMockRecorder) ListOverlappingForUpdate(ctx, tx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverlappingForUpdate", reflect.TypeOf((*MockPayrollRepo)(nil).ListOverlappingForUpdate), ctx, tx, from, to)
+}
+
+// SaveRun mocks base method.
+func (m *MockPayrollRepo) SaveRun(ctx context.Context, tx *gorm.DB, data *models.PayrollRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRun", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRun indicates an expected call of SaveRun.
+func (mr *MockPayrollRepoMockRecorder) SaveRun(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRun", reflect.TypeOf((*MockPayrollRepo)(nil).SaveRun), ctx, tx, data)
+}
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByIDsWithTerminated mocks base method.
+func (m *MockEmployeeInfoRepo) ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDsWithTerminated", ctx, tx, ids)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDsWithTerminated indicates an expected call of ListByIDsWithTerminated.
+func (mr *MockEmployeeInfoRepoMockRecorder) ListByIDsWithTerminated(ctx, tx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDsWithTerminated", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).ListByIDsWithTerminated), ctx, tx, ids)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListEffectiveBetween mocks base method.
+func (m *MockEmployeePositionRepo) ListEffectiveBetween(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffectiveBetween", ctx, tx, from, to)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffectiveBetween indicates an expected call of ListEffectiveBetween.
+func (mr *MockEmployeePositionRepoMockRecorder) ListEffectiveBetween(ctx, tx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveBetween", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListEffectiveBetween), ctx, tx, from, to)
+}
@@ -0,0 +1,40 @@
+package payroll
+
+import (
+	"net/http"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/payroll"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Preview computes the pay of the period like Create, without saving it.
+func (c *Controller) Preview(ctx *gin.Context) {
+	var req RunRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := c.parsePeriod(req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	lines, err := c.calculate(ctx, c.db, from, to)
+	if err != nil {
+		utils.RespondError(ctx, err, "failed to preview payroll run")
+		return
+	}
+	run := payroll.NewRun(from, to, lines)
+	run.Status = payroll.StatusPreview
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, payroll.NewRunV1Response(run, lines, utils.GetTimeFormatter(ctx)))
+}
@@ -0,0 +1,64 @@
+package payroll
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/payroll"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPreview(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee terminated in mid June", t, func() {
+			from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+			employeeID := int64(123)
+			terminatedAt := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+			employeeInfo := &models.EmployeeInfo{ID: employeeID, Name: "John Doe", TerminatedAt: &terminatedAt}
+			positions := []*models.EmployeePosition{
+				{ID: 1, EmployeeID: employeeID, Position: "Engineer", Salary: money.New(300000, "USD"), StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			}
+
+			req := RunRequest{
+				PeriodStart: "2025-06-01",
+				PeriodEnd:   "2025-06-30",
+			}
+
+			Convey("When previewing a run of the month", func() {
+				s.employeePositionRepo.EXPECT().
+					ListEffectiveBetween(gomock.Any(), gomock.Any(), from, to).
+					Return(positions, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+
+				var resp dtos.PayrollRunV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/preview", req, &resp, http.StatusOK)
+
+				Convey("Then the run should be computed but not saved", func() {
+					So(resp.PayrollRunID, ShouldEqual, 0)
+					So(resp.Status, ShouldEqual, payroll.StatusPreview)
+					So(resp.CreatedAt, ShouldBeEmpty)
+					So(resp.Totals, ShouldResemble, []money.Money{money.New(150000, "USD")})
+					So(resp.Items[0].Lines[0].EndDate, ShouldEqual, "2025-06-15")
+				})
+			})
+
+			Convey("When the positions cannot be listed", func() {
+				s.employeePositionRepo.EXPECT().
+					ListEffectiveBetween(gomock.Any(), gomock.Any(), from, to).
+					Return(nil, errors.New("database error"))
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/payroll/runs/preview", req, nil, http.StatusInternalServerError)
+			})
+		})
+	})
+}
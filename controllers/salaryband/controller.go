@@ -0,0 +1,44 @@
+package salaryband
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+	// DefaultCurrency is the currency of the amounts sent without one
+	DefaultCurrency string `env:"DEFAULT_CURRENCY,default=USD"`
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager      TxManager
+	salaryBandRepo SalaryBandRepo
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	salaryBandRepo SalaryBandRepo,
+) *Controller {
+	return &Controller{
+		cfg:            cfg,
+		db:             db,
+		txManager:      txManager,
+		salaryBandRepo: salaryBandRepo,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// salary bands
+	r.POST("/salary/bands", c.Create)
+	r.GET("/salary/bands", c.List)
+	r.GET("/salary/bands/:id", c.Get)
+	r.DELETE("/salary/bands/:id", c.Delete)
+}
@@ -0,0 +1,104 @@
+package salaryband
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CreateRequest struct {
+	Position string `json:"position" binding:"required,max=100"`
+	// Min, Mid and Max are monthly and share a currency, a bare number is in
+	// the default currency
+	Min money.Money `json:"min"`
+	Mid money.Money `json:"mid"`
+	Max money.Money `json:"max"`
+	// EffectiveDate is formatted as YYYY-MM-DD
+	EffectiveDate string `json:"effective_date" binding:"required"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Create adds a band to a position. Bands are never edited: a new band with
+// a later effective date supersedes the previous one.
+func (c *Controller) Create(ctx *gin.Context) {
+	var req CreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	band, err := c.newBand(req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		existing, err := c.salaryBandRepo.GetByKey(ctx, tx.DB, band.Position, band.Currency, band.EffectiveDate)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get salary band")
+		}
+		if existing != nil {
+			return utils.NewHttpError(http.StatusConflict, "salary band already exists")
+		}
+
+		if err := c.salaryBandRepo.Create(ctx, tx.DB, band); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create salary band")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create salary band")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, salaryband.NewV1Response(band, utils.GetTimeFormatter(ctx)))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) newBand(req CreateRequest) (*models.SalaryBand, error) {
+	effectiveDate, err := time.Parse(salaryband.DateLayout, req.EffectiveDate)
+	if err != nil {
+		return nil, errors.New("effective_date must be formatted as YYYY-MM-DD")
+	}
+
+	var amounts [3]money.Money
+	for i, amount := range []money.Money{req.Min, req.Mid, req.Max} {
+		amounts[i], err = amount.WithDefaultCurrency(c.cfg.DefaultCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount: %w", err)
+		}
+	}
+	low, mid, high := amounts[0], amounts[1], amounts[2]
+	if low.Currency != mid.Currency || mid.Currency != high.Currency {
+		return nil, errors.New("min, mid and max must share a currency")
+	}
+
+	band := &models.SalaryBand{
+		Position:      strings.TrimSpace(req.Position),
+		Currency:      low.Currency,
+		EffectiveDate: effectiveDate,
+		Min:           low.Amount,
+		Mid:           mid.Amount,
+		Max:           high.Amount,
+	}
+	if err := salaryband.Validate(band); err != nil {
+		return nil, err
+	}
+	return band, nil
+}
@@ -0,0 +1,127 @@
+package salaryband
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a new salary band", t, func() {
+			effectiveDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			req := CreateRequest{
+				Position:      " Developer ",
+				Min:           money.New(300000, "EUR"),
+				Mid:           money.New(400000, "EUR"),
+				Max:           money.New(500000, "EUR"),
+				EffectiveDate: "2025-01-01",
+			}
+
+			Convey("When creating it", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.salaryBandRepo.EXPECT().
+					GetByKey(gomock.Any(), gomock.Any(), "Developer", "EUR", effectiveDate).
+					Return(nil, nil)
+				s.salaryBandRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, band *models.SalaryBand) error {
+						c.So(band.Min, ShouldEqual, 300000)
+						c.So(band.Max, ShouldEqual, 500000)
+						band.ID = 3
+						return nil
+					})
+
+				var resp dtos.SalaryBandV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/salary/bands", req, &resp, http.StatusCreated)
+
+				Convey("Then the band should be returned", func() {
+					So(resp.SalaryBandID, ShouldEqual, 3)
+					So(resp.Position, ShouldEqual, "Developer")
+					So(resp.Mid, ShouldResemble, money.New(400000, "EUR"))
+					So(resp.EffectiveDate, ShouldEqual, "2025-01-01")
+				})
+			})
+
+			Convey("When the amounts are bare numbers", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.salaryBandRepo.EXPECT().
+					GetByKey(gomock.Any(), gomock.Any(), "Developer", "USD", effectiveDate).
+					Return(nil, nil)
+				s.salaryBandRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				legacyReq := map[string]any{
+					"position":       "Developer",
+					"min":            3000,
+					"mid":            4000,
+					"max":            5000.5,
+					"effective_date": "2025-01-01",
+				}
+				var resp dtos.SalaryBandV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/salary/bands", legacyReq, &resp, http.StatusCreated)
+
+				Convey("Then the band should be in the default currency", func() {
+					So(resp.Max, ShouldResemble, money.New(500050, "USD"))
+				})
+			})
+
+			Convey("When the band already exists", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.salaryBandRepo.EXPECT().
+					GetByKey(gomock.Any(), gomock.Any(), "Developer", "EUR", effectiveDate).
+					Return(&models.SalaryBand{ID: 1}, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/salary/bands", req, &errorResponse, http.StatusConflict)
+
+				Convey("Then the band should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "salary band already exists")
+				})
+			})
+
+			Convey("When the amounts are not in ascending order", func() {
+				badReq := req
+				badReq.Mid = money.New(600000, "EUR")
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/salary/bands", badReq, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the band should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "min, mid and max must be in ascending order")
+				})
+			})
+
+			Convey("When the amounts mix currencies", func() {
+				badReq := req
+				badReq.Max = money.New(500000, "USD")
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/salary/bands", badReq, &errorResponse, http.StatusBadRequest)
+
+				Convey("Then the band should be rejected", func() {
+					So(errorResponse["error"], ShouldEqual, "min, mid and max must share a currency")
+				})
+			})
+
+			Convey("When the effective date is malformed", func() {
+				badReq := req
+				badReq.EffectiveDate = "01/01/2025"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/salary/bands", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,44 @@
+package salaryband
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type DeleteResponse struct {
+	SalaryBandID int64 `json:"salary_band_id"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Delete removes a band, the previous band of the position and currency is
+// in effect again.
+func (c *Controller) Delete(ctx *gin.Context) {
+	bandID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	deleted, err := c.salaryBandRepo.Delete(ctx, c.db, bandID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete salary band"})
+		return
+	}
+	if !deleted {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "salary band not found"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, DeleteResponse{
+		SalaryBandID: bandID,
+	})
+}
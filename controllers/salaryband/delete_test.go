@@ -0,0 +1,41 @@
+package salaryband
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDelete(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a salary band", t, func() {
+			Convey("When deleting it", func() {
+				s.salaryBandRepo.EXPECT().
+					Delete(gomock.Any(), s.db, int64(1)).
+					Return(true, nil)
+
+				var resp DeleteResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/salary/bands/1", nil, &resp, http.StatusOK)
+
+				Convey("Then its ID should be returned", func() {
+					So(resp.SalaryBandID, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When the band does not exist", func() {
+				s.salaryBandRepo.EXPECT().
+					Delete(gomock.Any(), s.db, int64(2)).
+					Return(false, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodDelete, "/salary/bands/2", nil, &errorResponse, http.StatusNotFound)
+
+				Convey("Then the response should indicate not found", func() {
+					So(errorResponse["error"], ShouldEqual, "salary band not found")
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,36 @@
+package salaryband
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Get(ctx *gin.Context) {
+	bandID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	band, err := c.salaryBandRepo.Get(ctx, c.db, bandID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get salary band"})
+		return
+	}
+	if band == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "salary band not found"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, salaryband.NewV1Response(band, utils.GetTimeFormatter(ctx)))
+}
@@ -0,0 +1,53 @@
+package salaryband
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a salary band", t, func() {
+			band := models.DummySalaryBand(s.faker)
+			band.ID = 1
+
+			Convey("When getting it", func() {
+				s.salaryBandRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(1)).
+					Return(band, nil)
+
+				var resp dtos.SalaryBandV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/salary/bands/1", nil, &resp, http.StatusOK)
+
+				Convey("Then the band should be returned", func() {
+					So(resp.SalaryBandID, ShouldEqual, 1)
+					So(resp.Position, ShouldEqual, band.Position)
+					So(resp.Min.Currency, ShouldEqual, "USD")
+					So(resp.EffectiveDate, ShouldEqual, "2025-01-01")
+				})
+			})
+
+			Convey("When the band does not exist", func() {
+				s.salaryBandRepo.EXPECT().
+					Get(gomock.Any(), s.db, int64(2)).
+					Return(nil, nil)
+
+				var errorResponse map[string]string
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/salary/bands/2", nil, &errorResponse, http.StatusNotFound)
+
+				Convey("Then the response should indicate not found", func() {
+					So(errorResponse["error"], ShouldEqual, "salary band not found")
+				})
+			})
+
+			Convey("When the ID is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/salary/bands/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
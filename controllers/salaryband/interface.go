@@ -0,0 +1,23 @@
+package salaryband
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=salaryband
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type SalaryBandRepo interface {
+	Create(ctx context.Context, tx *gorm.DB, data *models.SalaryBand) error
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.SalaryBand, error)
+	GetByKey(ctx context.Context, tx *gorm.DB, position string, currency string, effectiveDate time.Time) (*models.SalaryBand, error)
+	List(ctx context.Context, tx *gorm.DB, position string) ([]*models.SalaryBand, error)
+	Delete(ctx context.Context, tx *gorm.DB, id int64) (bool, error)
+}
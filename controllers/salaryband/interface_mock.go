@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=salaryband
+//
+
+// Package salaryband is a generated GoMock package.
+package salaryband
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockSalaryBandRepo is a mock of SalaryBandRepo interface.
+type MockSalaryBandRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSalaryBandRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSalaryBandRepoMockRecorder is the mock recorder for MockSalaryBandRepo.
+type MockSalaryBandRepoMockRecorder struct {
+	mock *MockSalaryBandRepo
+}
+
+// NewMockSalaryBandRepo creates a new mock instance.
+func NewMockSalaryBandRepo(ctrl *gomock.Controller) *MockSalaryBandRepo {
+	mock := &MockSalaryBandRepo{ctrl: ctrl}
+	mock.recorder = &MockSalaryBandRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSalaryBandRepo) EXPECT() *MockSalaryBandRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSalaryBandRepo) Create(ctx context.Context, tx *gorm.DB, data *models.SalaryBand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSalaryBandRepoMockRecorder) Create(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSalaryBandRepo)(nil).Create), ctx, tx, data)
+}
+
+// Delete mocks base method.
+func (m *MockSalaryBandRepo) Delete(ctx context.Context, tx *gorm.DB, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSalaryBandRepoMockRecorder) Delete(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSalaryBandRepo)(nil).Delete), ctx, tx, id)
+}
+
+// Get mocks base method.
+func (m *MockSalaryBandRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.SalaryBand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.SalaryBand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSalaryBandRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSalaryBandRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetByKey mocks base method.
+func (m *MockSalaryBandRepo) GetByKey(ctx context.Context, tx *gorm.DB, position, currency string, effectiveDate time.Time) (*models.SalaryBand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, tx, position, currency, effectiveDate)
+	ret0, _ := ret[0].(*models.SalaryBand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockSalaryBandRepoMockRecorder) GetByKey(ctx, tx, position, currency, effectiveDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockSalaryBandRepo)(nil).GetByKey), ctx, tx, position, currency, effectiveDate)
+}
+
+// List mocks base method.
+func (m *MockSalaryBandRepo) List(ctx context.Context, tx *gorm.DB, position string) ([]*models.SalaryBand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx, position)
+	ret0, _ := ret[0].([]*models.SalaryBand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSalaryBandRepoMockRecorder) List(ctx, tx, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSalaryBandRepo)(nil).List), ctx, tx, position)
+}
@@ -0,0 +1,40 @@
+package salaryband
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/salaryband"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListResponse struct {
+	Items []dtos.SalaryBandV1Response `json:"items"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// List returns every band, past and future ones included, or the bands of
+// the position given in the query.
+func (c *Controller) List(ctx *gin.Context) {
+	position := strings.TrimSpace(ctx.Query("position"))
+
+	bands, err := c.salaryBandRepo.List(ctx, c.db, position)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list salary bands"})
+		return
+	}
+
+	formatter := utils.GetTimeFormatter(ctx)
+	ctx.JSON(http.StatusOK, ListResponse{
+		Items: lo.Map(bands, func(band *models.SalaryBand, _ int) dtos.SalaryBandV1Response {
+			return salaryband.NewV1Response(band, formatter)
+		}),
+	})
+}
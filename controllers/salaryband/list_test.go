@@ -0,0 +1,50 @@
+package salaryband
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given salary bands", t, func() {
+			bands := []*models.SalaryBand{
+				models.DummySalaryBand(s.faker),
+				models.DummySalaryBand(s.faker),
+			}
+			bands[0].ID, bands[1].ID = 1, 2
+
+			Convey("When listing the bands of a position", func() {
+				s.salaryBandRepo.EXPECT().
+					List(gomock.Any(), s.db, "Developer").
+					Return(bands, nil)
+
+				var resp ListResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/salary/bands?position=Developer", nil, &resp, http.StatusOK)
+
+				Convey("Then the bands should be returned", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.Items[0].SalaryBandID, ShouldEqual, 1)
+					So(resp.Items[1].Mid.Amount, ShouldEqual, bands[1].Mid)
+				})
+			})
+
+			Convey("When listing every band", func() {
+				s.salaryBandRepo.EXPECT().
+					List(gomock.Any(), s.db, "").
+					Return(nil, nil)
+
+				var resp ListResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/salary/bands", nil, &resp, http.StatusOK)
+
+				Convey("Then an empty list should be returned", func() {
+					So(resp.Items, ShouldBeEmpty)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,153 @@
+package shift
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/schedule"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const dateLayout = "2006-01-02"
+
+type AssignRequest struct {
+	// Exactly one of EmployeeID and DepartmentID is set
+	EmployeeID    *int64 `json:"employee_id"    binding:"omitempty,gt=0"`
+	DepartmentID  *int64 `json:"department_id"  binding:"omitempty,gt=0"`
+	EffectiveFrom string `json:"effective_from" binding:"required"`
+	// EffectiveTo is the inclusive last day, omitted for an open-ended
+	// assignment
+	EffectiveTo string `json:"effective_to"`
+}
+
+type ListAssignmentsResponse struct {
+	Items []dtos.ShiftAssignmentV1Response `json:"items"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Assign assigns the shift to an employee or a department.
+func (c *Controller) Assign(ctx *gin.Context) {
+	shiftID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req AssignRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if (req.EmployeeID == nil) == (req.DepartmentID == nil) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "exactly one of employee_id and department_id is required"})
+		return
+	}
+
+	assignment := &models.ShiftAssignment{
+		ShiftID:      shiftID,
+		EmployeeID:   req.EmployeeID,
+		DepartmentID: req.DepartmentID,
+	}
+	assignment.EffectiveFrom, err = time.ParseInLocation(dateLayout, req.EffectiveFrom, time.UTC)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid effective_from, expected " + dateLayout})
+		return
+	}
+	if req.EffectiveTo != "" {
+		effectiveTo, err := time.ParseInLocation(dateLayout, req.EffectiveTo, time.UTC)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid effective_to, expected " + dateLayout})
+			return
+		}
+		if effectiveTo.Before(assignment.EffectiveFrom) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "effective_to must not be before effective_from"})
+			return
+		}
+		assignment.EffectiveTo = &effectiveTo
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		shift, err := c.shiftRepo.Get(ctx, tx.DB, shiftID)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get shift")
+		}
+		if shift == nil {
+			return utils.NewHttpError(http.StatusNotFound, "shift not found")
+		}
+
+		if req.EmployeeID != nil {
+			employeeInfo, err := c.employeeInfoRepo.Get(ctx, tx.DB, *req.EmployeeID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get employee")
+			}
+			if employeeInfo == nil {
+				return utils.NewHttpError(http.StatusBadRequest, "employee not found")
+			}
+		}
+		if req.DepartmentID != nil {
+			department, err := c.departmentRepo.Get(ctx, tx.DB, *req.DepartmentID)
+			if err != nil {
+				return utils.NewHttpError(http.StatusInternalServerError, "failed to get department")
+			}
+			if department == nil {
+				return utils.NewHttpError(http.StatusBadRequest, "department not found")
+			}
+		}
+
+		if err := c.shiftRepo.CreateAssignment(ctx, tx.DB, assignment); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create shift assignment")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create shift assignment")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, schedule.NewAssignmentV1Response(assignment))
+}
+
+// ListAssignments lists the assignments of the shift by effective date.
+func (c *Controller) ListAssignments(ctx *gin.Context) {
+	shiftID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	shift, err := c.shiftRepo.Get(ctx, c.db, shiftID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get shift"})
+		return
+	}
+	if shift == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "shift not found"})
+		return
+	}
+
+	assignments, err := c.shiftRepo.ListAssignmentsByShiftID(ctx, c.db, shiftID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list shift assignments"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ListAssignmentsResponse{
+		Items: lo.Map(assignments, func(assignment *models.ShiftAssignment, _ int) dtos.ShiftAssignmentV1Response {
+			return schedule.NewAssignmentV1Response(assignment)
+		}),
+	})
+}
@@ -0,0 +1,104 @@
+package shift
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAssign(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a shift", t, func() {
+			shift := models.DummyShift(s.faker)
+			shift.ID = 1
+			departmentID := int64(10)
+
+			req := AssignRequest{
+				DepartmentID:  lo.ToPtr(departmentID),
+				EffectiveFrom: "2025-05-01",
+				EffectiveTo:   "2025-05-31",
+			}
+
+			Convey("When assigning it to a department", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.shiftRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(shift, nil)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), departmentID).
+					Return(&models.Department{ID: departmentID}, nil)
+				s.shiftRepo.EXPECT().
+					CreateAssignment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, assignment *models.ShiftAssignment) error {
+						c.So(assignment.EmployeeID, ShouldBeNil)
+						c.So(assignment.EffectiveFrom, ShouldEqual, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC))
+						assignment.ID = 3
+						return nil
+					})
+
+				var resp dtos.ShiftAssignmentV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift/1/assignments", req, &resp, http.StatusCreated)
+
+				Convey("Then the assignment should be returned", func() {
+					So(resp.AssignmentID, ShouldEqual, 3)
+					So(*resp.DepartmentID, ShouldEqual, departmentID)
+					So(resp.EffectiveTo, ShouldEqual, "2025-05-31")
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.shiftRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(shift, nil)
+				s.employeeInfoRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(123)).
+					Return(nil, nil)
+
+				employeeReq := AssignRequest{EmployeeID: lo.ToPtr(int64(123)), EffectiveFrom: "2025-05-01"}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift/1/assignments", employeeReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When both an employee and a department are given", func() {
+				badReq := req
+				badReq.EmployeeID = lo.ToPtr(int64(123))
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift/1/assignments", badReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the range ends before it starts", func() {
+				badReq := req
+				badReq.EffectiveTo = "2025-04-30"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift/1/assignments", badReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When listing the assignments", func() {
+				s.shiftRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(shift, nil)
+				s.shiftRepo.EXPECT().
+					ListAssignmentsByShiftID(gomock.Any(), gomock.Any(), int64(1)).
+					Return([]*models.ShiftAssignment{
+						{ID: 3, ShiftID: 1, DepartmentID: lo.ToPtr(departmentID), EffectiveFrom: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)},
+					}, nil)
+
+				var resp ListAssignmentsResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/1/assignments", nil, &resp, http.StatusOK)
+
+				Convey("Then an open-ended assignment should have no end", func() {
+					So(resp.Items, ShouldHaveLength, 1)
+					So(resp.Items[0].EffectiveTo, ShouldBeEmpty)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,72 @@
+package shift
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Config struct {
+}
+
+type Controller struct {
+	cfg Config
+	db  *gorm.DB
+
+	txManager              TxManager
+	timeModule             TimeModule
+	shiftRepo              ShiftRepo
+	departmentRepo         DepartmentRepo
+	employeeInfoRepo       EmployeeInfoRepo
+	employeePositionRepo   EmployeePositionRepo
+	employeeAttendanceRepo EmployeeAttendanceRepo
+	leaveRepo              LeaveRepo
+	holidayRepo            HolidayRepo
+}
+
+func NewController(
+	cfg Config,
+	db *gorm.DB,
+	txManager TxManager,
+	timeModule TimeModule,
+	shiftRepo ShiftRepo,
+	departmentRepo DepartmentRepo,
+	employeeInfoRepo EmployeeInfoRepo,
+	employeePositionRepo EmployeePositionRepo,
+	employeeAttendanceRepo EmployeeAttendanceRepo,
+	leaveRepo LeaveRepo,
+	holidayRepo HolidayRepo,
+) *Controller {
+	return &Controller{
+		cfg:                    cfg,
+		db:                     db,
+		txManager:              txManager,
+		timeModule:             timeModule,
+		shiftRepo:              shiftRepo,
+		departmentRepo:         departmentRepo,
+		employeeInfoRepo:       employeeInfoRepo,
+		employeePositionRepo:   employeePositionRepo,
+		employeeAttendanceRepo: employeeAttendanceRepo,
+		leaveRepo:              leaveRepo,
+		holidayRepo:            holidayRepo,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r *gin.Engine) {
+	////////////////////////////////////////////////////////////////////////////
+	// shift templates
+	r.POST("/shift", c.Create)
+	r.GET("/shift", c.List)
+	r.GET("/shift/:id", c.Get)
+
+	////////////////////////////////////////////////////////////////////////////
+	// shift assignments
+	r.POST("/shift/:id/assignments", c.Assign)
+	r.GET("/shift/:id/assignments", c.ListAssignments)
+
+	////////////////////////////////////////////////////////////////////////////
+	// late arrivals, early departures and absences
+	r.GET("/shift/report/employee/:id", c.EmployeeReport)
+	r.GET("/shift/report/department/:id", c.DepartmentReport)
+}
@@ -0,0 +1,86 @@
+package shift
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/sethvargo/go-envconfig"
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type testSuite struct {
+	db     *gorm.DB
+	mockDB sqlmock.Sqlmock
+
+	timeModule             *MockTimeModule
+	shiftRepo              *MockShiftRepo
+	departmentRepo         *MockDepartmentRepo
+	employeeInfoRepo       *MockEmployeeInfoRepo
+	employeePositionRepo   *MockEmployeePositionRepo
+	employeeAttendanceRepo *MockEmployeeAttendanceRepo
+	leaveRepo              *MockLeaveRepo
+	holidayRepo            *MockHolidayRepo
+
+	controller *Controller
+	testServer testutils.TestHttpServer
+	faker      *gofakeit.Faker
+}
+
+func testInit(t *testing.T, test func(*testSuite)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gormDB, mockDB := testutils.GetMockDB(t)
+
+	timeModule := NewMockTimeModule(ctrl)
+	shiftRepo := NewMockShiftRepo(ctrl)
+	departmentRepo := NewMockDepartmentRepo(ctrl)
+	employeeInfoRepo := NewMockEmployeeInfoRepo(ctrl)
+	employeePositionRepo := NewMockEmployeePositionRepo(ctrl)
+	employeeAttendanceRepo := NewMockEmployeeAttendanceRepo(ctrl)
+	leaveRepo := NewMockLeaveRepo(ctrl)
+	holidayRepo := NewMockHolidayRepo(ctrl)
+
+	cfg := Config{}
+	if err := envconfig.Process(t.Context(), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	controller := NewController(
+		cfg,
+		gormDB,
+		txmanager.New(gormDB),
+		timeModule,
+		shiftRepo,
+		departmentRepo,
+		employeeInfoRepo,
+		employeePositionRepo,
+		employeeAttendanceRepo,
+		leaveRepo,
+		holidayRepo,
+	)
+	testServer := testutils.NewTestHttpServer(controller)
+	faker := gofakeit.New(0)
+	suite := &testSuite{
+		db:                     gormDB,
+		mockDB:                 mockDB,
+		timeModule:             timeModule,
+		shiftRepo:              shiftRepo,
+		departmentRepo:         departmentRepo,
+		employeeInfoRepo:       employeeInfoRepo,
+		employeePositionRepo:   employeePositionRepo,
+		employeeAttendanceRepo: employeeAttendanceRepo,
+		leaveRepo:              leaveRepo,
+		holidayRepo:            holidayRepo,
+		controller:             controller,
+		testServer:             testServer,
+		faker:                  faker,
+	}
+
+	test(suite)
+}
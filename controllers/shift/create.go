@@ -0,0 +1,91 @@
+package shift
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/schedule"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type CreateRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+	// StartTime and EndTime are HH:MM on the wall clock of the employee, an
+	// end before the start falls on the next day
+	StartTime string `json:"start_time" binding:"required"`
+	EndTime   string `json:"end_time"   binding:"required"`
+	// Weekdays are the days the shift starts on, e.g. mon
+	Weekdays     []string `json:"weekdays"      binding:"required,min=1"`
+	GraceMinutes int      `json:"grace_minutes" binding:"min=0,max=240"`
+}
+
+type CreateResponse = dtos.ShiftV1Response
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) Create(ctx *gin.Context) {
+	var req CreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+
+	startMinute, err := schedule.ParseClock(req.StartTime)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	endMinute, err := schedule.ParseClock(req.EndTime)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if startMinute == endMinute {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "end_time must differ from start_time"})
+		return
+	}
+	weekdays, err := schedule.ParseWeekdays(req.Weekdays)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	shift := &models.Shift{
+		Name:         req.Name,
+		StartMinute:  startMinute,
+		EndMinute:    endMinute,
+		Weekdays:     weekdays,
+		GraceMinutes: req.GraceMinutes,
+	}
+	if err := c.txManager.Do(ctx, func(tx *txmanager.Tx) error {
+		// Names are unique regardless of case
+		existing, err := c.shiftRepo.GetByName(ctx, tx.DB, shift.Name)
+		if err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to get shift")
+		}
+		if existing != nil {
+			return utils.NewHttpError(http.StatusConflict, "shift already exists")
+		}
+
+		if err := c.shiftRepo.Create(ctx, tx.DB, shift); err != nil {
+			return utils.NewHttpError(http.StatusInternalServerError, "failed to create shift")
+		}
+		return nil
+	}); err != nil {
+		utils.RespondError(ctx, err, "failed to create shift")
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusCreated, schedule.NewShiftV1Response(shift, utils.GetTimeFormatter(ctx)))
+}
@@ -0,0 +1,82 @@
+package shift
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreate(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a night shift", t, func() {
+			req := CreateRequest{
+				Name:         "Night",
+				StartTime:    "22:00",
+				EndTime:      "06:00",
+				Weekdays:     []string{"mon", "tue", "wed"},
+				GraceMinutes: 10,
+			}
+
+			Convey("When creating it", func(c C) {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectCommit()
+
+				s.shiftRepo.EXPECT().
+					GetByName(gomock.Any(), gomock.Any(), "Night").
+					Return(nil, nil)
+				s.shiftRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ any, shift *models.Shift) error {
+						c.So(shift.StartMinute, ShouldEqual, 22*60)
+						c.So(shift.EndMinute, ShouldEqual, 6*60)
+						c.So(shift.Weekdays, ShouldEqual, 0b0001110)
+						shift.ID = 1
+						return nil
+					})
+
+				var resp CreateResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift", req, &resp, http.StatusCreated)
+
+				Convey("Then the shift should be returned", func() {
+					So(resp.ShiftID, ShouldEqual, 1)
+					So(resp.StartTime, ShouldEqual, "22:00")
+					So(resp.EndTime, ShouldEqual, "06:00")
+					So(resp.Weekdays, ShouldResemble, []string{"mon", "tue", "wed"})
+					So(resp.GraceMinutes, ShouldEqual, 10)
+				})
+			})
+
+			Convey("When the name is taken", func() {
+				s.mockDB.ExpectBegin()
+				s.mockDB.ExpectRollback()
+
+				s.shiftRepo.EXPECT().
+					GetByName(gomock.Any(), gomock.Any(), "Night").
+					Return(&models.Shift{ID: 2, Name: "night"}, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift", req, nil, http.StatusConflict)
+			})
+
+			Convey("When a time is malformed", func() {
+				badReq := req
+				badReq.StartTime = "10pm"
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift", badReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When the shift ends when it starts", func() {
+				badReq := req
+				badReq.EndTime = badReq.StartTime
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift", badReq, nil, http.StatusBadRequest)
+			})
+
+			Convey("When a weekday is unknown", func() {
+				badReq := req
+				badReq.Weekdays = []string{"monday"}
+				s.testServer.MustDoAndMatchCode(t, http.MethodPost, "/shift", badReq, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package shift
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGet(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a shift", t, func() {
+			shift := models.DummyShift(s.faker)
+			shift.ID = 1
+
+			Convey("When getting it", func() {
+				s.shiftRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(1)).
+					Return(shift, nil)
+
+				var resp dtos.ShiftV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/1", nil, &resp, http.StatusOK)
+
+				Convey("Then it should be returned", func() {
+					So(resp.Name, ShouldEqual, shift.Name)
+					So(resp.StartTime, ShouldEqual, "09:00")
+					So(resp.EndTime, ShouldEqual, "17:00")
+					So(resp.Weekdays, ShouldResemble, []string{"mon", "tue", "wed", "thu", "fri"})
+				})
+			})
+
+			Convey("When it does not exist", func() {
+				s.shiftRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), int64(2)).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/2", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the id is invalid", func() {
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/abc", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
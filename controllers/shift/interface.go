@@ -0,0 +1,58 @@
+package shift
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=shift
+type TxManager interface {
+	Do(ctx context.Context, fn func(tx *txmanager.Tx) error) error
+}
+
+type TimeModule interface {
+	Now() time.Time
+}
+
+type ShiftRepo interface {
+	Create(ctx context.Context, tx *gorm.DB, data *models.Shift) error
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Shift, error)
+	GetByName(ctx context.Context, tx *gorm.DB, name string) (*models.Shift, error)
+	List(ctx context.Context, tx *gorm.DB) ([]*models.Shift, error)
+	ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) (map[int64]*models.Shift, error)
+	CreateAssignment(ctx context.Context, tx *gorm.DB, data *models.ShiftAssignment) error
+	ListAssignmentsByShiftID(ctx context.Context, tx *gorm.DB, shiftID int64) ([]*models.ShiftAssignment, error)
+	ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs []int64, departmentIDs []int64, from, to time.Time) ([]*models.ShiftAssignment, error)
+}
+
+type DepartmentRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+}
+
+type EmployeeInfoRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error)
+	ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error)
+}
+
+type EmployeePositionRepo interface {
+	ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64) ([]*models.EmployeePosition, error)
+	ListEmployeeIDsByDepartmentID(ctx context.Context, tx *gorm.DB, departmentID int64) ([]int64, error)
+	ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error)
+}
+
+type EmployeeAttendanceRepo interface {
+	ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error)
+}
+
+type LeaveRepo interface {
+	ListApprovedByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.LeaveRequest, error)
+}
+
+type HolidayRepo interface {
+	ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs []int64, departmentIDs []int64) ([]*models.HolidayCalendarAssignment, error)
+	ListHolidays(ctx context.Context, tx *gorm.DB, calendarIDs []int64, from, to time.Time) ([]*models.Holiday, error)
+}
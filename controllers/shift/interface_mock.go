@@ -0,0 +1,533 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=shift
+//
+
+// Package shift is a generated GoMock package.
+package shift
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/WangWilly/labs-hr-go/pkgs/models"
+	txmanager "github.com/WangWilly/labs-hr-go/pkgs/txmanager"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(*txmanager.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockShiftRepo is a mock of ShiftRepo interface.
+type MockShiftRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockShiftRepoMockRecorder is the mock recorder for MockShiftRepo.
+type MockShiftRepoMockRecorder struct {
+	mock *MockShiftRepo
+}
+
+// NewMockShiftRepo creates a new mock instance.
+func NewMockShiftRepo(ctrl *gomock.Controller) *MockShiftRepo {
+	mock := &MockShiftRepo{ctrl: ctrl}
+	mock.recorder = &MockShiftRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftRepo) EXPECT() *MockShiftRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShiftRepo) Create(ctx context.Context, tx *gorm.DB, data *models.Shift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShiftRepoMockRecorder) Create(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShiftRepo)(nil).Create), ctx, tx, data)
+}
+
+// CreateAssignment mocks base method.
+func (m *MockShiftRepo) CreateAssignment(ctx context.Context, tx *gorm.DB, data *models.ShiftAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssignment", ctx, tx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAssignment indicates an expected call of CreateAssignment.
+func (mr *MockShiftRepoMockRecorder) CreateAssignment(ctx, tx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssignment", reflect.TypeOf((*MockShiftRepo)(nil).CreateAssignment), ctx, tx, data)
+}
+
+// Get mocks base method.
+func (m *MockShiftRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockShiftRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockShiftRepo)(nil).Get), ctx, tx, id)
+}
+
+// GetByName mocks base method.
+func (m *MockShiftRepo) GetByName(ctx context.Context, tx *gorm.DB, name string) (*models.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, tx, name)
+	ret0, _ := ret[0].(*models.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockShiftRepoMockRecorder) GetByName(ctx, tx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockShiftRepo)(nil).GetByName), ctx, tx, name)
+}
+
+// List mocks base method.
+func (m *MockShiftRepo) List(ctx context.Context, tx *gorm.DB) ([]*models.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tx)
+	ret0, _ := ret[0].([]*models.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockShiftRepoMockRecorder) List(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShiftRepo)(nil).List), ctx, tx)
+}
+
+// ListAssignments mocks base method.
+func (m *MockShiftRepo) ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs, departmentIDs []int64, from, to time.Time) ([]*models.ShiftAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignments", ctx, tx, employeeIDs, departmentIDs, from, to)
+	ret0, _ := ret[0].([]*models.ShiftAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssignments indicates an expected call of ListAssignments.
+func (mr *MockShiftRepoMockRecorder) ListAssignments(ctx, tx, employeeIDs, departmentIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignments", reflect.TypeOf((*MockShiftRepo)(nil).ListAssignments), ctx, tx, employeeIDs, departmentIDs, from, to)
+}
+
+// ListAssignmentsByShiftID mocks base method.
+func (m *MockShiftRepo) ListAssignmentsByShiftID(ctx context.Context, tx *gorm.DB, shiftID int64) ([]*models.ShiftAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignmentsByShiftID", ctx, tx, shiftID)
+	ret0, _ := ret[0].([]*models.ShiftAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssignmentsByShiftID indicates an expected call of ListAssignmentsByShiftID.
+func (mr *MockShiftRepoMockRecorder) ListAssignmentsByShiftID(ctx, tx, shiftID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignmentsByShiftID", reflect.TypeOf((*MockShiftRepo)(nil).ListAssignmentsByShiftID), ctx, tx, shiftID)
+}
+
+// ListByIDs mocks base method.
+func (m *MockShiftRepo) ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) (map[int64]*models.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, tx, ids)
+	ret0, _ := ret[0].(map[int64]*models.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockShiftRepoMockRecorder) ListByIDs(ctx, tx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockShiftRepo)(nil).ListByIDs), ctx, tx, ids)
+}
+
+// MockDepartmentRepo is a mock of DepartmentRepo interface.
+type MockDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepoMockRecorder is the mock recorder for MockDepartmentRepo.
+type MockDepartmentRepoMockRecorder struct {
+	mock *MockDepartmentRepo
+}
+
+// NewMockDepartmentRepo creates a new mock instance.
+func NewMockDepartmentRepo(ctrl *gomock.Controller) *MockDepartmentRepo {
+	mock := &MockDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepo) EXPECT() *MockDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDepartmentRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepartmentRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepartmentRepo)(nil).Get), ctx, tx, id)
+}
+
+// MockEmployeeInfoRepo is a mock of EmployeeInfoRepo interface.
+type MockEmployeeInfoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeInfoRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeInfoRepoMockRecorder is the mock recorder for MockEmployeeInfoRepo.
+type MockEmployeeInfoRepoMockRecorder struct {
+	mock *MockEmployeeInfoRepo
+}
+
+// NewMockEmployeeInfoRepo creates a new mock instance.
+func NewMockEmployeeInfoRepo(ctrl *gomock.Controller) *MockEmployeeInfoRepo {
+	mock := &MockEmployeeInfoRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeInfoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeInfoRepo) EXPECT() *MockEmployeeInfoRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockEmployeeInfoRepo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tx, id)
+	ret0, _ := ret[0].(*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeInfoRepoMockRecorder) Get(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).Get), ctx, tx, id)
+}
+
+// ListByIDsWithTerminated mocks base method.
+func (m *MockEmployeeInfoRepo) ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDsWithTerminated", ctx, tx, ids)
+	ret0, _ := ret[0].([]*models.EmployeeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDsWithTerminated indicates an expected call of ListByIDsWithTerminated.
+func (mr *MockEmployeeInfoRepoMockRecorder) ListByIDsWithTerminated(ctx, tx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDsWithTerminated", reflect.TypeOf((*MockEmployeeInfoRepo)(nil).ListByIDsWithTerminated), ctx, tx, ids)
+}
+
+// MockEmployeePositionRepo is a mock of EmployeePositionRepo interface.
+type MockEmployeePositionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeePositionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeePositionRepoMockRecorder is the mock recorder for MockEmployeePositionRepo.
+type MockEmployeePositionRepoMockRecorder struct {
+	mock *MockEmployeePositionRepo
+}
+
+// NewMockEmployeePositionRepo creates a new mock instance.
+func NewMockEmployeePositionRepo(ctrl *gomock.Controller) *MockEmployeePositionRepo {
+	mock := &MockEmployeePositionRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeePositionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeePositionRepo) EXPECT() *MockEmployeePositionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByEmployeeIDs mocks base method.
+func (m *MockEmployeePositionRepo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64) ([]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeIDs", ctx, tx, employeeIDs)
+	ret0, _ := ret[0].([]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeIDs indicates an expected call of ListByEmployeeIDs.
+func (mr *MockEmployeePositionRepoMockRecorder) ListByEmployeeIDs(ctx, tx, employeeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeIDs", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListByEmployeeIDs), ctx, tx, employeeIDs)
+}
+
+// ListCurrentByEmployeeIDs mocks base method.
+func (m *MockEmployeePositionRepo) ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrentByEmployeeIDs", ctx, tx, employeeIDs, nowtime)
+	ret0, _ := ret[0].(map[int64]*models.EmployeePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrentByEmployeeIDs indicates an expected call of ListCurrentByEmployeeIDs.
+func (mr *MockEmployeePositionRepoMockRecorder) ListCurrentByEmployeeIDs(ctx, tx, employeeIDs, nowtime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrentByEmployeeIDs", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListCurrentByEmployeeIDs), ctx, tx, employeeIDs, nowtime)
+}
+
+// ListEmployeeIDsByDepartmentID mocks base method.
+func (m *MockEmployeePositionRepo) ListEmployeeIDsByDepartmentID(ctx context.Context, tx *gorm.DB, departmentID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmployeeIDsByDepartmentID", ctx, tx, departmentID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmployeeIDsByDepartmentID indicates an expected call of ListEmployeeIDsByDepartmentID.
+func (mr *MockEmployeePositionRepoMockRecorder) ListEmployeeIDsByDepartmentID(ctx, tx, departmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployeeIDsByDepartmentID", reflect.TypeOf((*MockEmployeePositionRepo)(nil).ListEmployeeIDsByDepartmentID), ctx, tx, departmentID)
+}
+
+// MockEmployeeAttendanceRepo is a mock of EmployeeAttendanceRepo interface.
+type MockEmployeeAttendanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeeAttendanceRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeeAttendanceRepoMockRecorder is the mock recorder for MockEmployeeAttendanceRepo.
+type MockEmployeeAttendanceRepoMockRecorder struct {
+	mock *MockEmployeeAttendanceRepo
+}
+
+// NewMockEmployeeAttendanceRepo creates a new mock instance.
+func NewMockEmployeeAttendanceRepo(ctrl *gomock.Controller) *MockEmployeeAttendanceRepo {
+	mock := &MockEmployeeAttendanceRepo{ctrl: ctrl}
+	mock.recorder = &MockEmployeeAttendanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeeAttendanceRepo) EXPECT() *MockEmployeeAttendanceRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByEmployeeIDs mocks base method.
+func (m *MockEmployeeAttendanceRepo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmployeeIDs", ctx, tx, employeeIDs, from, to)
+	ret0, _ := ret[0].([]*models.EmployeeAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEmployeeIDs indicates an expected call of ListByEmployeeIDs.
+func (mr *MockEmployeeAttendanceRepoMockRecorder) ListByEmployeeIDs(ctx, tx, employeeIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmployeeIDs", reflect.TypeOf((*MockEmployeeAttendanceRepo)(nil).ListByEmployeeIDs), ctx, tx, employeeIDs, from, to)
+}
+
+// MockLeaveRepo is a mock of LeaveRepo interface.
+type MockLeaveRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockLeaveRepoMockRecorder is the mock recorder for MockLeaveRepo.
+type MockLeaveRepoMockRecorder struct {
+	mock *MockLeaveRepo
+}
+
+// NewMockLeaveRepo creates a new mock instance.
+func NewMockLeaveRepo(ctrl *gomock.Controller) *MockLeaveRepo {
+	mock := &MockLeaveRepo{ctrl: ctrl}
+	mock.recorder = &MockLeaveRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveRepo) EXPECT() *MockLeaveRepoMockRecorder {
+	return m.recorder
+}
+
+// ListApprovedByEmployeeIDs mocks base method.
+func (m *MockLeaveRepo) ListApprovedByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovedByEmployeeIDs", ctx, tx, employeeIDs, from, to)
+	ret0, _ := ret[0].([]*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovedByEmployeeIDs indicates an expected call of ListApprovedByEmployeeIDs.
+func (mr *MockLeaveRepoMockRecorder) ListApprovedByEmployeeIDs(ctx, tx, employeeIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovedByEmployeeIDs", reflect.TypeOf((*MockLeaveRepo)(nil).ListApprovedByEmployeeIDs), ctx, tx, employeeIDs, from, to)
+}
+
+// MockHolidayRepo is a mock of HolidayRepo interface.
+type MockHolidayRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidayRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockHolidayRepoMockRecorder is the mock recorder for MockHolidayRepo.
+type MockHolidayRepoMockRecorder struct {
+	mock *MockHolidayRepo
+}
+
+// NewMockHolidayRepo creates a new mock instance.
+func NewMockHolidayRepo(ctrl *gomock.Controller) *MockHolidayRepo {
+	mock := &MockHolidayRepo{ctrl: ctrl}
+	mock.recorder = &MockHolidayRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidayRepo) EXPECT() *MockHolidayRepoMockRecorder {
+	return m.recorder
+}
+
+// ListAssignments mocks base method.
+func (m *MockHolidayRepo) ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs, departmentIDs []int64) ([]*models.HolidayCalendarAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignments", ctx, tx, employeeIDs, departmentIDs)
+	ret0, _ := ret[0].([]*models.HolidayCalendarAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssignments indicates an expected call of ListAssignments.
+func (mr *MockHolidayRepoMockRecorder) ListAssignments(ctx, tx, employeeIDs, departmentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignments", reflect.TypeOf((*MockHolidayRepo)(nil).ListAssignments), ctx, tx, employeeIDs, departmentIDs)
+}
+
+// ListHolidays mocks base method.
+func (m *MockHolidayRepo) ListHolidays(ctx context.Context, tx *gorm.DB, calendarIDs []int64, from, to time.Time) ([]*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolidays", ctx, tx, calendarIDs, from, to)
+	ret0, _ := ret[0].([]*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolidays indicates an expected call of ListHolidays.
+func (mr *MockHolidayRepoMockRecorder) ListHolidays(ctx, tx, calendarIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolidays", reflect.TypeOf((*MockHolidayRepo)(nil).ListHolidays), ctx, tx, calendarIDs, from, to)
+}
@@ -0,0 +1,35 @@
+package shift
+
+import (
+	"net/http"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/schedule"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListResponse struct {
+	Items []dtos.ShiftV1Response `json:"items"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (c *Controller) List(ctx *gin.Context) {
+	shifts, err := c.shiftRepo.List(ctx, c.db)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list shifts"})
+		return
+	}
+
+	formatter := utils.GetTimeFormatter(ctx)
+	ctx.JSON(http.StatusOK, ListResponse{
+		Items: lo.Map(shifts, func(shift *models.Shift, _ int) dtos.ShiftV1Response {
+			return schedule.NewShiftV1Response(shift, formatter)
+		}),
+	})
+}
@@ -0,0 +1,32 @@
+package shift
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given shifts", t, func() {
+			shifts := []*models.Shift{models.DummyShift(s.faker), models.DummyShift(s.faker)}
+
+			Convey("When listing them", func() {
+				s.shiftRepo.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(shifts, nil)
+
+				var resp ListResponse
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift", nil, &resp, http.StatusOK)
+
+				Convey("Then every shift should be returned", func() {
+					So(resp.Items, ShouldHaveLength, 2)
+					So(resp.Items[1].Name, ShouldEqual, shifts[1].Name)
+				})
+			})
+		})
+	})
+}
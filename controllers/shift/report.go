@@ -0,0 +1,244 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/holiday"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/schedule"
+	"github.com/WangWilly/labs-hr-go/pkgs/timesheet"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ReportRequest struct {
+	// Period is one of day, week or month, defaults to week
+	Period string `form:"period"`
+	// Start is the first day of the period, defaults to the current period
+	Start string `form:"start"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// EmployeeReport flags the late arrivals, early departures and absences of
+// the employee over a period of days in the employee's zone. Public holidays
+// and days of approved leave are not absences.
+func (c *Controller) EmployeeReport(ctx *gin.Context) {
+	employeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	// Former employees are reported on as well
+	employeeInfos, err := c.employeeInfoRepo.ListByIDsWithTerminated(ctx, c.db, []int64{employeeID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get employee info"})
+		return
+	}
+	if len(employeeInfos) == 0 {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	// The current period is the one of the employee's zone
+	nowTime := c.timeModule.Now()
+	_, from, to, err := timesheet.ParseWindow(req.Period, req.Start, nowTime, employeeInfos[0].Location())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	from, to = timesheet.WindowIn(from, to, time.UTC)
+
+	// Every employee holds at least one position, so none means no employee
+	positions, err := c.employeePositionRepo.ListByEmployeeIDs(ctx, c.db, []int64{employeeID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+	if len(positions) == 0 {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "employee not found"})
+		return
+	}
+
+	reports, err := c.buildReports(ctx, employeeInfos, positions, from, to, nowTime, utils.GetTimeFormatter(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, reports[0])
+}
+
+// DepartmentReport reports on the employees currently holding a position in
+// the department.
+func (c *Controller) DepartmentReport(ctx *gin.Context) {
+	departmentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req ReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	nowTime := c.timeModule.Now()
+	_, from, to, err := timesheet.ParseWindow(req.Period, req.Start, nowTime, time.UTC)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	department, err := c.departmentRepo.Get(ctx, c.db, departmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get department"})
+		return
+	}
+	if department == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "department not found"})
+		return
+	}
+
+	// Former members still have positions in the department, keep the
+	// employees whose current position is in it
+	employeeIDs, err := c.employeePositionRepo.ListEmployeeIDsByDepartmentID(ctx, c.db, departmentID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list department employees"})
+		return
+	}
+	currentPositions, err := c.employeePositionRepo.ListCurrentByEmployeeIDs(ctx, c.db, employeeIDs, nowTime)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list department employees"})
+		return
+	}
+	memberIDs := lo.Filter(employeeIDs, func(employeeID int64, _ int) bool {
+		position, ok := currentPositions[employeeID]
+		return ok && position.DepartmentID == departmentID
+	})
+
+	memberInfos, err := c.employeeInfoRepo.ListByIDsWithTerminated(ctx, c.db, memberIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list department employees"})
+		return
+	}
+	positions, err := c.employeePositionRepo.ListByEmployeeIDs(ctx, c.db, memberIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list employee positions"})
+		return
+	}
+
+	reports, err := c.buildReports(ctx, memberInfos, positions, from, to, nowTime, utils.GetTimeFormatter(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	////////////////////////////////////////////////////////////////////////////
+
+	ctx.JSON(http.StatusOK, schedule.NewDepartmentReportV1Response(departmentID, from, to, reports))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// buildReports evaluates the schedule of each employee over the days of
+// [from, to), in the employee's zone. The returned error is meant for the
+// client.
+func (c *Controller) buildReports(
+	ctx context.Context,
+	employeeInfos []*models.EmployeeInfo,
+	positions []*models.EmployeePosition,
+	from, to, nowTime time.Time,
+	formatter utils.TimeFormatter,
+) ([]dtos.ShiftReportV1Response, error) {
+	employeeIDs := lo.Map(employeeInfos, func(employeeInfo *models.EmployeeInfo, _ int) int64 {
+		return employeeInfo.ID
+	})
+
+	// Shifts may be assigned through any department held during the period
+	departmentIDs := lo.Uniq(lo.Map(positions, func(position *models.EmployeePosition, _ int) int64 {
+		return position.DepartmentID
+	}))
+	assignments, err := c.shiftRepo.ListAssignments(ctx, c.db, employeeIDs, departmentIDs, from, to)
+	if err != nil {
+		return nil, errors.New("failed to list shift assignments")
+	}
+	shiftIDs := lo.Uniq(lo.Map(assignments, func(assignment *models.ShiftAssignment, _ int) int64 {
+		return assignment.ShiftID
+	}))
+	shifts, err := c.shiftRepo.ListByIDs(ctx, c.db, shiftIDs)
+	if err != nil {
+		return nil, errors.New("failed to list shifts")
+	}
+
+	// The days of a zone start up to a day away from UTC, and an overnight
+	// shift of the last day ends the day after
+	attendances, err := c.employeeAttendanceRepo.ListByEmployeeIDs(ctx, c.db, employeeIDs, from.AddDate(0, 0, -1), to.AddDate(0, 0, 2))
+	if err != nil {
+		return nil, errors.New("failed to list attendance")
+	}
+	leaves, err := c.leaveRepo.ListApprovedByEmployeeIDs(ctx, c.db, employeeIDs, from, to)
+	if err != nil {
+		return nil, errors.New("failed to list approved leave")
+	}
+	holidayAssignments, err := c.holidayRepo.ListAssignments(ctx, c.db, employeeIDs, departmentIDs)
+	if err != nil {
+		return nil, errors.New("failed to list holiday calendar assignments")
+	}
+	calendarIDs := lo.Uniq(lo.Map(holidayAssignments, func(assignment *models.HolidayCalendarAssignment, _ int) int64 {
+		return assignment.CalendarID
+	}))
+	holidays, err := c.holidayRepo.ListHolidays(ctx, c.db, calendarIDs, from, to)
+	if err != nil {
+		return nil, errors.New("failed to list holidays")
+	}
+
+	positionsByEmployee := lo.GroupBy(positions, func(position *models.EmployeePosition) int64 {
+		return position.EmployeeID
+	})
+	attendancesByEmployee := lo.GroupBy(attendances, func(attendance *models.EmployeeAttendance) int64 {
+		return attendance.EmployeeID
+	})
+	leavesByEmployee := lo.GroupBy(leaves, func(leave *models.LeaveRequest) int64 {
+		return leave.EmployeeID
+	})
+
+	reports := make([]dtos.ShiftReportV1Response, 0, len(employeeInfos))
+	for _, employeeInfo := range employeeInfos {
+		employeeID := employeeInfo.ID
+		employeeSchedule := schedule.New(employeeID, positionsByEmployee[employeeID], assignments, shifts)
+		report := schedule.Evaluate(
+			employeeSchedule,
+			attendancesByEmployee[employeeID],
+			leavesByEmployee[employeeID],
+			holiday.ForEmployee(employeeID, positionsByEmployee[employeeID], holidayAssignments, holidays),
+			from, to, nowTime,
+			employeeInfo.Location(),
+		)
+		reports = append(reports, schedule.NewReportV1Response(employeeID, report, formatter.In(employeeInfo.Location())))
+	}
+
+	return reports, nil
+}
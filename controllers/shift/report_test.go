@@ -0,0 +1,217 @@
+package shift
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReport(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given an employee on a department shift", t, func() {
+			employeeID := int64(123)
+			departmentID := int64(10)
+			// Monday
+			from := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+			to := from.AddDate(0, 0, 7)
+			nowTime := time.Date(2025, 5, 12, 9, 0, 0, 0, time.UTC)
+
+			shift := &models.Shift{ID: 1, Name: "Day", StartMinute: 9 * 60, EndMinute: 17 * 60, Weekdays: 0b0000110, GraceMinutes: 5}
+			position := &models.EmployeePosition{ID: 456, EmployeeID: employeeID, DepartmentID: departmentID, StartDate: from.AddDate(-1, 0, 0)}
+			assignment := &models.ShiftAssignment{ID: 1, ShiftID: 1, DepartmentID: lo.ToPtr(departmentID), EffectiveFrom: from}
+			attendances := []*models.EmployeeAttendance{
+				// Monday 20 minutes late, absent on Tuesday
+				{EmployeeID: employeeID, ClockIn: from.Add(9*time.Hour + 20*time.Minute), ClockOut: lo.ToPtr(from.Add(17 * time.Hour)), Status: models.AttendanceStatusClosed},
+			}
+
+			holidayAssignment := &models.HolidayCalendarAssignment{ID: 1, CalendarID: 1, DepartmentID: lo.ToPtr(departmentID)}
+			employeeInfo := &models.EmployeeInfo{ID: employeeID, TimeZone: models.DefaultTimeZone}
+
+			expectReport := func(employeeIDs []int64, attendances []*models.EmployeeAttendance, leaves []*models.LeaveRequest, holidays []*models.Holiday) {
+				s.shiftRepo.EXPECT().
+					ListAssignments(gomock.Any(), gomock.Any(), employeeIDs, []int64{departmentID}, from, to).
+					Return([]*models.ShiftAssignment{assignment}, nil)
+				s.shiftRepo.EXPECT().
+					ListByIDs(gomock.Any(), gomock.Any(), []int64{1}).
+					Return(map[int64]*models.Shift{1: shift}, nil)
+				s.employeeAttendanceRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), employeeIDs, from.AddDate(0, 0, -1), to.AddDate(0, 0, 2)).
+					Return(attendances, nil)
+				s.leaveRepo.EXPECT().
+					ListApprovedByEmployeeIDs(gomock.Any(), gomock.Any(), employeeIDs, from, to).
+					Return(leaves, nil)
+				s.holidayRepo.EXPECT().
+					ListAssignments(gomock.Any(), gomock.Any(), employeeIDs, []int64{departmentID}).
+					Return([]*models.HolidayCalendarAssignment{holidayAssignment}, nil)
+				s.holidayRepo.EXPECT().
+					ListHolidays(gomock.Any(), gomock.Any(), []int64{1}, from, to).
+					Return(holidays, nil)
+			}
+
+			Convey("When getting the report of the employee", func() {
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeePosition{position}, nil)
+				expectReport([]int64{employeeID}, attendances, nil, nil)
+
+				var resp dtos.ShiftReportV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/report/employee/123?start=2025-05-05", nil, &resp, http.StatusOK)
+
+				Convey("Then the late arrival and the absence should be flagged", func() {
+					So(resp.Start, ShouldEqual, "2025-05-05")
+					So(resp.End, ShouldEqual, "2025-05-11")
+					So(resp.Days, ShouldHaveLength, 2)
+					So(resp.Days[0].Late, ShouldBeTrue)
+					So(resp.Days[0].LateMinutes, ShouldEqual, 20)
+					So(resp.Days[0].ScheduledStart, ShouldEqual, "2025-05-05 09:00:00")
+					So(resp.Days[0].ClockInTime, ShouldEqual, "2025-05-05 09:20:00")
+					So(resp.Days[1].Absent, ShouldBeTrue)
+					So(resp.LateCount, ShouldEqual, 1)
+					So(resp.LeftEarlyCount, ShouldEqual, 0)
+					So(resp.AbsentCount, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When the employee was on leave on Tuesday", func() {
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeePosition{position}, nil)
+				expectReport([]int64{employeeID}, attendances, []*models.LeaveRequest{
+					{ID: 1, EmployeeID: employeeID, StartDate: from.AddDate(0, 0, 1), EndDate: from.AddDate(0, 0, 1), Status: models.LeaveStatusApproved},
+				}, nil)
+
+				var resp dtos.ShiftReportV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/report/employee/123?start=2025-05-05", nil, &resp, http.StatusOK)
+
+				Convey("Then the day should not count as an absence", func() {
+					So(resp.Days, ShouldHaveLength, 2)
+					So(resp.Days[1].OnLeave, ShouldBeTrue)
+					So(resp.Days[1].Absent, ShouldBeFalse)
+					So(resp.AbsentCount, ShouldEqual, 0)
+					So(resp.OnLeaveCount, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When Tuesday was a public holiday", func() {
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeePosition{position}, nil)
+				expectReport([]int64{employeeID}, attendances, nil, []*models.Holiday{
+					{ID: 1, CalendarID: 1, Date: from.AddDate(0, 0, 1), Name: "Labour Day"},
+				})
+
+				var resp dtos.ShiftReportV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/report/employee/123?start=2025-05-05", nil, &resp, http.StatusOK)
+
+				Convey("Then the day should be a holiday rather than an absence", func() {
+					So(resp.Days, ShouldHaveLength, 2)
+					So(resp.Days[1].Holiday, ShouldEqual, "Labour Day")
+					So(resp.Days[1].Absent, ShouldBeFalse)
+					So(resp.Days[0].Holiday, ShouldBeEmpty)
+					So(resp.AbsentCount, ShouldEqual, 0)
+					So(resp.HolidayCount, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When getting the report of the department", func() {
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.departmentRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), departmentID).
+					Return(&models.Department{ID: departmentID}, nil)
+				// Employee 124 moved to another department
+				s.employeePositionRepo.EXPECT().
+					ListEmployeeIDsByDepartmentID(gomock.Any(), gomock.Any(), departmentID).
+					Return([]int64{employeeID, 124}, nil)
+				s.employeePositionRepo.EXPECT().
+					ListCurrentByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID, 124}, nowTime).
+					Return(map[int64]*models.EmployeePosition{
+						employeeID: position,
+						124:        {ID: 789, EmployeeID: 124, DepartmentID: 20},
+					}, nil)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeePosition{position}, nil)
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+				expectReport([]int64{employeeID}, attendances, nil, nil)
+
+				var resp dtos.DepartmentShiftReportV1Response
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/report/department/10?start=2025-05-05", nil, &resp, http.StatusOK)
+
+				Convey("Then only the current members should be reported", func() {
+					So(resp.Employees, ShouldHaveLength, 1)
+					So(resp.Employees[0].EmployeeID, ShouldEqual, employeeID)
+					So(resp.LateCount, ShouldEqual, 1)
+					So(resp.AbsentCount, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When the employee works in another zone", func() {
+				taipei := &models.EmployeeInfo{ID: employeeID, TimeZone: "Asia/Taipei"}
+				// Monday 09:03 in Taipei, Tuesday's shift ends at 09:00 UTC
+				attendances := []*models.EmployeeAttendance{
+					{EmployeeID: employeeID, ClockIn: from.Add(1*time.Hour + 3*time.Minute), ClockOut: lo.ToPtr(from.Add(9 * time.Hour)), Status: models.AttendanceStatusClosed},
+				}
+
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{taipei}, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.employeePositionRepo.EXPECT().
+					ListByEmployeeIDs(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeePosition{position}, nil)
+				expectReport([]int64{employeeID}, attendances, nil, nil)
+
+				header := http.Header{}
+				header.Set(utils.TimeFormatHeader, string(utils.TimeFormatRFC3339))
+				var resp dtos.ShiftReportV1Response
+				s.testServer.MustDoWithHeaderAndMatchCode(t, http.MethodGet, "/shift/report/employee/123?start=2025-05-05", header, nil, &resp, http.StatusOK)
+
+				Convey("Then the shift should be worked on the local clock", func() {
+					So(resp.Days, ShouldHaveLength, 2)
+					So(resp.Days[0].Late, ShouldBeFalse)
+					So(resp.Days[0].ScheduledStart, ShouldEqual, "2025-05-05T09:00:00+08:00")
+					So(resp.Days[0].ClockInTime, ShouldEqual, "2025-05-05T09:03:00+08:00")
+					So(resp.Days[1].Absent, ShouldBeTrue)
+					So(resp.LateCount, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the employee does not exist", func() {
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{int64(999)}).
+					Return(nil, nil)
+
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/report/employee/999", nil, nil, http.StatusNotFound)
+			})
+
+			Convey("When the period is unknown", func() {
+				s.employeeInfoRepo.EXPECT().
+					ListByIDsWithTerminated(gomock.Any(), gomock.Any(), []int64{employeeID}).
+					Return([]*models.EmployeeInfo{employeeInfo}, nil)
+				s.timeModule.EXPECT().Now().Return(nowTime)
+				s.testServer.MustDoAndMatchCode(t, http.MethodGet, "/shift/report/employee/123?period=year", nil, nil, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -12,7 +12,8 @@ import (
 ////////////////////////////////////////////////////////////////////////////////
 
 type envConfig struct {
-	DbCfg utils.DbConfig `env:",prefix="`
+	DbCfg        utils.DbConfig    `env:",prefix="`
+	MigrationCfg migrations.Config `env:",prefix="`
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -37,7 +38,7 @@ func main() {
 	////////////////////////////////////////////////////////////////////////////
 	// run migrations
 
-	if err := migrations.Apply(db); err != nil {
+	if err := migrations.Apply(db, cfg.MigrationCfg); err != nil {
 		panic(err)
 	}
 }
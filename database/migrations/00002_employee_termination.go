@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00002 = &gormigrate.Migration{
+		ID: "00002",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00002EmployeeTermination(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00002EmployeeTermination(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00002EmployeeTermination(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Add the termination columns to the employeeinfo table
+	for _, field := range []string{"TerminatedAt", "TerminationReason"} {
+		if db.Migrator().HasColumn(&models.EmployeeInfo{}, field) {
+			continue
+		}
+		if err := db.Migrator().AddColumn(&models.EmployeeInfo{}, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00002EmployeeTermination(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the termination columns from the employeeinfo table
+	for _, field := range []string{"TerminatedAt", "TerminationReason"} {
+		if !db.Migrator().HasColumn(&models.EmployeeInfo{}, field) {
+			continue
+		}
+		if err := db.Migrator().DropColumn(&models.EmployeeInfo{}, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
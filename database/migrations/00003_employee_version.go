@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00003 = &gormigrate.Migration{
+		ID: "00003",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00003EmployeeVersion(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00003EmployeeVersion(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00003EmployeeVersion(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Add the version column to the employeeinfo table, existing rows start at 1
+	if db.Migrator().HasColumn(&models.EmployeeInfo{}, "Version") {
+		return nil
+	}
+	return db.Migrator().AddColumn(&models.EmployeeInfo{}, "Version")
+}
+
+func Down00003EmployeeVersion(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the version column from the employeeinfo table
+	if !db.Migrator().HasColumn(&models.EmployeeInfo{}, "Version") {
+		return nil
+	}
+	return db.Migrator().DropColumn(&models.EmployeeInfo{}, "Version")
+}
@@ -0,0 +1,97 @@
+package migrations
+
+import (
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00004 = &gormigrate.Migration{
+		ID: "00004",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00004Departments(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00004Departments(tx)
+		},
+	}
+)
+
+// unassignedDepartment receives the positions without a department name
+const unassignedDepartment = "Unassigned"
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00004Departments(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the department table
+	if !db.Migrator().HasTable(&models.Department{}) {
+		if err := db.Migrator().CreateTable(&models.Department{}); err != nil {
+			return err
+		}
+	}
+	// Add the department_id column to the employeeposition table
+	if !db.Migrator().HasColumn(&models.EmployeePosition{}, "DepartmentID") {
+		if err := db.Migrator().AddColumn(&models.EmployeePosition{}, "DepartmentID"); err != nil {
+			return err
+		}
+	}
+
+	// Backfill a department for every free-text department name. Names that
+	// only differ by case or surrounding spaces end up in the same department.
+	var names []string
+	if err := db.Model(&models.EmployeePosition{}).
+		Where("department_id = 0 OR department_id IS NULL").
+		Distinct().
+		Pluck("department", &names).Error; err != nil {
+		return err
+	}
+
+	departments := map[string]*models.Department{}
+	for _, name := range names {
+		canonical := strings.TrimSpace(name)
+		if canonical == "" {
+			canonical = unassignedDepartment
+		}
+		key := strings.ToLower(canonical)
+
+		department, ok := departments[key]
+		if !ok {
+			department = &models.Department{Name: canonical}
+			if err := db.Where("name = ?", canonical).FirstOrCreate(department).Error; err != nil {
+				return err
+			}
+			departments[key] = department
+		}
+
+		if err := db.Model(&models.EmployeePosition{}).
+			Where("(department_id = 0 OR department_id IS NULL) AND department = ?", name).
+			Updates(map[string]any{
+				"department_id": department.ID,
+				"department":    department.Name,
+			}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00004Departments(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the department_id column from the employeeposition table
+	if db.Migrator().HasColumn(&models.EmployeePosition{}, "DepartmentID") {
+		if err := db.Migrator().DropColumn(&models.EmployeePosition{}, "DepartmentID"); err != nil {
+			return err
+		}
+	}
+	// Drop the department table
+	return db.Migrator().DropTable(&models.Department{})
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00005 = &gormigrate.Migration{
+		ID: "00005",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00005AttendanceStatus(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00005AttendanceStatus(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00005AttendanceStatus(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Add the status column to the employeeattendance table, existing rows
+	// start as open
+	if !db.Migrator().HasColumn(&models.EmployeeAttendance{}, "Status") {
+		if err := db.Migrator().AddColumn(&models.EmployeeAttendance{}, "Status"); err != nil {
+			return err
+		}
+	}
+	// Make clock_out nullable
+	if err := db.Migrator().AlterColumn(&models.EmployeeAttendance{}, "ClockOut"); err != nil {
+		return err
+	}
+
+	// Backfill: sessions used to be open while clock_out equalled clock_in
+	if err := db.Model(&models.EmployeeAttendance{}).
+		Where("clock_out IS NOT NULL AND clock_out <> clock_in").
+		Update("status", models.AttendanceStatusClosed).Error; err != nil {
+		return err
+	}
+	if err := db.Model(&models.EmployeeAttendance{}).
+		Where("clock_out = clock_in").
+		Updates(map[string]any{
+			"status":    models.AttendanceStatusOpen,
+			"clock_out": nil,
+		}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func Down00005AttendanceStatus(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Restore the clock_out = clock_in encoding of open sessions
+	if err := db.Model(&models.EmployeeAttendance{}).
+		Where("clock_out IS NULL").
+		Update("clock_out", gorm.Expr("clock_in")).Error; err != nil {
+		return err
+	}
+	// Drop the status column from the employeeattendance table
+	if db.Migrator().HasColumn(&models.EmployeeAttendance{}, "Status") {
+		if err := db.Migrator().DropColumn(&models.EmployeeAttendance{}, "Status"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
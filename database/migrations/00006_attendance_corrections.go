@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00006 = &gormigrate.Migration{
+		ID: "00006",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00006AttendanceCorrections(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00006AttendanceCorrections(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00006AttendanceCorrections(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the correction request and revision tables
+	for _, table := range []any{&models.AttendanceCorrection{}, &models.AttendanceRevision{}} {
+		if db.Migrator().HasTable(table) {
+			continue
+		}
+		if err := db.Migrator().CreateTable(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00006AttendanceCorrections(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the correction request and revision tables
+	return db.Migrator().DropTable(&models.AttendanceRevision{}, &models.AttendanceCorrection{})
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00007 = &gormigrate.Migration{
+		ID: "00007",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00007Shifts(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00007Shifts(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00007Shifts(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the shift template and assignment tables
+	for _, table := range []any{&models.Shift{}, &models.ShiftAssignment{}} {
+		if db.Migrator().HasTable(table) {
+			continue
+		}
+		if err := db.Migrator().CreateTable(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00007Shifts(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the shift template and assignment tables
+	return db.Migrator().DropTable(&models.ShiftAssignment{}, &models.Shift{})
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00008 = &gormigrate.Migration{
+		ID: "00008",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00008Leave(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00008Leave(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00008Leave(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the leave type, balance and request tables
+	for _, table := range []any{&models.LeaveType{}, &models.LeaveBalance{}, &models.LeaveRequest{}} {
+		if db.Migrator().HasTable(table) {
+			continue
+		}
+		if err := db.Migrator().CreateTable(table); err != nil {
+			return err
+		}
+	}
+
+	// Provide the usual leave types, their accrual can be changed afterwards
+	leaveTypes := []*models.LeaveType{
+		{Code: models.LeaveTypeCodeAnnual, Name: "Annual leave", Paid: true, TracksBalance: true, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 30},
+		{Code: models.LeaveTypeCodeSick, Name: "Sick leave", Paid: true, TracksBalance: true, AccrualDaysPerMonth: 1},
+		{Code: models.LeaveTypeCodeUnpaid, Name: "Unpaid leave", Paid: false, TracksBalance: false},
+	}
+	for _, leaveType := range leaveTypes {
+		if err := db.Create(leaveType).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00008Leave(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the leave type, balance and request tables
+	return db.Migrator().DropTable(&models.LeaveRequest{}, &models.LeaveBalance{}, &models.LeaveType{})
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00009 = &gormigrate.Migration{
+		ID: "00009",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00009Holidays(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00009Holidays(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00009Holidays(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the holiday calendar, holiday and assignment tables
+	for _, table := range []any{&models.HolidayCalendar{}, &models.Holiday{}, &models.HolidayCalendarAssignment{}} {
+		if db.Migrator().HasTable(table) {
+			continue
+		}
+		if err := db.Migrator().CreateTable(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00009Holidays(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the holiday calendar, holiday and assignment tables
+	return db.Migrator().DropTable(&models.HolidayCalendarAssignment{}, &models.Holiday{}, &models.HolidayCalendar{})
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00010 = &gormigrate.Migration{
+		ID: "00010",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00010EmployeeTimeZone(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00010EmployeeTimeZone(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00010EmployeeTimeZone(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Add the time zone column to the employeeinfo table, existing rows are UTC
+	if db.Migrator().HasColumn(&models.EmployeeInfo{}, "TimeZone") {
+		return nil
+	}
+	return db.Migrator().AddColumn(&models.EmployeeInfo{}, "TimeZone")
+}
+
+func Down00010EmployeeTimeZone(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the time zone column from the employeeinfo table
+	if !db.Migrator().HasColumn(&models.EmployeeInfo{}, "TimeZone") {
+		return nil
+	}
+	return db.Migrator().DropColumn(&models.EmployeeInfo{}, "TimeZone")
+}
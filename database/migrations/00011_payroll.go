@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00011 = &gormigrate.Migration{
+		ID: "00011",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00011Payroll(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00011Payroll(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00011Payroll(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the payroll run and line tables
+	for _, table := range []any{&models.PayrollRun{}, &models.PayrollLine{}} {
+		if db.Migrator().HasTable(table) {
+			continue
+		}
+		if err := db.Migrator().CreateTable(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Down00011Payroll(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the payroll run and line tables
+	return db.Migrator().DropTable(&models.PayrollLine{}, &models.PayrollRun{})
+}
@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func m00012(cfg Config) *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "00012",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00012Money(tx, cfg.DefaultCurrency)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00012Money(tx)
+		},
+	}
+}
+
+// moneyColumns are the decimal columns replaced by a money.Money, keyed by
+// the table, with the prefix of the new columns
+var moneyColumns = []struct {
+	model  any
+	table  string
+	column string
+	prefix string
+	// definition is the type of the decimal column, for the rollback
+	definition string
+}{
+	{&models.EmployeePosition{}, "employeeposition", "salary", "salary_", "decimal(10,2)"},
+	{&models.PayrollLine{}, "payrollline", "monthly_salary", "monthly_salary_", "decimal(10,2)"},
+	{&models.PayrollLine{}, "payrollline", "amount", "pay_", "decimal(12,2)"},
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00012Money(db *gorm.DB, defaultCurrency string) error {
+	// This code is executed when the migration is applied.
+
+	if !money.IsCurrency(defaultCurrency) {
+		return fmt.Errorf("unknown default currency %q", defaultCurrency)
+	}
+
+	// Convert the decimal amounts to minor units of the default currency.
+	// Tables created after the models changed have no decimal column.
+	factor := math.Pow10(money.Exponent(defaultCurrency))
+	for _, c := range moneyColumns {
+		if !db.Migrator().HasColumn(c.model, c.column) {
+			continue
+		}
+		for _, column := range []string{c.prefix + "amount", c.prefix + "currency"} {
+			if err := db.Migrator().AddColumn(c.model, column); err != nil {
+				return err
+			}
+		}
+		if err := db.Exec(
+			"UPDATE "+c.table+" SET "+c.prefix+"amount = ROUND("+c.column+" * ?), "+c.prefix+"currency = ?",
+			factor, defaultCurrency,
+		).Error; err != nil {
+			return err
+		}
+		if err := db.Migrator().DropColumn(c.model, c.column); err != nil {
+			return err
+		}
+	}
+
+	// A run may mix currencies, its totals are summed from the lines
+	if db.Migrator().HasColumn(&models.PayrollRun{}, "total") {
+		return db.Migrator().DropColumn(&models.PayrollRun{}, "total")
+	}
+	return nil
+}
+
+func Down00012Money(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Convert the amounts back to decimals, the currencies are lost
+	for _, c := range moneyColumns {
+		if db.Migrator().HasColumn(c.model, c.column) {
+			continue
+		}
+		if err := db.Exec("ALTER TABLE " + c.table + " ADD COLUMN " + c.column + " " + c.definition).Error; err != nil {
+			return err
+		}
+
+		var currencies []string
+		if err := db.Table(c.table).Distinct(c.prefix+"currency").Pluck(c.prefix+"currency", &currencies).Error; err != nil {
+			return err
+		}
+		for _, currency := range currencies {
+			if err := db.Exec(
+				"UPDATE "+c.table+" SET "+c.column+" = "+c.prefix+"amount / ? WHERE "+c.prefix+"currency = ?",
+				math.Pow10(money.Exponent(currency)), currency,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, column := range []string{c.prefix + "amount", c.prefix + "currency"} {
+			if err := db.Migrator().DropColumn(c.model, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Restore the run totals from the decimal amounts of the lines
+	if db.Migrator().HasColumn(&models.PayrollRun{}, "total") {
+		return nil
+	}
+	if err := db.Exec("ALTER TABLE payrollrun ADD COLUMN total decimal(14,2)").Error; err != nil {
+		return err
+	}
+	return db.Exec(
+		"UPDATE payrollrun SET total = (SELECT COALESCE(SUM(amount), 0) FROM payrollline WHERE payrollline.run_id = payrollrun.id)",
+	).Error
+}
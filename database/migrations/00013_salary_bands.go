@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00013 = &gormigrate.Migration{
+		ID: "00013",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00013SalaryBands(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00013SalaryBands(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00013SalaryBands(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the salary band table
+	if db.Migrator().HasTable(&models.SalaryBand{}) {
+		return nil
+	}
+	return db.Migrator().CreateTable(&models.SalaryBand{})
+}
+
+func Down00013SalaryBands(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the salary band table
+	return db.Migrator().DropTable(&models.SalaryBand{})
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	m00014 = &gormigrate.Migration{
+		ID: "00014",
+		Migrate: func(tx *gorm.DB) error {
+			return Up00014ReportingLines(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return Down00014ReportingLines(tx)
+		},
+	}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Up00014ReportingLines(db *gorm.DB) error {
+	// This code is executed when the migration is applied.
+
+	// Create the reporting line table
+	if db.Migrator().HasTable(&models.ReportingLine{}) {
+		return nil
+	}
+	return db.Migrator().CreateTable(&models.ReportingLine{})
+}
+
+func Down00014ReportingLines(db *gorm.DB) error {
+	// This code is executed when the migration is rolled back.
+
+	// Drop the reporting line table
+	return db.Migrator().DropTable(&models.ReportingLine{})
+}
@@ -7,12 +7,32 @@ import (
 
 ////////////////////////////////////////////////////////////////////////////////
 
-var migrationList = []*gormigrate.Migration{
-	m00001,
+type Config struct {
+	// DefaultCurrency is given to the salaries recorded without a currency
+	DefaultCurrency string `env:"DEFAULT_CURRENCY,default=USD"`
 }
 
-func Apply(db *gorm.DB) error {
-	m := gormigrate.New(db, gormigrate.DefaultOptions, migrationList)
+func migrationList(cfg Config) []*gormigrate.Migration {
+	return []*gormigrate.Migration{
+		m00001,
+		m00002,
+		m00003,
+		m00004,
+		m00005,
+		m00006,
+		m00007,
+		m00008,
+		m00009,
+		m00010,
+		m00011,
+		m00012(cfg),
+		m00013,
+		m00014,
+	}
+}
+
+func Apply(db *gorm.DB, cfg Config) error {
+	m := gormigrate.New(db, gormigrate.DefaultOptions, migrationList(cfg))
 	if err := m.Migrate(); err != nil {
 		return err
 	}
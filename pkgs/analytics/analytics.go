@@ -0,0 +1,194 @@
+// Package analytics computes the monthly headcount, hires and leavers from
+// the effective-dated positions of the employees. Days are UTC calendar
+// days.
+package analytics
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const MonthLayout = "2006-01"
+
+// GroupByDepartment splits the metrics of every month by department.
+const GroupByDepartment = "department"
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Metrics are the counts of a month. Headcount is taken at the end of the
+// last day of the month, OpeningHeadcount at the end of the month before, so
+// that Headcount is OpeningHeadcount plus Hires minus Leavers.
+type Metrics struct {
+	OpeningHeadcount int
+	Headcount        int
+	Hires            int
+	Leavers          int
+}
+
+// AttritionRate returns the leavers as a percentage of the average of the
+// opening and closing headcounts, rounded to 2 decimals.
+func (m Metrics) AttritionRate() float64 {
+	average := float64(m.OpeningHeadcount+m.Headcount) / 2
+	if average == 0 {
+		return 0
+	}
+	return math.Round(float64(m.Leavers)/average*100*100) / 100
+}
+
+// Group are the metrics of a department in a month.
+type Group struct {
+	DepartmentID int64
+	Department   string
+	Metrics
+}
+
+// Month are the metrics of a calendar month, split by group when asked.
+type Month struct {
+	// Start is the first day of the month
+	Start time.Time
+	Metrics
+	Groups []Group
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// WindowStart returns the first day the positions are needed from to compute
+// the months starting with the month of from: the last day of the month
+// before, for the opening headcount.
+func WindowStart(from time.Time) time.Time {
+	return FirstDayOfMonth(from).AddDate(0, 0, -1)
+}
+
+// Headcount returns the metrics of every month from the month of from to the
+// month of to. The positions are grouped by employee and ordered by start
+// date, the first one of an employee being their hire unless it started
+// before WindowStart. An employee counts from the start of their first
+// position until the end of the day before their termination date. Employees
+// missing from employeeInfos are left out. A move between departments is
+// neither a hire nor a leave.
+func Headcount(from, to time.Time, employeeInfos []*models.EmployeeInfo, positions []*models.EmployeePosition, groupBy string) []Month {
+	employeeInfoByID := lo.KeyBy(employeeInfos, func(employeeInfo *models.EmployeeInfo) int64 {
+		return employeeInfo.ID
+	})
+	timelines := lo.PartitionBy(positions, func(position *models.EmployeePosition) int64 {
+		return position.EmployeeID
+	})
+
+	months := []Month{}
+	for start := FirstDayOfMonth(from); !start.After(to); start = start.AddDate(0, 1, 0) {
+		end := start.AddDate(0, 1, -1)
+		month := Month{Start: start}
+		groups := map[int64]*Group{}
+		count := func(position *models.EmployeePosition, add func(*Metrics)) {
+			add(&month.Metrics)
+			if groupBy != GroupByDepartment {
+				return
+			}
+			group, ok := groups[position.DepartmentID]
+			if !ok {
+				group = &Group{DepartmentID: position.DepartmentID, Department: position.Department}
+				groups[position.DepartmentID] = group
+			}
+			add(&group.Metrics)
+		}
+
+		for _, timeline := range timelines {
+			employeeInfo, ok := employeeInfoByID[timeline[0].EmployeeID]
+			if !ok {
+				continue
+			}
+			employee := employee{positions: timeline, terminatedAt: TerminationDay(employeeInfo)}
+
+			if position := employee.activeAfter(start.AddDate(0, 0, -1)); position != nil {
+				count(position, func(m *Metrics) { m.OpeningHeadcount++ })
+			}
+			if position := employee.activeAfter(end); position != nil {
+				count(position, func(m *Metrics) { m.Headcount++ })
+			}
+			if hiredAt := Day(timeline[0].StartDate); !hiredAt.Before(start) && !hiredAt.After(end) {
+				// Hired and terminated on the same day, the employee never counted
+				if position := employee.activeAfter(hiredAt); position != nil {
+					count(position, func(m *Metrics) { m.Hires++ })
+				}
+			}
+			if leftAt := employee.terminatedAt; leftAt != nil && !leftAt.Before(start) && !leftAt.After(end) {
+				// Counted where the employee was in the last headcount they were part of
+				if position := employee.positionOn(leftAt.AddDate(0, 0, -1)); position != nil {
+					count(position, func(m *Metrics) { m.Leavers++ })
+				}
+			}
+		}
+
+		month.Groups = lo.Map(lo.Values(groups), func(group *Group, _ int) Group {
+			return *group
+		})
+		slices.SortFunc(month.Groups, func(a, b Group) int {
+			if c := strings.Compare(a.Department, b.Department); c != 0 {
+				return c
+			}
+			return int(a.DepartmentID - b.DepartmentID)
+		})
+		months = append(months, month)
+	}
+	return months
+}
+
+// TerminationDay returns the last day of a terminated employee. Employees
+// terminated before termination dates were recorded leave on the day they
+// were deleted.
+func TerminationDay(employeeInfo *models.EmployeeInfo) *time.Time {
+	if employeeInfo.TerminatedAt != nil {
+		return lo.ToPtr(Day(*employeeInfo.TerminatedAt))
+	}
+	if employeeInfo.DeleteAt.Valid {
+		return lo.ToPtr(Day(employeeInfo.DeleteAt.Time))
+	}
+	return nil
+}
+
+// Day returns the calendar day of t, at midnight UTC.
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// FirstDayOfMonth returns the first day of the month of t.
+func FirstDayOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type employee struct {
+	// positions are ordered by start date
+	positions    []*models.EmployeePosition
+	terminatedAt *time.Time
+}
+
+// activeAfter returns the position of the employee at the end of the day,
+// nil before their first position or once terminated.
+func (e employee) activeAfter(day time.Time) *models.EmployeePosition {
+	if e.terminatedAt != nil && !day.Before(*e.terminatedAt) {
+		return nil
+	}
+	return e.positionOn(day)
+}
+
+// positionOn returns the position of the employee on the day, nil before
+// their first position.
+func (e employee) positionOn(day time.Time) *models.EmployeePosition {
+	var current *models.EmployeePosition
+	for _, position := range e.positions {
+		if Day(position.StartDate).After(day) {
+			break
+		}
+		current = position
+	}
+	return current
+}
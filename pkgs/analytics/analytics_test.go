@@ -0,0 +1,99 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/gorm"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func position(employeeID int64, departmentID int64, department string, startDate time.Time) *models.EmployeePosition {
+	return &models.EmployeePosition{
+		EmployeeID:   employeeID,
+		DepartmentID: departmentID,
+		Department:   department,
+		StartDate:    startDate,
+	}
+}
+
+func TestHeadcount(t *testing.T) {
+	Convey("Given employees hired, moved and terminated over two months", t, func() {
+		from := date(2025, 6, 1)
+		to := date(2025, 7, 31)
+
+		employeeInfos := []*models.EmployeeInfo{
+			// In engineering all along
+			{ID: 1},
+			// Moves to sales in July
+			{ID: 2},
+			// Hired in June, leaves in July
+			{ID: 3, TerminatedAt: lo.ToPtr(date(2025, 7, 15))},
+			// Left before the timestamps of the terminations were recorded
+			{ID: 4, DeleteAt: gorm.DeletedAt{Time: time.Date(2025, 6, 30, 18, 0, 0, 0, time.UTC), Valid: true}},
+			// Hired in August
+			{ID: 5},
+		}
+		positions := []*models.EmployeePosition{
+			position(1, 1, "Engineering", date(2024, 1, 1)),
+			position(2, 1, "Engineering", date(2025, 3, 1)),
+			position(2, 2, "Sales", date(2025, 7, 1)),
+			position(3, 2, "Sales", date(2025, 6, 10)),
+			position(4, 1, "Engineering", date(2024, 6, 1)),
+			position(5, 1, "Engineering", date(2025, 8, 1)),
+		}
+
+		Convey("The months should be counted company wide", func() {
+			months := Headcount(from, to, employeeInfos, positions, "")
+
+			So(months, ShouldHaveLength, 2)
+			So(months[0].Start, ShouldEqual, date(2025, 6, 1))
+			So(months[0].Metrics, ShouldResemble, Metrics{OpeningHeadcount: 3, Headcount: 3, Hires: 1, Leavers: 1})
+			So(months[0].Groups, ShouldBeEmpty)
+			So(months[1].Metrics, ShouldResemble, Metrics{OpeningHeadcount: 3, Headcount: 2, Hires: 0, Leavers: 1})
+		})
+
+		Convey("The months should be split by department", func() {
+			months := Headcount(from, to, employeeInfos, positions, GroupByDepartment)
+
+			So(months[1].Groups, ShouldResemble, []Group{
+				{DepartmentID: 1, Department: "Engineering", Metrics: Metrics{OpeningHeadcount: 2, Headcount: 1}},
+				{DepartmentID: 2, Department: "Sales", Metrics: Metrics{OpeningHeadcount: 1, Headcount: 1, Leavers: 1}},
+			})
+		})
+
+		Convey("An employee leaving on their first day should not count", func() {
+			infos := []*models.EmployeeInfo{{ID: 6, TerminatedAt: lo.ToPtr(date(2025, 6, 10))}}
+			months := Headcount(from, to, infos, []*models.EmployeePosition{position(6, 1, "Engineering", date(2025, 6, 10))}, "")
+
+			So(months[0].Metrics, ShouldResemble, Metrics{})
+		})
+
+		Convey("Employees not listed should be left out", func() {
+			months := Headcount(from, to, employeeInfos[:1], positions, "")
+
+			So(months[0].Metrics, ShouldResemble, Metrics{OpeningHeadcount: 1, Headcount: 1})
+		})
+	})
+}
+
+func TestAttritionRate(t *testing.T) {
+	Convey("The leavers should be a percentage of the average headcount", t, func() {
+		So(Metrics{OpeningHeadcount: 3, Headcount: 2, Leavers: 1}.AttritionRate(), ShouldEqual, 40)
+		So(Metrics{OpeningHeadcount: 3, Headcount: 3, Leavers: 1}.AttritionRate(), ShouldEqual, 33.33)
+		So(Metrics{}.AttritionRate(), ShouldEqual, 0)
+	})
+}
+
+func TestWindowStart(t *testing.T) {
+	Convey("The window should open on the last day of the month before", t, func() {
+		So(WindowStart(date(2025, 3, 1)), ShouldEqual, date(2025, 2, 28))
+		So(WindowStart(date(2025, 3, 15)), ShouldEqual, date(2025, 2, 28))
+	})
+}
@@ -0,0 +1,40 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewHeadcountV1Response renders the months of a headcount report.
+func NewHeadcountV1Response(from, to time.Time, groupBy string, months []Month) dtos.HeadcountV1Response {
+	return dtos.HeadcountV1Response{
+		From:    from.Format(MonthLayout),
+		To:      to.Format(MonthLayout),
+		GroupBy: groupBy,
+		Items: lo.Map(months, func(month Month, _ int) dtos.HeadcountMonthV1Response {
+			return dtos.HeadcountMonthV1Response{
+				Month:            month.Start.Format(MonthLayout),
+				OpeningHeadcount: month.OpeningHeadcount,
+				Headcount:        month.Headcount,
+				Hires:            month.Hires,
+				Leavers:          month.Leavers,
+				AttritionRate:    month.AttritionRate(),
+				Groups: lo.Map(month.Groups, func(group Group, _ int) dtos.HeadcountGroupV1Response {
+					return dtos.HeadcountGroupV1Response{
+						DepartmentID:     group.DepartmentID,
+						Department:       group.Department,
+						OpeningHeadcount: group.OpeningHeadcount,
+						Headcount:        group.Headcount,
+						Hires:            group.Hires,
+						Leavers:          group.Leavers,
+						AttritionRate:    group.AttritionRate(),
+					}
+				}),
+			}
+		}),
+	}
+}
@@ -0,0 +1,68 @@
+// Package approval decides who may review the requests of an employee.
+package approval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type EmployeePositionRepo interface {
+	GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error)
+}
+
+type DepartmentRepo interface {
+	Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// IsManager reports whether managerID heads the current department of the
+// employee or one of its parent departments. Nobody manages themself.
+func IsManager(
+	ctx context.Context,
+	tx *gorm.DB,
+	employeePositionRepo EmployeePositionRepo,
+	departmentRepo DepartmentRepo,
+	employeeID int64,
+	managerID int64,
+	nowTime time.Time,
+) (bool, error) {
+	if managerID == employeeID {
+		return false, nil
+	}
+
+	position, err := employeePositionRepo.GetCurrentByEmployeeID(ctx, tx, employeeID, nowTime)
+	if err != nil {
+		return false, fmt.Errorf("failed to get employee position: %w", err)
+	}
+	if position == nil {
+		return false, nil
+	}
+
+	// The visited set guards against a corrupted parent chain
+	visited := map[int64]bool{}
+	departmentID := &position.DepartmentID
+	for departmentID != nil && !visited[*departmentID] {
+		visited[*departmentID] = true
+
+		department, err := departmentRepo.Get(ctx, tx, *departmentID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get department: %w", err)
+		}
+		if department == nil {
+			break
+		}
+		if department.HeadEmployeeID != nil && *department.HeadEmployeeID == managerID {
+			return true, nil
+		}
+		departmentID = department.ParentID
+	}
+
+	return false, nil
+}
@@ -0,0 +1,65 @@
+package approval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type positions map[int64]*models.EmployeePosition
+
+func (p positions) GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) (*models.EmployeePosition, error) {
+	return p[employeeID], nil
+}
+
+type departments map[int64]*models.Department
+
+func (d departments) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	return d[id], nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestIsManager(t *testing.T) {
+	Convey("Given an employee in a team of a division", t, func() {
+		ctx := t.Context()
+		nowTime := time.Date(2025, 5, 5, 9, 0, 0, 0, time.UTC)
+		employeePositions := positions{
+			1: {EmployeeID: 1, DepartmentID: 10},
+		}
+		hierarchy := departments{
+			10: {ID: 10, ParentID: lo.ToPtr(int64(1)), HeadEmployeeID: lo.ToPtr(int64(7))},
+			1:  {ID: 1, HeadEmployeeID: lo.ToPtr(int64(8))},
+		}
+
+		isManager := func(employeeID, managerID int64) bool {
+			ok, err := IsManager(ctx, nil, employeePositions, hierarchy, employeeID, managerID, nowTime)
+			So(err, ShouldBeNil)
+			return ok
+		}
+
+		Convey("The heads of the team and of the division should manage the employee", func() {
+			So(isManager(1, 7), ShouldBeTrue)
+			So(isManager(1, 8), ShouldBeTrue)
+		})
+
+		Convey("Anybody else should not", func() {
+			So(isManager(1, 9), ShouldBeFalse)
+			So(isManager(1, 1), ShouldBeFalse)
+			// Without a current position
+			So(isManager(2, 7), ShouldBeFalse)
+		})
+
+		Convey("A cycle in the hierarchy should not loop forever", func() {
+			hierarchy[1].ParentID = lo.ToPtr(int64(10))
+			So(isManager(1, 9), ShouldBeFalse)
+		})
+	})
+}
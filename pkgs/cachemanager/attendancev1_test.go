@@ -22,6 +22,7 @@ func TestAttendanceV1(t *testing.T) {
 				PositionID:   int64(456),
 				ClockInTime:  "2023-06-15 09:00:00",
 				ClockOutTime: "2023-06-15 17:00:00",
+				Status:       "closed",
 			}
 
 			// Clean up before testing to ensure consistent state
@@ -47,6 +48,7 @@ func TestAttendanceV1(t *testing.T) {
 						So(cachedData.PositionID, ShouldEqual, attendanceData.PositionID)
 						So(cachedData.ClockInTime, ShouldEqual, attendanceData.ClockInTime)
 						So(cachedData.ClockOutTime, ShouldEqual, attendanceData.ClockOutTime)
+						So(cachedData.Status, ShouldEqual, attendanceData.Status)
 					})
 				})
 			})
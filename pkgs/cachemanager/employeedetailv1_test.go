@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -29,7 +30,7 @@ func TestEmployeeDetailV1(t *testing.T) {
 				PositionID: 456,
 				Position:   "Developer",
 				Department: "Engineering",
-				Salary:     75000.00,
+				Salary:     money.New(7500000, "USD"),
 				StartDate:  "2023-01-01T00:00:00Z",
 			}
 
@@ -61,7 +62,7 @@ func TestEmployeeDetailV1(t *testing.T) {
 						So(cachedData.PositionID, ShouldEqual, employeeData.PositionID)
 						So(cachedData.Position, ShouldEqual, employeeData.Position)
 						So(cachedData.Department, ShouldEqual, employeeData.Department)
-						So(cachedData.Salary, ShouldEqual, employeeData.Salary)
+						So(cachedData.Salary, ShouldResemble, employeeData.Salary)
 						So(cachedData.StartDate, ShouldEqual, employeeData.StartDate)
 					})
 				})
@@ -77,7 +78,7 @@ func TestEmployeeDetailV1(t *testing.T) {
 				updatedData.Name = "Jane Smith"
 				updatedData.Age = 32
 				updatedData.Position = "Senior Developer"
-				updatedData.Salary = 85000.00
+				updatedData.Salary = money.New(8500000, "USD")
 
 				// Set the updated data
 				err = s.manager.SetEmployeeDetailV1(ctx, employeeID, updatedData, time.Minute*15)
@@ -95,7 +96,7 @@ func TestEmployeeDetailV1(t *testing.T) {
 						So(cachedData.Name, ShouldEqual, updatedData.Name)
 						So(cachedData.Age, ShouldEqual, updatedData.Age)
 						So(cachedData.Position, ShouldEqual, updatedData.Position)
-						So(cachedData.Salary, ShouldEqual, updatedData.Salary)
+						So(cachedData.Salary, ShouldResemble, updatedData.Salary)
 					})
 				})
 			})
@@ -0,0 +1,44 @@
+package cachemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func headcountV1Key(from, to, groupBy string) (string, error) {
+	return buildCacheFullKey(headcountV1, map[string]any{
+		"from":     from,
+		"to":       to,
+		"group_by": groupBy,
+	})
+}
+
+func (m *manager) SetHeadcountV1(
+	ctx context.Context,
+	from, to, groupBy string,
+	data dtos.HeadcountV1Response,
+	expired time.Duration,
+) error {
+	fullKey, err := headcountV1Key(from, to, groupBy)
+	if err != nil {
+		return err
+	}
+
+	return setItem(m.redisClient, ctx, fullKey, data, expired)
+}
+
+func (m *manager) GetHeadcountV1(
+	ctx context.Context,
+	from, to, groupBy string,
+) (*dtos.HeadcountV1Response, error) {
+	fullKey, err := headcountV1Key(from, to, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return getItem[dtos.HeadcountV1Response](m.redisClient, ctx, fullKey, false)
+}
@@ -0,0 +1,64 @@
+package cachemanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestHeadcountV1(t *testing.T) {
+	testInit(t, func(s *testSuite) {
+		Convey("Given a headcount cache manager", t, func() {
+			ctx := t.Context()
+
+			headcountData := dtos.HeadcountV1Response{
+				From:    "2025-01",
+				To:      "2025-02",
+				GroupBy: "department",
+				Items: []dtos.HeadcountMonthV1Response{
+					{
+						Month:     "2025-01",
+						Headcount: 2,
+						Hires:     2,
+						Groups: []dtos.HeadcountGroupV1Response{
+							{DepartmentID: 1, Department: "Engineering", Headcount: 2, Hires: 2},
+						},
+					},
+					{Month: "2025-02", OpeningHeadcount: 2, Headcount: 1, Leavers: 1, AttritionRate: 66.67},
+				},
+			}
+
+			// Clean up before testing to ensure consistent state, reports are
+			// only ever evicted by their TTL
+			for _, groupBy := range []string{"department", ""} {
+				fullKey, err := headcountV1Key("2025-01", "2025-02", groupBy)
+				So(err, ShouldBeNil)
+				_ = s.manager.redisClient.Del(ctx, fullKey).Err()
+			}
+
+			Convey("When setting headcount cache", func() {
+				err := s.manager.SetHeadcountV1(ctx, "2025-01", "2025-02", "department", headcountData, time.Minute)
+				So(err, ShouldBeNil)
+
+				Convey("Then the same query should hit the cache", func() {
+					cachedData, err := s.manager.GetHeadcountV1(ctx, "2025-01", "2025-02", "department")
+					So(err, ShouldBeNil)
+					So(cachedData, ShouldNotBeNil)
+					So(cachedData.Items, ShouldHaveLength, 2)
+					So(cachedData.Items[0].Groups, ShouldResemble, headcountData.Items[0].Groups)
+					So(cachedData.Items[1].AttritionRate, ShouldEqual, 66.67)
+				})
+
+				Convey("Then another grouping should miss the cache", func() {
+					cachedData, err := s.manager.GetHeadcountV1(ctx, "2025-01", "2025-02", "")
+					So(err, ShouldBeNil)
+					So(cachedData, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
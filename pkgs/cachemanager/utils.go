@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/gob"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,8 +18,10 @@ import (
 type cacheMainKey string
 
 const (
-	employeeDetailV1 cacheMainKey = "employee_detail_v1"
+	// The salary became a money.Money in employee_detail_v1.1
+	employeeDetailV1 cacheMainKey = "employee_detail_v1.1"
 	attendanceV1     cacheMainKey = "attendance_v1"
+	headcountV1      cacheMainKey = "headcount_v1"
 )
 
 func buildCacheFullKey(k cacheMainKey, pairs map[string]any) (string, error) {
@@ -46,6 +49,8 @@ func buildCacheFullKey(k cacheMainKey, pairs map[string]any) (string, error) {
 	if len(intergatedPairs) == 0 {
 		return "", fmt.Errorf("no valid pairs found")
 	}
+	// Maps are iterated in random order, a key must not depend on it
+	slices.Sort(intergatedPairs)
 
 	return "[" + string(k) + "]" + strings.Join(intergatedPairs, ":"), nil
 
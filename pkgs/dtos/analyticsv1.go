@@ -0,0 +1,30 @@
+package dtos
+
+type HeadcountV1Response struct {
+	// From and To are the first and last months, as YYYY-MM
+	From    string                     `json:"from"`
+	To      string                     `json:"to"`
+	GroupBy string                     `json:"group_by"`
+	Items   []HeadcountMonthV1Response `json:"items"`
+}
+
+type HeadcountMonthV1Response struct {
+	Month            string  `json:"month"`
+	OpeningHeadcount int     `json:"opening_headcount"`
+	Headcount        int     `json:"headcount"`
+	Hires            int     `json:"hires"`
+	Leavers          int     `json:"leavers"`
+	AttritionRate    float64 `json:"attrition_rate"`
+	// Groups are left out unless grouped, ordered by department name
+	Groups []HeadcountGroupV1Response `json:"groups,omitempty"`
+}
+
+type HeadcountGroupV1Response struct {
+	DepartmentID     int64   `json:"department_id"`
+	Department       string  `json:"department"`
+	OpeningHeadcount int     `json:"opening_headcount"`
+	Headcount        int     `json:"headcount"`
+	Hires            int     `json:"hires"`
+	Leavers          int     `json:"leavers"`
+	AttritionRate    float64 `json:"attrition_rate"`
+}
@@ -0,0 +1,26 @@
+package dtos
+
+type AttendanceCorrectionV1Response struct {
+	CorrectionID int64 `json:"correction_id"`
+	EmployeeID   int64 `json:"employee_id"`
+	// Kind is add for a missing session or change for an existing one
+	Kind         string `json:"kind"`
+	AttendanceID *int64 `json:"attendance_id"`
+	ClockInTime  string `json:"clock_in_time"`
+	ClockOutTime string `json:"clock_out_time"`
+	Reason       string `json:"reason"`
+	Status       string `json:"status"`
+	ReviewerID   *int64 `json:"reviewer_id"`
+	ReviewNote   string `json:"review_note"`
+	ReviewedAt   string `json:"reviewed_at"`
+	CreatedAt    string `json:"created_at"`
+	// Original holds the values an approved change replaced
+	Original *AttendanceRevisionV1Response `json:"original,omitempty"`
+}
+
+type AttendanceRevisionV1Response struct {
+	ClockInTime  string `json:"clock_in_time"`
+	ClockOutTime string `json:"clock_out_time"`
+	Status       string `json:"status"`
+	RevisedAt    string `json:"revised_at"`
+}
@@ -5,4 +5,18 @@ type AttendanceV1Response struct {
 	PositionID   int64  `json:"position_id"`
 	ClockInTime  string `json:"clock_in_time"`
 	ClockOutTime string `json:"clock_out_time"`
+	Status       string `json:"status"`
+}
+
+type AttendanceSessionV1Response struct {
+	AttendanceID int64  `json:"attendance_id"`
+	PositionID   int64  `json:"position_id"`
+	Position     string `json:"position"`
+	Department   string `json:"department"`
+	ClockInTime  string `json:"clock_in_time"`
+	ClockOutTime string `json:"clock_out_time"`
+	Status       string `json:"status"`
+	// DurationSeconds runs up to now while the session is open
+	DurationSeconds int64 `json:"duration_seconds"`
+	Open            bool  `json:"open"`
 }
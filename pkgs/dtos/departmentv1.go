@@ -0,0 +1,10 @@
+package dtos
+
+type DepartmentV1Response struct {
+	DepartmentID   int64  `json:"department_id"`
+	Name           string `json:"name"`
+	ParentID       *int64 `json:"parent_id"`
+	HeadEmployeeID *int64 `json:"head_employee_id"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
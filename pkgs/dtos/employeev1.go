@@ -1,5 +1,7 @@
 package dtos
 
+import "github.com/WangWilly/labs-hr-go/pkgs/money"
+
 type EmployeeV1Response struct {
 	EmployeeID int64  `json:"employee_id"`
 	Name       string `json:"name"`
@@ -7,12 +9,15 @@ type EmployeeV1Response struct {
 	Phone      string `json:"phone"`
 	Email      string `json:"email"`
 	Address    string `json:"address"`
+	TimeZone   string `json:"time_zone"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
+	Version    int64  `json:"version"`
 
-	PositionID int64   `json:"position_id"`
-	Position   string  `json:"position"`
-	Department string  `json:"department"`
-	Salary     float64 `json:"salary"`
-	StartDate  string  `json:"start_date"`
+	PositionID   int64       `json:"position_id"`
+	Position     string      `json:"position"`
+	DepartmentID int64       `json:"department_id"`
+	Department   string      `json:"department"`
+	Salary       money.Money `json:"salary"`
+	StartDate    string      `json:"start_date"`
 }
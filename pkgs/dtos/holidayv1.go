@@ -0,0 +1,32 @@
+package dtos
+
+type HolidayCalendarV1Response struct {
+	CalendarID  int64  `json:"calendar_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type HolidayV1Response struct {
+	HolidayID  int64  `json:"holiday_id"`
+	CalendarID int64  `json:"calendar_id"`
+	Date       string `json:"date"`
+	Name       string `json:"name"`
+	// UID is the UID of the imported iCalendar event, empty when added by hand
+	UID string `json:"uid"`
+}
+
+type HolidayCalendarAssignmentV1Response struct {
+	AssignmentID int64  `json:"assignment_id"`
+	CalendarID   int64  `json:"calendar_id"`
+	EmployeeID   *int64 `json:"employee_id"`
+	DepartmentID *int64 `json:"department_id"`
+}
+
+type HolidaysV1Response struct {
+	Start string `json:"start"`
+	// End is the last day of the range, inclusive
+	End   string              `json:"end"`
+	Items []HolidayV1Response `json:"items"`
+}
@@ -0,0 +1,44 @@
+package dtos
+
+type LeaveTypeV1Response struct {
+	LeaveTypeID         int64   `json:"leave_type_id"`
+	Code                string  `json:"code"`
+	Name                string  `json:"name"`
+	Paid                bool    `json:"paid"`
+	TracksBalance       bool    `json:"tracks_balance"`
+	AccrualDaysPerMonth float64 `json:"accrual_days_per_month"`
+	// MaxBalanceDays is zero for no cap
+	MaxBalanceDays float64 `json:"max_balance_days"`
+}
+
+type LeaveBalanceV1Response struct {
+	LeaveTypeID int64   `json:"leave_type_id"`
+	Code        string  `json:"code"`
+	BalanceDays float64 `json:"balance_days"`
+	// PendingDays are requested but not reviewed yet
+	PendingDays   float64 `json:"pending_days"`
+	AvailableDays float64 `json:"available_days"`
+	// NextAccrual is the day the next accrual is credited on
+	NextAccrual string `json:"next_accrual"`
+}
+
+type LeaveBalancesV1Response struct {
+	EmployeeID int64                    `json:"employee_id"`
+	Items      []LeaveBalanceV1Response `json:"items"`
+}
+
+type LeaveRequestV1Response struct {
+	LeaveRequestID int64 `json:"leave_request_id"`
+	EmployeeID     int64 `json:"employee_id"`
+	LeaveTypeID    int64 `json:"leave_type_id"`
+	// StartDate and EndDate are inclusive
+	StartDate  string  `json:"start_date"`
+	EndDate    string  `json:"end_date"`
+	Days       float64 `json:"days"`
+	Reason     string  `json:"reason"`
+	Status     string  `json:"status"`
+	ReviewerID *int64  `json:"reviewer_id"`
+	ReviewNote string  `json:"review_note"`
+	ReviewedAt string  `json:"reviewed_at"`
+	CreatedAt  string  `json:"created_at"`
+}
@@ -0,0 +1,51 @@
+package dtos
+
+type ReportingLineV1Response struct {
+	ReportingLineID int64 `json:"reporting_line_id"`
+	EmployeeID      int64 `json:"employee_id"`
+	// ManagerID is null once the employee reports to nobody
+	ManagerID *int64 `json:"manager_id"`
+	StartDate string `json:"start_date"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type OrgChartEmployeeV1Response struct {
+	EmployeeID int64  `json:"employee_id"`
+	Name       string `json:"name"`
+	// Position and Department are empty without a position on the date
+	Position   string `json:"position"`
+	Department string `json:"department"`
+}
+
+type OrgChartNodeV1Response struct {
+	EmployeeID int64  `json:"employee_id"`
+	Name       string `json:"name"`
+	Position   string `json:"position"`
+	Department string `json:"department"`
+	// Reports report to the employee directly, ordered by ID
+	Reports []OrgChartNodeV1Response `json:"reports"`
+}
+
+type OrgChartV1Response struct {
+	AsOf string `json:"as_of"`
+	// Roots report to nobody
+	Roots []OrgChartNodeV1Response `json:"roots"`
+}
+
+type ReportingLineListV1Response struct {
+	EmployeeID int64                     `json:"employee_id"`
+	Items      []ReportingLineV1Response `json:"items"`
+}
+
+type OrgChartReportsV1Response struct {
+	AsOf string                 `json:"as_of"`
+	Tree OrgChartNodeV1Response `json:"tree"`
+}
+
+type OrgChartChainV1Response struct {
+	AsOf       string `json:"as_of"`
+	EmployeeID int64  `json:"employee_id"`
+	// Chain starts with the direct manager and ends at the top of the chart
+	Chain []OrgChartEmployeeV1Response `json:"chain"`
+}
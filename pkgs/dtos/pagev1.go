@@ -0,0 +1,6 @@
+package dtos
+
+type PageV1Response[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor"`
+}
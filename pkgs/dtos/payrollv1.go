@@ -0,0 +1,38 @@
+package dtos
+
+import "github.com/WangWilly/labs-hr-go/pkgs/money"
+
+type PayrollRunV1Response struct {
+	// PayrollRunID is zero for a preview
+	PayrollRunID int64 `json:"payroll_run_id"`
+	// PeriodStart and PeriodEnd are inclusive
+	PeriodStart   string `json:"period_start"`
+	PeriodEnd     string `json:"period_end"`
+	Status        string `json:"status"`
+	EmployeeCount int    `json:"employee_count"`
+	// Totals has an amount per currency paid
+	Totals      []money.Money               `json:"totals"`
+	FinalizedAt string                      `json:"finalized_at"`
+	CreatedAt   string                      `json:"created_at"`
+	Items       []PayrollEmployeeV1Response `json:"items"`
+}
+
+type PayrollEmployeeV1Response struct {
+	EmployeeID int64                   `json:"employee_id"`
+	Name       string                  `json:"name"`
+	Totals     []money.Money           `json:"totals"`
+	Lines      []PayrollLineV1Response `json:"lines"`
+}
+
+type PayrollLineV1Response struct {
+	PositionID   int64  `json:"position_id"`
+	Position     string `json:"position"`
+	DepartmentID int64  `json:"department_id"`
+	Department   string `json:"department"`
+	// StartDate and EndDate are inclusive
+	StartDate     string      `json:"start_date"`
+	EndDate       string      `json:"end_date"`
+	Days          int         `json:"days"`
+	MonthlySalary money.Money `json:"monthly_salary"`
+	Amount        money.Money `json:"amount"`
+}
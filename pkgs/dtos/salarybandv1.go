@@ -0,0 +1,27 @@
+package dtos
+
+import "github.com/WangWilly/labs-hr-go/pkgs/money"
+
+type SalaryBandV1Response struct {
+	SalaryBandID int64       `json:"salary_band_id"`
+	Position     string      `json:"position"`
+	Min          money.Money `json:"min"`
+	Mid          money.Money `json:"mid"`
+	Max          money.Money `json:"max"`
+	// EffectiveDate is the first day of the band
+	EffectiveDate string `json:"effective_date"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// SalaryBandCheckV1Response places a salary in the band of its position.
+type SalaryBandCheckV1Response struct {
+	SalaryBandID int64       `json:"salary_band_id"`
+	Min          money.Money `json:"min"`
+	Mid          money.Money `json:"mid"`
+	Max          money.Money `json:"max"`
+	// CompaRatio is the salary divided by the midpoint of the band
+	CompaRatio float64 `json:"compa_ratio"`
+	// Status is below, within or above the band
+	Status string `json:"status"`
+}
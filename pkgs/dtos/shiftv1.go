@@ -0,0 +1,69 @@
+package dtos
+
+type ShiftV1Response struct {
+	ShiftID int64  `json:"shift_id"`
+	Name    string `json:"name"`
+	// StartTime and EndTime are HH:MM in UTC, an end at or before the start
+	// falls on the next day
+	StartTime    string   `json:"start_time"`
+	EndTime      string   `json:"end_time"`
+	Weekdays     []string `json:"weekdays"`
+	GraceMinutes int      `json:"grace_minutes"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+type ShiftAssignmentV1Response struct {
+	AssignmentID  int64  `json:"assignment_id"`
+	ShiftID       int64  `json:"shift_id"`
+	EmployeeID    *int64 `json:"employee_id"`
+	DepartmentID  *int64 `json:"department_id"`
+	EffectiveFrom string `json:"effective_from"`
+	// EffectiveTo is the inclusive last day, empty for an open-ended assignment
+	EffectiveTo string `json:"effective_to"`
+}
+
+type ShiftReportDayV1Response struct {
+	Date           string `json:"date"`
+	ShiftID        int64  `json:"shift_id"`
+	ShiftName      string `json:"shift_name"`
+	ScheduledStart string `json:"scheduled_start"`
+	ScheduledEnd   string `json:"scheduled_end"`
+	ClockInTime    string `json:"clock_in_time"`
+	// ClockOutTime is empty while a session is still open
+	ClockOutTime string `json:"clock_out_time"`
+	Late         bool   `json:"late"`
+	LateMinutes  int64  `json:"late_minutes"`
+	LeftEarly    bool   `json:"left_early"`
+	EarlyMinutes int64  `json:"early_minutes"`
+	Absent       bool   `json:"absent"`
+	OnLeave      bool   `json:"on_leave"`
+	// Holiday is the name of the public holiday falling on the day, empty on
+	// working days
+	Holiday string `json:"holiday"`
+}
+
+type ShiftReportV1Response struct {
+	EmployeeID int64  `json:"employee_id"`
+	Start      string `json:"start"`
+	// End is the last day of the period, inclusive
+	End            string                     `json:"end"`
+	LateCount      int                        `json:"late_count"`
+	LeftEarlyCount int                        `json:"left_early_count"`
+	AbsentCount    int                        `json:"absent_count"`
+	OnLeaveCount   int                        `json:"on_leave_count"`
+	HolidayCount   int                        `json:"holiday_count"`
+	Days           []ShiftReportDayV1Response `json:"days"`
+}
+
+type DepartmentShiftReportV1Response struct {
+	DepartmentID   int64                   `json:"department_id"`
+	Start          string                  `json:"start"`
+	End            string                  `json:"end"`
+	LateCount      int                     `json:"late_count"`
+	LeftEarlyCount int                     `json:"left_early_count"`
+	AbsentCount    int                     `json:"absent_count"`
+	OnLeaveCount   int                     `json:"on_leave_count"`
+	HolidayCount   int                     `json:"holiday_count"`
+	Employees      []ShiftReportV1Response `json:"employees"`
+}
@@ -0,0 +1,33 @@
+package dtos
+
+type TimesheetDayV1Response struct {
+	Date          string  `json:"date"`
+	WorkedSeconds int64   `json:"worked_seconds"`
+	WorkedHours   float64 `json:"worked_hours"`
+	Sessions      int     `json:"sessions"`
+	// Open is set when a session still open was worked on the day
+	Open bool `json:"open"`
+}
+
+type TimesheetV1Response struct {
+	EmployeeID int64  `json:"employee_id"`
+	Period     string `json:"period"`
+	Start      string `json:"start"`
+	// End is the last day of the period, inclusive
+	End           string                   `json:"end"`
+	WorkedSeconds int64                    `json:"worked_seconds"`
+	WorkedHours   float64                  `json:"worked_hours"`
+	Open          bool                     `json:"open"`
+	Days          []TimesheetDayV1Response `json:"days"`
+}
+
+type DepartmentTimesheetV1Response struct {
+	DepartmentID  int64                 `json:"department_id"`
+	Period        string                `json:"period"`
+	Start         string                `json:"start"`
+	End           string                `json:"end"`
+	WorkedSeconds int64                 `json:"worked_seconds"`
+	WorkedHours   float64               `json:"worked_hours"`
+	Open          bool                  `json:"open"`
+	Employees     []TimesheetV1Response `json:"employees"`
+}
@@ -0,0 +1,55 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const DateLayout = "2006-01-02"
+
+// ForEmployee keeps the holidays of the calendar the employee follows on
+// each day: the calendar assigned to the employee, or else the one of the
+// department of the position held on the day. The positions are ordered by
+// start date.
+func ForEmployee(
+	employeeID int64,
+	positions []*models.EmployeePosition,
+	assignments []*models.HolidayCalendarAssignment,
+	holidays []*models.Holiday,
+) []*models.Holiday {
+	employeeCalendarID := int64(0)
+	departmentCalendarIDs := map[int64]int64{}
+	for _, assignment := range assignments {
+		if assignment.EmployeeID != nil && *assignment.EmployeeID == employeeID {
+			employeeCalendarID = assignment.CalendarID
+		}
+		if assignment.DepartmentID != nil {
+			departmentCalendarIDs[*assignment.DepartmentID] = assignment.CalendarID
+		}
+	}
+
+	return lo.Filter(holidays, func(holiday *models.Holiday, _ int) bool {
+		calendarID := employeeCalendarID
+		if calendarID == 0 {
+			calendarID = departmentCalendarIDs[departmentOn(positions, holiday.Date)]
+		}
+		return calendarID != 0 && holiday.CalendarID == calendarID
+	})
+}
+
+// departmentOn returns the department of the position held on the day, zero
+// before the first position.
+func departmentOn(positions []*models.EmployeePosition, date time.Time) int64 {
+	departmentID := int64(0)
+	for _, position := range positions {
+		if position.StartDate.After(date) {
+			break
+		}
+		departmentID = position.DepartmentID
+	}
+	return departmentID
+}
@@ -0,0 +1,54 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestForEmployee(t *testing.T) {
+	Convey("Given an employee who moved from Taipei to Tokyo in April", t, func() {
+		employeeID := int64(1)
+		positions := []*models.EmployeePosition{
+			{EmployeeID: employeeID, DepartmentID: 10, StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: employeeID, DepartmentID: 20, StartDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		}
+		holidays := []*models.Holiday{
+			{ID: 1, CalendarID: 1, Date: time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC), Name: "Lunar New Year"},
+			{ID: 2, CalendarID: 2, Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year"},
+			{ID: 3, CalendarID: 1, Date: time.Date(2025, 10, 10, 0, 0, 0, 0, time.UTC), Name: "National Day"},
+			{ID: 4, CalendarID: 2, Date: time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), Name: "Children's Day"},
+		}
+
+		Convey("When the departments have their calendars", func() {
+			assignments := []*models.HolidayCalendarAssignment{
+				{CalendarID: 1, DepartmentID: lo.ToPtr(int64(10))},
+				{CalendarID: 2, DepartmentID: lo.ToPtr(int64(20))},
+			}
+			result := ForEmployee(employeeID, positions, assignments, holidays)
+
+			Convey("Then the calendar of the department held on the day should apply", func() {
+				So(lo.Map(result, func(holiday *models.Holiday, _ int) int64 { return holiday.ID }), ShouldResemble, []int64{1, 4})
+			})
+		})
+
+		Convey("When the employee has a calendar of their own", func() {
+			assignments := []*models.HolidayCalendarAssignment{
+				{CalendarID: 1, DepartmentID: lo.ToPtr(int64(20))},
+				{CalendarID: 2, EmployeeID: lo.ToPtr(employeeID)},
+			}
+			result := ForEmployee(employeeID, positions, assignments, holidays)
+
+			Convey("Then it should take precedence", func() {
+				So(lo.Map(result, func(holiday *models.Holiday, _ int) int64 { return holiday.ID }), ShouldResemble, []int64{2, 4})
+			})
+		})
+
+		Convey("When no calendar is assigned", func() {
+			So(ForEmployee(employeeID, positions, nil, holidays), ShouldBeEmpty)
+		})
+	})
+}
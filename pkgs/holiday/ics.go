@@ -0,0 +1,443 @@
+// Package holiday reads holidays from iCalendar files and resolves the
+// holidays of an employee from the calendar assignments.
+package holiday
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// maxLineBytes bounds an unfolded content line
+	maxLineBytes = 64 * 1024
+	// maxOccurrences bounds the expansion of a recurring event
+	maxOccurrences = 1000
+	// maxEventDays bounds the days of an occurrence
+	maxEventDays = 31
+	// maxExpandedDays bounds the days of all the events of a file
+	maxExpandedDays = 20000
+	// maxNameLength is the size of the name and UID columns
+	maxNameLength = 255
+)
+
+var durationRegexp = regexp.MustCompile(`^P(?:(\d+)W|(\d+)D)`)
+
+// Event is a VEVENT of an iCalendar file, reduced to the days it covers.
+type Event struct {
+	// Line is the line of BEGIN:VEVENT in the file
+	Line    int
+	UID     string
+	Summary string
+	// Start is the first day and End the day after the last one
+	Start time.Time
+	End   time.Time
+
+	// Interval is the number of years between occurrences, zero for an event
+	// that does not repeat
+	Interval int
+	// Count bounds the occurrences, zero for no bound
+	Count int
+	// Until is the last day an occurrence may start on, nil for no bound
+	Until *time.Time
+	// ExDates are the starts of the excluded occurrences
+	ExDates []time.Time
+}
+
+// SkippedEvent is an event that cannot be imported.
+type SkippedEvent struct {
+	Line    int
+	UID     string
+	Summary string
+	Reason  string
+}
+
+// ParseICS reads the events of an RFC 5545 iCalendar file. Only the events
+// that are not cancelled, repeat yearly at most and last up to maxEventDays
+// are returned, the others are reported as skipped. It fails when the file is
+// not an iCalendar file.
+func ParseICS(r io.Reader) ([]Event, []SkippedEvent, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0].text, "BEGIN:VCALENDAR") {
+		return nil, nil, errors.New("not an iCalendar file")
+	}
+
+	events := []Event{}
+	skipped := []SkippedEvent{}
+
+	var event *Event
+	var reason string
+	cancelled := false
+	// durationDays stands for DTEND when set, DTSTART may come after it
+	durationDays := 0
+	// components holds the names of the components being read
+	components := []string{}
+	for _, line := range lines {
+		name, value := splitLine(line.text)
+
+		switch name {
+		case "BEGIN":
+			components = append(components, strings.ToUpper(value))
+			if len(components) == 2 && components[1] == "VEVENT" {
+				event = &Event{Line: line.number}
+				reason = ""
+				cancelled = false
+				durationDays = 0
+			}
+			continue
+		case "END":
+			if len(components) == 0 {
+				return nil, nil, fmt.Errorf("line %d: unexpected END:%s", line.number, value)
+			}
+			if len(components) == 2 && event != nil {
+				if reason == "" && event.Start.IsZero() {
+					reason = "missing DTSTART"
+				}
+				if reason == "" {
+					reason = resolveEnd(event, durationDays)
+				}
+				switch {
+				case cancelled:
+				case reason != "":
+					skipped = append(skipped, SkippedEvent{
+						Line:    event.Line,
+						UID:     event.UID,
+						Summary: event.Summary,
+						Reason:  reason,
+					})
+				default:
+					events = append(events, *event)
+				}
+				event = nil
+			}
+			components = components[:len(components)-1]
+			continue
+		}
+
+		// Alarms and other components nested in the event are not read
+		if event == nil || len(components) != 2 || reason != "" {
+			continue
+		}
+
+		switch name {
+		case "UID":
+			event.UID = value
+		case "SUMMARY":
+			event.Summary = unescape(value)
+		case "STATUS":
+			cancelled = strings.EqualFold(value, "CANCELLED")
+		case "DTSTART":
+			event.Start, err = parseDate(value)
+			if err != nil {
+				reason = "invalid DTSTART"
+			}
+		case "DTEND":
+			event.End, err = parseEndDate(value)
+			if err != nil {
+				reason = "invalid DTEND"
+			}
+		case "DURATION":
+			durationDays, err = parseDuration(value)
+			if err != nil {
+				reason = "unsupported DURATION"
+			}
+		case "RRULE":
+			if err := parseRule(event, value); err != nil {
+				reason = err.Error()
+			}
+		case "EXDATE":
+			for _, part := range strings.Split(value, ",") {
+				date, err := parseDate(part)
+				if err != nil {
+					reason = "invalid EXDATE"
+					break
+				}
+				event.ExDates = append(event.ExDates, date)
+			}
+		case "RDATE":
+			reason = "unsupported RDATE"
+		}
+	}
+
+	if len(components) != 0 {
+		return nil, nil, errors.New("unterminated iCalendar file")
+	}
+
+	return events, skipped, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Expand lists the holidays of the events, one per day. Yearly events
+// without a bound repeat up to horizon. The names of the events falling on
+// the same day are joined. It fails when the events cover more than
+// maxExpandedDays days.
+func Expand(calendarID int64, events []Event, horizon time.Time) ([]*models.Holiday, error) {
+	byDate := map[time.Time]*models.Holiday{}
+	expanded := 0
+	for _, event := range events {
+		days := event.Days(horizon)
+		expanded += len(days)
+		if expanded > maxExpandedDays {
+			return nil, fmt.Errorf("too many holidays, at most %d days are allowed", maxExpandedDays)
+		}
+		for _, day := range days {
+			holiday, ok := byDate[day]
+			if !ok {
+				byDate[day] = &models.Holiday{
+					CalendarID: calendarID,
+					Date:       day,
+					Name:       event.Summary,
+					UID:        event.UID,
+				}
+				continue
+			}
+			switch {
+			case holiday.Name == "":
+				holiday.Name = event.Summary
+			case event.Summary != "" && !strings.Contains(holiday.Name, event.Summary):
+				holiday.Name += " / " + event.Summary
+			}
+		}
+	}
+
+	holidays := make([]*models.Holiday, 0, len(byDate))
+	for _, holiday := range byDate {
+		holiday.Name = truncate(holiday.Name, maxNameLength)
+		holiday.UID = truncate(holiday.UID, maxNameLength)
+		holidays = append(holidays, holiday)
+	}
+	slices.SortFunc(holidays, func(a, b *models.Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return holidays, nil
+}
+
+// Days lists the days covered by the occurrences of the event.
+func (e *Event) Days(horizon time.Time) []time.Time {
+	length := int(e.End.Sub(e.Start).Hours() / 24)
+
+	days := []time.Time{}
+	occurrences := 0
+	for i := 0; i < maxOccurrences; i++ {
+		if e.Interval == 0 && i > 0 {
+			break
+		}
+		start := e.Start.AddDate(i*e.Interval, 0, 0)
+		if e.Count > 0 && occurrences >= e.Count {
+			break
+		}
+		if e.Until != nil && start.After(*e.Until) {
+			break
+		}
+		if e.Interval > 0 && e.Count == 0 && e.Until == nil && start.After(horizon) {
+			break
+		}
+		// A yearly event on February 29 only occurs on leap years
+		if start.Day() != e.Start.Day() {
+			continue
+		}
+
+		occurrences++
+		if slices.ContainsFunc(e.ExDates, start.Equal) {
+			continue
+		}
+		for day := 0; day < length; day++ {
+			days = append(days, start.AddDate(0, 0, day))
+		}
+	}
+
+	return days
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// resolveEnd sets the end of the event from its DURATION or DTEND, an event
+// without either lasting a day. It returns why the event is skipped when it
+// lasts too long.
+func resolveEnd(event *Event, durationDays int) string {
+	if durationDays > maxEventDays {
+		return fmt.Sprintf("event longer than %d days", maxEventDays)
+	}
+	if durationDays > 0 {
+		event.End = event.Start.AddDate(0, 0, durationDays)
+	}
+	if !event.End.After(event.Start) {
+		event.End = event.Start.AddDate(0, 0, 1)
+	}
+	if event.End.After(event.Start.AddDate(0, 0, maxEventDays)) {
+		return fmt.Sprintf("event longer than %d days", maxEventDays)
+	}
+	return ""
+}
+
+type contentLine struct {
+	number int
+	text   string
+}
+
+// unfold joins the content lines folded over several lines of the file.
+func unfold(r io.Reader) ([]contentLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineBytes)
+
+	lines := []contentLine{}
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if number == 1 {
+			text = strings.TrimPrefix(text, "\uFEFF")
+		}
+		if text == "" {
+			continue
+		}
+		if (text[0] == ' ' || text[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1].text += text[1:]
+			continue
+		}
+		lines = append(lines, contentLine{number: number, text: text})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read iCalendar file: %w", err)
+	}
+
+	return lines, nil
+}
+
+// splitLine splits a content line into its upper case name and its value,
+// dropping the parameters. The value starts at the first colon outside of
+// quotes.
+func splitLine(text string) (string, string) {
+	quoted := false
+	for i, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			name, _, _ := strings.Cut(text[:i], ";")
+			return strings.ToUpper(name), text[i+1:]
+		}
+	}
+	return strings.ToUpper(text), ""
+}
+
+// truncate cuts the text to at most n runes.
+func truncate(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return string(runes[:n])
+}
+
+// unescape decodes a TEXT value.
+func unescape(value string) string {
+	replacer := strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, " ", `\N`, " ")
+	return strings.TrimSpace(replacer.Replace(value))
+}
+
+// parseDate reads the day of a DATE or DATE-TIME value as written, the time
+// zone is not applied.
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+// parseEndDate reads the exclusive end of an event. An end within a day
+// still covers that day.
+func parseEndDate(value string) (time.Time, error) {
+	date, err := parseDate(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	clock := strings.TrimSuffix(strings.TrimSpace(value)[8:], "Z")
+	if clock != "" && clock != "T000000" {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date, nil
+}
+
+// parseDuration reads a DURATION in whole days or weeks.
+func parseDuration(value string) (int, error) {
+	match := durationRegexp.FindStringSubmatch(strings.TrimPrefix(value, "+"))
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	if match[1] != "" {
+		weeks, _ := strconv.Atoi(match[1])
+		return weeks * 7, nil
+	}
+	days, _ := strconv.Atoi(match[2])
+	return days, nil
+}
+
+// parseRule reads a yearly RRULE into the event.
+func parseRule(event *Event, value string) error {
+	event.Interval = 1
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			if !strings.EqualFold(val, "YEARLY") {
+				return fmt.Errorf("unsupported RRULE frequency %s", val)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return errors.New("invalid RRULE interval")
+			}
+			event.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return errors.New("invalid RRULE count")
+			}
+			event.Count = count
+		case "UNTIL":
+			until, err := parseDate(val)
+			if err != nil {
+				return errors.New("invalid RRULE until")
+			}
+			event.Until = &until
+		case "BYMONTH", "BYMONTHDAY":
+			// Only the month and day of DTSTART are supported
+			if !matchesStart(event, key, val) {
+				return fmt.Errorf("unsupported RRULE %s", strings.ToUpper(key))
+			}
+		case "WKST":
+		default:
+			return fmt.Errorf("unsupported RRULE %s", strings.ToUpper(key))
+		}
+	}
+	return nil
+}
+
+// matchesStart reports whether a BYMONTH or BYMONTHDAY rule part only
+// repeats the month or day of DTSTART.
+func matchesStart(event *Event, key string, value string) bool {
+	number, err := strconv.Atoi(value)
+	if err != nil || event.Start.IsZero() {
+		return false
+	}
+	if strings.EqualFold(key, "BYMONTH") {
+		return number == int(event.Start.Month())
+	}
+	return number == event.Start.Day()
+}
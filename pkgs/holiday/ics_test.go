@@ -0,0 +1,193 @@
+package holiday
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const sampleICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//Holidays//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Asia/Taipei\r\n" +
+	"END:VTIMEZONE\r\n" +
+	// A folded and escaped summary, with an alarm
+	"BEGIN:VEVENT\r\n" +
+	"UID:new-year@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20250101\r\n" +
+	"DTEND;VALUE=DATE:20250102\r\n" +
+	"SUMMARY:New Year\\, the first\r\n" +
+	"  day\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"SUMMARY:Reminder\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	// Three days
+	"BEGIN:VEVENT\r\n" +
+	"UID:lunar@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20250128\r\n" +
+	"DURATION:P3D\r\n" +
+	"SUMMARY:Lunar New Year\r\n" +
+	"END:VEVENT\r\n" +
+	// Yearly, twice
+	"BEGIN:VEVENT\r\n" +
+	"UID:labour@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20250501\r\n" +
+	"RRULE:FREQ=YEARLY;COUNT=2\r\n" +
+	"SUMMARY:Labour Day\r\n" +
+	"END:VEVENT\r\n" +
+	// Yearly without bound, but in 2026
+	"BEGIN:VEVENT\r\n" +
+	"UID:national@example.com\r\n" +
+	"DTSTART;TZID=Asia/Taipei:20251010T000000\r\n" +
+	"DTEND;TZID=Asia/Taipei:20251010T235900\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=10\r\n" +
+	"EXDATE;VALUE=DATE:20261010\r\n" +
+	"SUMMARY:National Day\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cancelled@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20250602\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"SUMMARY:Cancelled\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:thanksgiving@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20251127\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH\r\n" +
+	"SUMMARY:Thanksgiving\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICS(t *testing.T) {
+	Convey("Given an iCalendar file", t, func() {
+		events, skipped, err := ParseICS(strings.NewReader(sampleICS))
+		So(err, ShouldBeNil)
+
+		Convey("Then the supported events should be read", func() {
+			So(events, ShouldHaveLength, 4)
+
+			So(events[0].UID, ShouldEqual, "new-year@example.com")
+			So(events[0].Summary, ShouldEqual, "New Year, the first day")
+			So(events[0].Start, ShouldEqual, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			So(events[0].End, ShouldEqual, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+
+			So(events[1].End, ShouldEqual, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC))
+
+			So(events[2].Interval, ShouldEqual, 1)
+			So(events[2].Count, ShouldEqual, 2)
+
+			So(events[3].End, ShouldEqual, time.Date(2025, 10, 11, 0, 0, 0, 0, time.UTC))
+			So(events[3].ExDates, ShouldHaveLength, 1)
+		})
+
+		Convey("Then the unsupported rules should be skipped", func() {
+			So(skipped, ShouldHaveLength, 1)
+			So(skipped[0].UID, ShouldEqual, "thanksgiving@example.com")
+			So(skipped[0].Reason, ShouldEqual, "unsupported RRULE BYDAY")
+			So(skipped[0].Line, ShouldEqual, 43)
+		})
+
+		Convey("When expanding the events up to 2027", func() {
+			horizon := time.Date(2027, 12, 31, 0, 0, 0, 0, time.UTC)
+			holidays, err := Expand(1, events, horizon)
+			So(err, ShouldBeNil)
+
+			dates := make([]string, 0, len(holidays))
+			for _, holiday := range holidays {
+				dates = append(dates, holiday.Date.Format(DateLayout))
+			}
+
+			Convey("Then there should be a holiday per day", func() {
+				So(dates, ShouldResemble, []string{
+					"2025-01-01",
+					"2025-01-28", "2025-01-29", "2025-01-30",
+					"2025-05-01",
+					"2025-10-10",
+					"2026-05-01",
+					"2027-10-10",
+				})
+				So(holidays[0].CalendarID, ShouldEqual, 1)
+				So(holidays[0].UID, ShouldEqual, "new-year@example.com")
+			})
+		})
+	})
+
+	Convey("Given two events on the same day", t, func() {
+		events := []Event{
+			{Summary: "Labour Day", Start: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)},
+			{Summary: "Bank Holiday", Start: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)},
+		}
+		holidays, err := Expand(1, events, time.Time{})
+		So(err, ShouldBeNil)
+
+		Convey("Then their names should be joined", func() {
+			So(holidays, ShouldHaveLength, 1)
+			So(holidays[0].Name, ShouldEqual, "Labour Day / Bank Holiday")
+		})
+	})
+
+	Convey("Given a yearly event on February 29", t, func() {
+		event := Event{
+			Start:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			End:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Interval: 1,
+			Count:    2,
+		}
+
+		Convey("Then it should only occur on leap years", func() {
+			days := event.Days(time.Time{})
+			So(days, ShouldHaveLength, 2)
+			So(days[1], ShouldEqual, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC))
+		})
+	})
+
+	Convey("Given an event lasting two centuries", t, func() {
+		events, skipped, err := ParseICS(strings.NewReader("BEGIN:VCALENDAR\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"UID:long@example.com\r\n" +
+			"DTSTART;VALUE=DATE:19000101\r\n" +
+			"DTEND;VALUE=DATE:21000101\r\n" +
+			"RRULE:FREQ=YEARLY;COUNT=1000\r\n" +
+			"END:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"UID:weeks@example.com\r\n" +
+			"DTSTART;VALUE=DATE:20250101\r\n" +
+			"DURATION:P5W\r\n" +
+			"END:VEVENT\r\n" +
+			"END:VCALENDAR\r\n"))
+		So(err, ShouldBeNil)
+
+		Convey("Then it should be skipped", func() {
+			So(events, ShouldBeEmpty)
+			So(skipped, ShouldHaveLength, 2)
+			So(skipped[0].Reason, ShouldEqual, "event longer than 31 days")
+			So(skipped[1].Reason, ShouldEqual, "event longer than 31 days")
+		})
+	})
+
+	Convey("Given events covering too many days", t, func() {
+		event := Event{
+			Start:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:      time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			Interval: 1,
+			Count:    maxOccurrences,
+		}
+
+		Convey("Then the expansion should fail", func() {
+			_, err := Expand(1, []Event{event}, time.Time{})
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given files that are not calendars", t, func() {
+		_, _, err := ParseICS(strings.NewReader("name,date\nNew Year,2025-01-01\n"))
+		So(err, ShouldNotBeNil)
+
+		_, _, err = ParseICS(strings.NewReader("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20250101\n"))
+		So(err, ShouldNotBeNil)
+	})
+}
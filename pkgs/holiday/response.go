@@ -0,0 +1,56 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewCalendarV1Response renders a holiday calendar.
+func NewCalendarV1Response(calendar *models.HolidayCalendar, formatter utils.TimeFormatter) dtos.HolidayCalendarV1Response {
+	return dtos.HolidayCalendarV1Response{
+		CalendarID:  calendar.ID,
+		Name:        calendar.Name,
+		Description: calendar.Description,
+		CreatedAt:   formatter.Time(calendar.CreatedAt),
+		UpdatedAt:   formatter.Time(calendar.UpdatedAt),
+	}
+}
+
+// NewV1Response renders a holiday.
+func NewV1Response(holiday *models.Holiday) dtos.HolidayV1Response {
+	return dtos.HolidayV1Response{
+		HolidayID:  holiday.ID,
+		CalendarID: holiday.CalendarID,
+		Date:       holiday.Date.Format(DateLayout),
+		Name:       holiday.Name,
+		UID:        holiday.UID,
+	}
+}
+
+// NewListV1Response renders the holidays of [from, to).
+func NewListV1Response(from, to time.Time, holidays []*models.Holiday) dtos.HolidaysV1Response {
+	items := make([]dtos.HolidayV1Response, 0, len(holidays))
+	for _, holiday := range holidays {
+		items = append(items, NewV1Response(holiday))
+	}
+	return dtos.HolidaysV1Response{
+		Start: from.Format(DateLayout),
+		End:   to.AddDate(0, 0, -1).Format(DateLayout),
+		Items: items,
+	}
+}
+
+// NewAssignmentV1Response renders a calendar assignment.
+func NewAssignmentV1Response(assignment *models.HolidayCalendarAssignment) dtos.HolidayCalendarAssignmentV1Response {
+	return dtos.HolidayCalendarAssignmentV1Response{
+		AssignmentID: assignment.ID,
+		CalendarID:   assignment.CalendarID,
+		EmployeeID:   assignment.EmployeeID,
+		DepartmentID: assignment.DepartmentID,
+	}
+}
@@ -0,0 +1,67 @@
+// Package leave counts the working days of leave requests and accrues the
+// leave balances. Days are UTC calendar days.
+package leave
+
+import (
+	"math"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const DateLayout = "2006-01-02"
+
+// WorkingDays counts the days from start to end inclusive, weekends excluded.
+func WorkingDays(start, end time.Time) float64 {
+	days := 0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			days++
+		}
+	}
+	return float64(days)
+}
+
+// Today returns the day containing t.
+func Today(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewBalance returns the empty balance of an employee hired on hireDate. The
+// first accrual is credited on the first day of the following month.
+func NewBalance(employeeID int64, leaveTypeID int64, hireDate time.Time) *models.LeaveBalance {
+	hireDate = hireDate.UTC()
+	return &models.LeaveBalance{
+		EmployeeID:     employeeID,
+		LeaveTypeID:    leaveTypeID,
+		AccruedThrough: time.Date(hireDate.Year(), hireDate.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0),
+	}
+}
+
+// Accrue credits the balance for every first day of a month passed by
+// nowTime, up to the cap of the leave type. It reports whether the balance
+// changed.
+func Accrue(balance *models.LeaveBalance, leaveType *models.LeaveType, nowTime time.Time) bool {
+	changed := false
+	for !balance.AccruedThrough.After(nowTime) {
+		days := balance.BalanceDays + leaveType.AccrualDaysPerMonth
+		// An adjustment may have gone past the cap, accrual does not
+		if leaveType.MaxBalanceDays > 0 {
+			days = math.Min(days, math.Max(leaveType.MaxBalanceDays, balance.BalanceDays))
+		}
+		balance.BalanceDays = RoundDays(days)
+		balance.AccruedThrough = balance.AccruedThrough.AddDate(0, 1, 0)
+		changed = true
+	}
+	return changed
+}
+
+// RoundDays rounds to the hundredth of a day, the precision of the columns.
+func RoundDays(days float64) float64 {
+	return math.Round(days*100) / 100
+}
@@ -0,0 +1,57 @@
+package leave
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWorkingDays(t *testing.T) {
+	Convey("Given ranges of days", t, func() {
+		monday := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+
+		Convey("Weekends should not be counted", func() {
+			So(WorkingDays(monday, monday.AddDate(0, 0, 6)), ShouldEqual, 5)
+			So(WorkingDays(monday, monday.AddDate(0, 0, 13)), ShouldEqual, 10)
+		})
+
+		Convey("A single day should count once", func() {
+			So(WorkingDays(monday, monday), ShouldEqual, 1)
+			So(WorkingDays(monday.AddDate(0, 0, 5), monday.AddDate(0, 0, 6)), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestAccrue(t *testing.T) {
+	Convey("Given an employee hired in mid January", t, func() {
+		hireDate := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		annual := &models.LeaveType{ID: 1, AccrualDaysPerMonth: 1.75, MaxBalanceDays: 5}
+		balance := NewBalance(1, annual.ID, hireDate)
+
+		Convey("The first accrual should be credited on the first of February", func() {
+			So(balance.AccruedThrough, ShouldEqual, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+			So(Accrue(balance, annual, time.Date(2025, 1, 31, 23, 0, 0, 0, time.UTC)), ShouldBeFalse)
+			So(balance.BalanceDays, ShouldEqual, 0)
+
+			So(Accrue(balance, annual, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)), ShouldBeTrue)
+			So(balance.BalanceDays, ShouldEqual, 1.75)
+		})
+
+		Convey("Months passed should all be credited up to the cap", func() {
+			Accrue(balance, annual, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC))
+			So(balance.BalanceDays, ShouldEqual, 3.5)
+			So(balance.AccruedThrough, ShouldEqual, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+
+			Accrue(balance, annual, time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC))
+			So(balance.BalanceDays, ShouldEqual, 5)
+		})
+
+		Convey("A balance adjusted past the cap should be kept but not grow", func() {
+			balance.BalanceDays = 8
+			Accrue(balance, annual, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+			So(balance.BalanceDays, ShouldEqual, 8)
+		})
+	})
+}
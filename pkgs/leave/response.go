@@ -0,0 +1,55 @@
+package leave
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewTypeV1Response renders a leave type.
+func NewTypeV1Response(leaveType *models.LeaveType) dtos.LeaveTypeV1Response {
+	return dtos.LeaveTypeV1Response{
+		LeaveTypeID:         leaveType.ID,
+		Code:                leaveType.Code,
+		Name:                leaveType.Name,
+		Paid:                leaveType.Paid,
+		TracksBalance:       leaveType.TracksBalance,
+		AccrualDaysPerMonth: leaveType.AccrualDaysPerMonth,
+		MaxBalanceDays:      leaveType.MaxBalanceDays,
+	}
+}
+
+// NewBalanceV1Response renders an accrued balance, less the pending days.
+func NewBalanceV1Response(leaveType *models.LeaveType, balance *models.LeaveBalance, pendingDays float64) dtos.LeaveBalanceV1Response {
+	return dtos.LeaveBalanceV1Response{
+		LeaveTypeID:   leaveType.ID,
+		Code:          leaveType.Code,
+		BalanceDays:   balance.BalanceDays,
+		PendingDays:   pendingDays,
+		AvailableDays: RoundDays(balance.BalanceDays - pendingDays),
+		NextAccrual:   balance.AccruedThrough.Format(DateLayout),
+	}
+}
+
+// NewRequestV1Response renders a leave request.
+func NewRequestV1Response(request *models.LeaveRequest, formatter utils.TimeFormatter) dtos.LeaveRequestV1Response {
+	resp := dtos.LeaveRequestV1Response{
+		LeaveRequestID: request.ID,
+		EmployeeID:     request.EmployeeID,
+		LeaveTypeID:    request.LeaveTypeID,
+		StartDate:      request.StartDate.Format(DateLayout),
+		EndDate:        request.EndDate.Format(DateLayout),
+		Days:           request.Days,
+		Reason:         request.Reason,
+		Status:         request.Status,
+		ReviewerID:     request.ReviewerID,
+		ReviewNote:     request.ReviewNote,
+		CreatedAt:      formatter.Time(request.CreatedAt),
+	}
+	if request.ReviewedAt != nil {
+		resp.ReviewedAt = formatter.Time(*request.ReviewedAt)
+	}
+	return resp
+}
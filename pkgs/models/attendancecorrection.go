@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// AttendanceCorrection is an employee's request to fix a wrong punch. Only its
+// approval changes the attendance.
+type AttendanceCorrection struct {
+	ID         int64  `gorm:"primaryKey" fake:"-"`
+	EmployeeID int64  `gorm:"index" fake:"{number:1,100}"`
+	Kind       string `gorm:"size:16;not null" fake:"-"`
+	// AttendanceID is the corrected session. A request adding a missing
+	// session gets the ID of the created session once approved.
+	AttendanceID *int64 `gorm:"index" fake:"-"`
+
+	// ClockIn and ClockOut are the requested values
+	ClockIn  time.Time `gorm:"datetime" fake:"-"`
+	ClockOut time.Time `gorm:"datetime" fake:"-"`
+	Reason   string    `gorm:"size:255" fake:"{sentence:6}"`
+
+	Status     string     `gorm:"size:16;not null;default:pending;index" fake:"-"`
+	ReviewerID *int64     `gorm:"index" fake:"-"`
+	ReviewNote string     `gorm:"size:255" fake:"-"`
+	ReviewedAt *time.Time `gorm:"datetime" fake:"-"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" fake:"-"`
+}
+
+func (AttendanceCorrection) TableName() string {
+	return "attendancecorrection"
+}
+
+const (
+	// AttendanceCorrectionKindAdd adds a session the employee forgot to punch
+	AttendanceCorrectionKindAdd = "add"
+	// AttendanceCorrectionKindChange changes the times of an existing session
+	AttendanceCorrectionKindChange = "change"
+)
+
+const (
+	AttendanceCorrectionStatusPending  = "pending"
+	AttendanceCorrectionStatusApproved = "approved"
+	AttendanceCorrectionStatusRejected = "rejected"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummyAttendanceCorrection(faker *gofakeit.Faker) *AttendanceCorrection {
+	var gen AttendanceCorrection
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+	gen.Kind = AttendanceCorrectionKindAdd
+	gen.ClockIn = faker.Date()
+	gen.ClockOut = gen.ClockIn.Add(time.Hour * 8)
+	gen.Status = AttendanceCorrectionStatusPending
+
+	return &gen
+}
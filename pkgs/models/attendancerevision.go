@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// AttendanceRevision keeps the values of an attendance session before an
+// approved correction changed them. Revisions are never updated or deleted.
+type AttendanceRevision struct {
+	ID           int64 `gorm:"primaryKey"`
+	AttendanceID int64 `gorm:"index"`
+	CorrectionID int64 `gorm:"index"`
+
+	ClockIn  time.Time  `gorm:"datetime"`
+	ClockOut *time.Time `gorm:"datetime"`
+	Status   string     `gorm:"size:16"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (AttendanceRevision) TableName() string {
+	return "attendancerevision"
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Department struct {
+	ID   int64  `gorm:"primaryKey" fake:"-"`
+	Name string `gorm:"size:100;uniqueIndex" fake:"{word}"`
+
+	// ParentID is nil for a top level department
+	ParentID       *int64 `gorm:"index" fake:"-"`
+	HeadEmployeeID *int64 `gorm:"index" fake:"-"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" fake:"-"`
+}
+
+func (Department) TableName() string {
+	return "department"
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummyDepartment(faker *gofakeit.Faker) *Department {
+	var gen Department
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+
+	return &gen
+}
@@ -14,7 +14,9 @@ type EmployeeAttendance struct {
 
 	PositionID int64     `gorm:"index" fake:"{number:1,100}"`
 	ClockIn    time.Time `gorm:"datetime" fake:"-"`
-	ClockOut   time.Time `gorm:"datetime" fake:"-"`
+	// ClockOut is nil while the session is open
+	ClockOut *time.Time `gorm:"datetime" fake:"-"`
+	Status   string     `gorm:"size:16;not null;default:open;index" fake:"-"`
 
 	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime" fake:"-"`
@@ -24,6 +26,20 @@ func (EmployeeAttendance) TableName() string {
 	return "employeeattendance"
 }
 
+const (
+	AttendanceStatusOpen = "open"
+	// AttendanceStatusClosed is set when the employee clocks out
+	AttendanceStatusClosed = "closed"
+	// AttendanceStatusAutoClosed is set when the session is closed on the
+	// employee's behalf
+	AttendanceStatusAutoClosed = "auto_closed"
+	AttendanceStatusVoided     = "voided"
+)
+
+func (a *EmployeeAttendance) IsOpen() bool {
+	return a.Status == AttendanceStatusOpen
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func DummyEmployeeAttendance(faker *gofakeit.Faker) *EmployeeAttendance {
@@ -33,7 +49,9 @@ func DummyEmployeeAttendance(faker *gofakeit.Faker) *EmployeeAttendance {
 	}
 
 	gen.ClockIn = faker.Date()
-	gen.ClockOut = gen.ClockIn.Add(time.Hour * 8)
+	clockOut := gen.ClockIn.Add(time.Hour * 8)
+	gen.ClockOut = &clockOut
+	gen.Status = AttendanceStatusClosed
 
 	return &gen
 }
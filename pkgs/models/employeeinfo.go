@@ -10,6 +10,8 @@ import (
 
 ////////////////////////////////////////////////////////////////////////////////
 
+const DefaultTimeZone = "UTC"
+
 type EmployeeInfo struct {
 	ID      int64  `gorm:"primaryKey" fake:"-"`
 	Name    string `fake:"{firstname}"`
@@ -18,6 +20,15 @@ type EmployeeInfo struct {
 	Phone   string `fake:"{phone}"`
 	Email   string `fake:"{email}"`
 
+	// TimeZone is the IANA name of the zone the employee works in
+	TimeZone string `gorm:"size:64;not null;default:UTC" fake:"-"`
+
+	TerminatedAt      *time.Time `gorm:"type:date" fake:"-"`
+	TerminationReason string     `gorm:"size:255" fake:"-"`
+
+	// Version is bumped on every write, see employeeinforepo.Save
+	Version int64 `gorm:"not null;default:1" fake:"-"`
+
 	CreatedAt time.Time      `gorm:"autoCreateTime" fake:"-"`
 	UpdatedAt time.Time      `gorm:"autoUpdateTime" fake:"-"`
 	DeleteAt  gorm.DeletedAt `fake:"-"`
@@ -27,6 +38,15 @@ func (EmployeeInfo) TableName() string {
 	return "employeeinfo"
 }
 
+// Location returns the time zone of the employee, UTC when it is unknown.
+func (e *EmployeeInfo) Location() *time.Location {
+	loc, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func DummyEmployeeInfo(faker *gofakeit.Faker) *EmployeeInfo {
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
 	"github.com/brianvoe/gofakeit/v6"
 )
 
@@ -12,9 +13,12 @@ type EmployeePosition struct {
 	ID         int64 `gorm:"primaryKey" fake:"-"`
 	EmployeeID int64 `gorm:"index" fake:"{number:1,100}"`
 
-	Position   string  `gorm:"size:100" fake:"{word}"`
-	Department string  `gorm:"size:100" fake:"{word}"`
-	Salary     float64 `gorm:"type:decimal(10,2)" fake:"{price:1000,5000}"`
+	Position     string `gorm:"size:100" fake:"{word}"`
+	DepartmentID int64  `gorm:"index" fake:"{number:1,100}"`
+	// Department is the name of the department, kept in sync by the department repo
+	Department string `gorm:"size:100" fake:"{word}"`
+	// Salary is monthly, stored as salary_amount and salary_currency
+	Salary money.Money `gorm:"embedded;embeddedPrefix:salary_" fake:"-"`
 
 	StartDate time.Time `gorm:"type:date" fake:"-"`
 	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
@@ -31,6 +35,7 @@ func DummyEmployeePosition(faker *gofakeit.Faker) *EmployeePosition {
 	if err := faker.Struct(&gen); err != nil {
 		panic(err)
 	}
+	gen.Salary = money.New(int64(faker.Number(1000, 5000))*100, "USD")
 	// generate date without time
 	gen.StartDate = faker.Date()
 	gen.StartDate = time.Date(
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Holiday is a day off of a calendar. A calendar has one holiday per day.
+type Holiday struct {
+	ID         int64     `gorm:"primaryKey"`
+	CalendarID int64     `gorm:"uniqueIndex:idx_holiday_calendar_date"`
+	Date       time.Time `gorm:"type:date;uniqueIndex:idx_holiday_calendar_date"`
+	Name       string    `gorm:"size:255"`
+	// UID is the UID of the imported iCalendar event, empty when added by hand
+	UID string `gorm:"size:255"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Holiday) TableName() string {
+	return "holiday"
+}
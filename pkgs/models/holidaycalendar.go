@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// HolidayCalendar is a named set of public holidays, typically those of the
+// country of an office.
+type HolidayCalendar struct {
+	ID          int64  `gorm:"primaryKey"`
+	Name        string `gorm:"size:100;uniqueIndex"`
+	Description string `gorm:"size:255"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (HolidayCalendar) TableName() string {
+	return "holidaycalendar"
+}
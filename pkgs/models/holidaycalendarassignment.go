@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// HolidayCalendarAssignment assigns a calendar to either an employee or a
+// department. An employee or a department follows a single calendar, and an
+// employee assignment takes precedence over the one of the employee's
+// department.
+type HolidayCalendarAssignment struct {
+	ID         int64 `gorm:"primaryKey"`
+	CalendarID int64 `gorm:"index"`
+
+	// Exactly one of EmployeeID and DepartmentID is set
+	EmployeeID   *int64 `gorm:"uniqueIndex"`
+	DepartmentID *int64 `gorm:"uniqueIndex"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (HolidayCalendarAssignment) TableName() string {
+	return "holidaycalendarassignment"
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// LeaveBalance is the number of days of a leave type an employee can take.
+type LeaveBalance struct {
+	ID          int64   `gorm:"primaryKey"`
+	EmployeeID  int64   `gorm:"uniqueIndex:idx_leavebalance_employee_type"`
+	LeaveTypeID int64   `gorm:"uniqueIndex:idx_leavebalance_employee_type"`
+	BalanceDays float64 `gorm:"type:decimal(6,2);not null;default:0"`
+	// AccruedThrough is the first day of the month the next accrual is
+	// credited on
+	AccruedThrough time.Time `gorm:"type:date"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (LeaveBalance) TableName() string {
+	return "leavebalance"
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// LeaveRequest is a request for time off. Only an approved request is taken
+// from the balance.
+type LeaveRequest struct {
+	ID          int64 `gorm:"primaryKey" fake:"-"`
+	EmployeeID  int64 `gorm:"index" fake:"{number:1,100}"`
+	LeaveTypeID int64 `gorm:"index" fake:"{number:1,3}"`
+
+	// StartDate and EndDate are inclusive
+	StartDate time.Time `gorm:"type:date" fake:"-"`
+	EndDate   time.Time `gorm:"type:date" fake:"-"`
+	// Days is the number of working days taken
+	Days   float64 `gorm:"type:decimal(6,2)" fake:"-"`
+	Reason string  `gorm:"size:255" fake:"{sentence:6}"`
+
+	Status     string     `gorm:"size:16;not null;default:pending;index" fake:"-"`
+	ReviewerID *int64     `gorm:"index" fake:"-"`
+	ReviewNote string     `gorm:"size:255" fake:"-"`
+	ReviewedAt *time.Time `gorm:"datetime" fake:"-"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" fake:"-"`
+}
+
+func (LeaveRequest) TableName() string {
+	return "leaverequest"
+}
+
+const (
+	LeaveStatusPending   = "pending"
+	LeaveStatusApproved  = "approved"
+	LeaveStatusRejected  = "rejected"
+	LeaveStatusCancelled = "cancelled"
+)
+
+// Covers reports whether the leave includes the day.
+func (l *LeaveRequest) Covers(date time.Time) bool {
+	return !date.Before(l.StartDate) && !date.After(l.EndDate)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummyLeaveRequest(faker *gofakeit.Faker) *LeaveRequest {
+	var gen LeaveRequest
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+	// A working week
+	gen.StartDate = time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	gen.EndDate = gen.StartDate.AddDate(0, 0, 4)
+	gen.Days = 5
+	gen.Status = LeaveStatusPending
+
+	return &gen
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// LeaveType is a kind of time off along with its accrual rule.
+type LeaveType struct {
+	ID   int64  `gorm:"primaryKey"`
+	Code string `gorm:"size:32;uniqueIndex"`
+	Name string `gorm:"size:100"`
+	Paid bool   `gorm:"not null"`
+
+	// TracksBalance is unset for a leave taken without limit, e.g. unpaid
+	TracksBalance bool `gorm:"not null"`
+	// AccrualDaysPerMonth is credited on the first day of every month
+	AccrualDaysPerMonth float64 `gorm:"type:decimal(5,2);not null;default:0"`
+	// MaxBalanceDays caps the accrued balance, zero for no cap
+	MaxBalanceDays float64 `gorm:"type:decimal(6,2);not null;default:0"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (LeaveType) TableName() string {
+	return "leavetype"
+}
+
+const (
+	LeaveTypeCodeAnnual = "annual"
+	LeaveTypeCodeSick   = "sick"
+	LeaveTypeCodeUnpaid = "unpaid"
+)
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// PayrollLine is the pay of an employee for the days of a run spent in one
+// position. The position is copied so the line outlives later changes.
+type PayrollLine struct {
+	ID           int64  `gorm:"primaryKey" fake:"-"`
+	RunID        int64  `gorm:"index" fake:"-"`
+	EmployeeID   int64  `gorm:"index" fake:"{number:1,100}"`
+	EmployeeName string `gorm:"size:255" fake:"{name}"`
+
+	PositionID   int64  `fake:"{number:1,100}"`
+	Position     string `gorm:"size:100" fake:"{word}"`
+	DepartmentID int64  `fake:"{number:1,100}"`
+	Department   string `gorm:"size:100" fake:"{word}"`
+
+	// StartDate and EndDate are inclusive
+	StartDate time.Time `gorm:"type:date" fake:"-"`
+	EndDate   time.Time `gorm:"type:date" fake:"-"`
+	Days      int       `fake:"-"`
+	// MonthlySalary is the salary of the position, Pay its prorated share
+	MonthlySalary money.Money `gorm:"embedded;embeddedPrefix:monthly_salary_" fake:"-"`
+	Pay           money.Money `gorm:"embedded;embeddedPrefix:pay_" fake:"-"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
+}
+
+func (PayrollLine) TableName() string {
+	return "payrollline"
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummyPayrollLine(faker *gofakeit.Faker) *PayrollLine {
+	var gen PayrollLine
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+	// The first half of a month
+	gen.StartDate = time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	gen.EndDate = time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC)
+	gen.Days = 15
+	gen.MonthlySalary = money.New(310000, "USD")
+	gen.Pay = money.New(150000, "USD")
+
+	return &gen
+}
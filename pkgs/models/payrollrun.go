@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// PayrollRun is the pay computed for a period. Its lines are written once
+// with the run; only the status of a draft changes, when it is finalized.
+type PayrollRun struct {
+	ID int64 `gorm:"primaryKey" fake:"-"`
+
+	// PeriodStart and PeriodEnd are inclusive
+	PeriodStart time.Time `gorm:"type:date;index" fake:"-"`
+	PeriodEnd   time.Time `gorm:"type:date;index" fake:"-"`
+
+	Status        string `gorm:"size:16;not null;default:draft;index" fake:"-"`
+	EmployeeCount int    `fake:"-"`
+
+	FinalizedAt *time.Time `gorm:"datetime" fake:"-"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" fake:"-"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime" fake:"-"`
+}
+
+func (PayrollRun) TableName() string {
+	return "payrollrun"
+}
+
+const (
+	PayrollRunStatusDraft     = "draft"
+	PayrollRunStatusFinalized = "finalized"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummyPayrollRun(faker *gofakeit.Faker) *PayrollRun {
+	var gen PayrollRun
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+	// A calendar month
+	gen.PeriodStart = time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	gen.PeriodEnd = time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+	gen.Status = PayrollRunStatusDraft
+
+	return &gen
+}
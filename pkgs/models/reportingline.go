@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ReportingLine makes an employee report to a manager from its start date
+// until the next line of the employee. A line without a manager ends the
+// reporting, the employee is then at the top of the org chart.
+type ReportingLine struct {
+	ID         int64 `gorm:"primaryKey"`
+	EmployeeID int64 `gorm:"uniqueIndex:idx_reportingline_employee_date"`
+	// ManagerID is nil for an employee reporting to nobody
+	ManagerID *int64    `gorm:"index"`
+	StartDate time.Time `gorm:"type:date;uniqueIndex:idx_reportingline_employee_date"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (ReportingLine) TableName() string {
+	return "reportingline"
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SalaryBand is the monthly salary range of a position title in a currency.
+// A band stays in effect from its effective date until the next band of the
+// same title and currency.
+type SalaryBand struct {
+	ID int64 `gorm:"primaryKey" fake:"-"`
+
+	// Position is compared to the position titles regardless of case
+	Position      string    `gorm:"size:100;not null;uniqueIndex:idx_salaryband_position_currency_date" fake:"{jobtitle}"`
+	Currency      string    `gorm:"size:3;not null;uniqueIndex:idx_salaryband_position_currency_date" fake:"-"`
+	EffectiveDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_salaryband_position_currency_date" fake:"-"`
+
+	// Min, Mid and Max are in minor units of the currency
+	Min int64 `gorm:"not null" fake:"-"`
+	Mid int64 `gorm:"not null" fake:"-"`
+	Max int64 `gorm:"not null" fake:"-"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" fake:"-"`
+}
+
+func (SalaryBand) TableName() string {
+	return "salaryband"
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummySalaryBand(faker *gofakeit.Faker) *SalaryBand {
+	var gen SalaryBand
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+	gen.Currency = "USD"
+	gen.EffectiveDate = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	gen.Mid = int64(faker.Number(3000, 6000)) * 100
+	gen.Min = gen.Mid * 8 / 10
+	gen.Max = gen.Mid * 12 / 10
+
+	return &gen
+}
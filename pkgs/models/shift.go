@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Shift is a template of the hours an employee is expected to work.
+type Shift struct {
+	ID   int64  `gorm:"primaryKey" fake:"-"`
+	Name string `gorm:"size:100;uniqueIndex" fake:"{word}"`
+
+	// StartMinute and EndMinute are minutes since midnight on the wall clock
+	// of the employee. A shift ending at or before its start ends on the next
+	// day.
+	StartMinute int `gorm:"not null" fake:"-"`
+	EndMinute   int `gorm:"not null" fake:"-"`
+	// Weekdays is a bit set indexed by time.Weekday
+	Weekdays int `gorm:"not null" fake:"-"`
+	// GraceMinutes is tolerated before flagging a late arrival or an early
+	// departure
+	GraceMinutes int `gorm:"not null;default:0" fake:"{number:0,15}"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" fake:"-"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" fake:"-"`
+}
+
+func (Shift) TableName() string {
+	return "shift"
+}
+
+// WorksOn reports whether the shift starts on the weekday.
+func (s *Shift) WorksOn(weekday time.Weekday) bool {
+	return s.Weekdays&(1<<weekday) != 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func DummyShift(faker *gofakeit.Faker) *Shift {
+	var gen Shift
+	if err := faker.Struct(&gen); err != nil {
+		panic(err)
+	}
+	// 09:00 to 17:00 from Monday to Friday
+	gen.StartMinute = 9 * 60
+	gen.EndMinute = 17 * 60
+	gen.Weekdays = 0b0111110
+
+	return &gen
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ShiftAssignment assigns a shift to either an employee or a department over
+// a range of days. An employee assignment takes precedence over the one of
+// the employee's department.
+type ShiftAssignment struct {
+	ID      int64 `gorm:"primaryKey"`
+	ShiftID int64 `gorm:"index"`
+
+	// Exactly one of EmployeeID and DepartmentID is set
+	EmployeeID   *int64 `gorm:"index"`
+	DepartmentID *int64 `gorm:"index"`
+
+	EffectiveFrom time.Time `gorm:"type:date"`
+	// EffectiveTo is the inclusive last day, nil for an open-ended assignment
+	EffectiveTo *time.Time `gorm:"type:date"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (ShiftAssignment) TableName() string {
+	return "shiftassignment"
+}
+
+// EffectiveOn reports whether the assignment covers the day.
+func (a *ShiftAssignment) EffectiveOn(date time.Time) bool {
+	if date.Before(a.EffectiveFrom) {
+		return false
+	}
+	return a.EffectiveTo == nil || !date.After(*a.EffectiveTo)
+}
@@ -0,0 +1,237 @@
+// Package money represents amounts as integer minor units of an ISO 4217
+// currency, so sums and prorations do not drift like floats.
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Money is an amount in a currency. Stored with gorm it takes two columns,
+// see the embeddedPrefix of the fields using it.
+type Money struct {
+	// Amount is in minor units of the currency, cents for USD
+	Amount   int64  `gorm:"not null"`
+	Currency string `gorm:"size:3;not null"`
+}
+
+// New returns the amount in minor units of the currency.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Parse reads a decimal amount in major units of the currency, e.g. "4000.5"
+// in USD. It rejects more decimals than the currency has.
+func Parse(decimal string, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !IsCurrency(currency) {
+		return Money{}, fmt.Errorf("unknown currency %q", currency)
+	}
+	amount, err := parseMinor(strings.TrimSpace(decimal), Exponent(currency))
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseText reads the representation of String, e.g. "4000.50 USD". The
+// currency may be left out, defaultCurrency is used then.
+func ParseText(text string, defaultCurrency string) (Money, error) {
+	fields := strings.Fields(text)
+	switch len(fields) {
+	case 1:
+		return Parse(fields[0], defaultCurrency)
+	case 2:
+		return Parse(fields[0], fields[1])
+	default:
+		return Money{}, fmt.Errorf("invalid amount %q", text)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// IsZero reports whether m is the zero value, without a currency.
+func (m Money) IsZero() bool {
+	return m == Money{}
+}
+
+// Add returns the sum of two amounts of the same currency.
+func (m Money) Add(other Money) Money {
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}
+}
+
+// Sub returns the difference of two amounts of the same currency.
+func (m Money) Sub(other Money) Money {
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}
+}
+
+// Totals sums the amounts per currency, ordered by currency.
+func Totals(amounts ...Money) []Money {
+	byCurrency := make(map[string]int64)
+	for _, amount := range amounts {
+		byCurrency[amount.Currency] += amount.Amount
+	}
+
+	totals := make([]Money, 0, len(byCurrency))
+	for currency, amount := range byCurrency {
+		totals = append(totals, Money{Amount: amount, Currency: currency})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Currency < totals[j].Currency
+	})
+	return totals
+}
+
+// MulRat returns m multiplied by r, rounded half away from zero to the minor
+// unit.
+func (m Money) MulRat(r *big.Rat) Money {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), r)
+	// Round by truncating the product moved half a unit away from zero
+	half := big.NewRat(1, 2)
+	if product.Sign() < 0 {
+		half.Neg(half)
+	}
+	product.Add(product, half)
+	amount := new(big.Int).Quo(product.Num(), product.Denom())
+	return Money{Amount: amount.Int64(), Currency: m.Currency}
+}
+
+// Decimal returns the amount in major units, with every digit of the minor
+// unit, e.g. "4000.50".
+func (m Money) Decimal() string {
+	exponent := Exponent(m.Currency)
+	digits := strconv.FormatInt(abs(m.Amount), 10)
+	sign := ""
+	if m.Amount < 0 {
+		sign = "-"
+	}
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+// String returns the amount followed by its currency, e.g. "4000.50 USD".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type jsonMoney struct {
+	Amount   json.Number `json:"amount"`
+	Currency string      `json:"currency"`
+}
+
+// MarshalJSON writes the amount as a decimal string, which keeps every
+// digit whatever the precision of the client. The zero value is null.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{m.Decimal(), m.Currency})
+}
+
+// UnmarshalJSON reads an object with an amount and a currency. A bare
+// number, as sent by clients predating currencies, is read in hundredths
+// without a currency; see WithDefaultCurrency.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if data[0] != '{' {
+		var number json.Number
+		if err := json.Unmarshal(data, &number); err != nil {
+			return errors.New("amount must be a number or an object with an amount and a currency")
+		}
+		amount, err := parseMinor(number.String(), legacyExponent)
+		if err != nil {
+			return err
+		}
+		*m = Money{Amount: amount}
+		return nil
+	}
+
+	var value jsonMoney
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	parsed, err := Parse(value.Amount.String(), value.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// WithDefaultCurrency sets the currency of an amount read without one.
+func (m Money) WithDefaultCurrency(currency string) (Money, error) {
+	if m.Currency != "" {
+		return m, nil
+	}
+	return Parse(Money{Amount: m.Amount, Currency: legacyCurrency}.Decimal(), currency)
+}
+
+// legacyExponent is the precision of the amounts predating currencies, the
+// decimal(10,2) salaries
+const legacyExponent = 2
+
+// legacyCurrency is any currency counting in hundredths
+const legacyCurrency = "USD"
+
+////////////////////////////////////////////////////////////////////////////////
+
+// parseMinor converts a decimal string to minor units of the given exponent.
+func parseMinor(decimal string, exponent int) (int64, error) {
+	sign := int64(1)
+	if strings.HasPrefix(decimal, "-") {
+		sign, decimal = -1, decimal[1:]
+	}
+	whole, fraction, _ := strings.Cut(decimal, ".")
+	// Trailing zeros do not add precision
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid amount %q", decimal)
+	}
+	if len(fraction) > exponent {
+		return 0, fmt.Errorf("amount %q has more than %d decimals", decimal, exponent)
+	}
+
+	amount, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", exponent-len(fraction)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("amount %q is out of range", decimal)
+	}
+	return sign * amount, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
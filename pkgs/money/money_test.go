@@ -0,0 +1,119 @@
+package money
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("Given decimal amounts", t, func() {
+		Convey("They should be read in minor units of the currency", func() {
+			m, err := Parse("4000.5", "usd")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, New(400050, "USD"))
+
+			m, err = Parse("4000", "JPY")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, New(4000, "JPY"))
+
+			m, err = Parse("-1.250", "KWD")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, New(-1250, "KWD"))
+		})
+
+		Convey("Large amounts should be kept exact", func() {
+			m, err := Parse("123456789012.34", "TWD")
+			So(err, ShouldBeNil)
+			So(m.Amount, ShouldEqual, 12345678901234)
+			So(m.Decimal(), ShouldEqual, "123456789012.34")
+		})
+
+		Convey("Extra decimals and unknown currencies should be rejected", func() {
+			_, err := Parse("10.5", "JPY")
+			So(err, ShouldNotBeNil)
+			_, err = Parse("10.001", "USD")
+			So(err, ShouldNotBeNil)
+			_, err = Parse("10", "XYZ")
+			So(err, ShouldNotBeNil)
+			_, err = Parse("1e3", "USD")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("The text form should round trip", func() {
+			m, err := ParseText(New(5, "EUR").String(), "USD")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, New(5, "EUR"))
+
+			m, err = ParseText("12.30", "USD")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, New(1230, "USD"))
+		})
+	})
+}
+
+func TestDecimal(t *testing.T) {
+	Convey("Amounts should be written with every digit of the minor unit", t, func() {
+		So(New(400050, "USD").Decimal(), ShouldEqual, "4000.50")
+		So(New(5, "USD").Decimal(), ShouldEqual, "0.05")
+		So(New(-5, "USD").Decimal(), ShouldEqual, "-0.05")
+		So(New(1250, "KWD").Decimal(), ShouldEqual, "1.250")
+		So(New(4000, "JPY").Decimal(), ShouldEqual, "4000")
+	})
+}
+
+func TestMulRat(t *testing.T) {
+	Convey("Products should be rounded half away from zero", t, func() {
+		So(New(100000, "USD").MulRat(big.NewRat(1, 3)).Amount, ShouldEqual, 33333)
+		So(New(1, "USD").MulRat(big.NewRat(1, 2)).Amount, ShouldEqual, 1)
+		So(New(-1, "USD").MulRat(big.NewRat(1, 2)).Amount, ShouldEqual, -1)
+		So(New(300000, "USD").MulRat(big.NewRat(20, 30)).Amount, ShouldEqual, 200000)
+	})
+}
+
+func TestTotals(t *testing.T) {
+	Convey("Amounts should be summed per currency", t, func() {
+		totals := Totals(New(100, "USD"), New(5, "EUR"), New(250, "USD"))
+		So(totals, ShouldResemble, []Money{New(5, "EUR"), New(350, "USD")})
+		So(Totals(), ShouldBeEmpty)
+	})
+}
+
+func TestJSON(t *testing.T) {
+	Convey("Given amounts in JSON", t, func() {
+		Convey("An amount should be written as a decimal string with its currency", func() {
+			data, err := json.Marshal(New(400050, "USD"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"amount":"4000.50","currency":"USD"}`)
+
+			data, err = json.Marshal(Money{})
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `null`)
+		})
+
+		Convey("An object should be read with its currency", func() {
+			var m Money
+			So(json.Unmarshal([]byte(`{"amount":4000.5,"currency":"EUR"}`), &m), ShouldBeNil)
+			So(m, ShouldResemble, New(400050, "EUR"))
+			So(json.Unmarshal([]byte(`{"amount":"100","currency":"JPY"}`), &m), ShouldBeNil)
+			So(m, ShouldResemble, New(100, "JPY"))
+			So(json.Unmarshal([]byte(`{"amount":"1.5","currency":"JPY"}`), &m), ShouldNotBeNil)
+		})
+
+		Convey("A bare number should take the default currency", func() {
+			var m Money
+			So(json.Unmarshal([]byte(`4000.5`), &m), ShouldBeNil)
+			So(m.Currency, ShouldBeEmpty)
+
+			m, err := m.WithDefaultCurrency("TWD")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, New(400050, "TWD"))
+
+			So(json.Unmarshal([]byte(`4000.5`), &m), ShouldBeNil)
+			_, err = Money{Amount: m.Amount}.WithDefaultCurrency("JPY")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
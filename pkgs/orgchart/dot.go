@@ -0,0 +1,49 @@
+package orgchart
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const DOTContentType = "text/vnd.graphviz; charset=utf-8"
+
+// WriteDOT renders an org chart as a Graphviz digraph, every manager
+// pointing to their reports. A node is labelled with the name and the
+// position of the employee.
+func WriteDOT(w io.Writer, chart dtos.OrgChartV1Response) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph orgchart {\n")
+	fmt.Fprintf(bw, "\tlabel=%s;\n", quoteDOT("Org chart as of "+chart.AsOf))
+	fmt.Fprintf(bw, "\tnode [shape=box];\n")
+	for _, root := range chart.Roots {
+		writeDOTNode(bw, root)
+	}
+	fmt.Fprintf(bw, "}\n")
+	return bw.Flush()
+}
+
+func writeDOTNode(w io.Writer, node dtos.OrgChartNodeV1Response) {
+	label := node.Name
+	if node.Position != "" {
+		label += "\n" + node.Position
+	}
+	fmt.Fprintf(w, "\t%d [label=%s];\n", node.EmployeeID, quoteDOT(label))
+	for _, report := range node.Reports {
+		fmt.Fprintf(w, "\t%d -> %d;\n", node.EmployeeID, report.EmployeeID)
+		writeDOTNode(w, report)
+	}
+}
+
+// dotEscaper escapes a DOT string, new lines becoming line breaks of the
+// label.
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", "")
+
+func quoteDOT(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
@@ -0,0 +1,40 @@
+package orgchart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWriteDOT(t *testing.T) {
+	Convey("Given an org chart", t, func() {
+		chart := dtos.OrgChartV1Response{
+			AsOf: "2025-06-01",
+			Roots: []dtos.OrgChartNodeV1Response{
+				{
+					EmployeeID: 1,
+					Name:       "Alice",
+					Position:   "CEO",
+					Reports: []dtos.OrgChartNodeV1Response{
+						{EmployeeID: 2, Name: `Bob "Bobby" Smith`, Reports: []dtos.OrgChartNodeV1Response{}},
+					},
+				},
+			},
+		}
+
+		Convey("It should render a digraph from managers to reports", func() {
+			var buf bytes.Buffer
+			So(WriteDOT(&buf, chart), ShouldBeNil)
+			So(buf.String(), ShouldEqual, `digraph orgchart {
+	label="Org chart as of 2025-06-01";
+	node [shape=box];
+	1 [label="Alice\nCEO"];
+	1 -> 2;
+	2 [label="Bob \"Bobby\" Smith"];
+}
+`)
+		})
+	})
+}
@@ -0,0 +1,152 @@
+// Package orgchart builds the reporting tree of the employees from their
+// effective-dated reporting lines. Dates are UTC days.
+package orgchart
+
+import (
+	"slices"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const DateLayout = "2006-01-02"
+
+// Managers returns the manager of every employee on the date, keyed by the
+// employee. An employee whose line in effect has no manager is left out.
+func Managers(lines []*models.ReportingLine, date time.Time) map[int64]int64 {
+	effective := map[int64]*models.ReportingLine{}
+	for _, line := range lines {
+		if line.StartDate.After(date) {
+			continue
+		}
+		if current, ok := effective[line.EmployeeID]; ok && !line.StartDate.After(current.StartDate) {
+			continue
+		}
+		effective[line.EmployeeID] = line
+	}
+
+	managers := make(map[int64]int64, len(effective))
+	for employeeID, line := range effective {
+		if line.ManagerID != nil {
+			managers[employeeID] = *line.ManagerID
+		}
+	}
+	return managers
+}
+
+// Chain returns the managers above the employee, the direct manager first
+// and the top of the chart last. It stops before an employee met twice,
+// should the lines hold a cycle.
+func Chain(managers map[int64]int64, employeeID int64) []int64 {
+	chain := []int64{}
+	seen := map[int64]bool{employeeID: true}
+	for managerID, ok := managers[employeeID]; ok && !seen[managerID]; managerID, ok = managers[managerID] {
+		seen[managerID] = true
+		chain = append(chain, managerID)
+	}
+	return chain
+}
+
+// HasCycle tells whether the new line would make an employee report to
+// themselves, directly or not, on any day from its start. The new line
+// replaces the line of the employee starting on the same day.
+func HasCycle(lines []*models.ReportingLine, line *models.ReportingLine) bool {
+	if line.ManagerID == nil {
+		return false
+	}
+	if *line.ManagerID == line.EmployeeID {
+		return true
+	}
+
+	// The tree only changes on the first day of a line
+	merged := []*models.ReportingLine{line}
+	dates := []time.Time{line.StartDate}
+	for _, other := range lines {
+		if other.EmployeeID == line.EmployeeID && other.StartDate.Equal(line.StartDate) {
+			continue
+		}
+		merged = append(merged, other)
+		if other.StartDate.After(line.StartDate) {
+			dates = append(dates, other.StartDate)
+		}
+	}
+
+	for _, date := range dates {
+		if lo.Contains(Chain(Managers(merged, date), *line.ManagerID), line.EmployeeID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune drops the lines of the employees not kept, the employees reporting
+// to them are then at the top of the chart.
+func Prune(managers map[int64]int64, keep func(employeeID int64) bool) map[int64]int64 {
+	return lo.PickBy(managers, func(employeeID int64, managerID int64) bool {
+		return keep(employeeID) && keep(managerID)
+	})
+}
+
+// EmployeeIDs returns the employees in a reporting line, as a report or as a
+// manager, ordered by ID.
+func EmployeeIDs(managers map[int64]int64) []int64 {
+	ids := lo.Uniq(append(lo.Keys(managers), lo.Values(managers)...))
+	slices.Sort(ids)
+	return ids
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Node is an employee with the employees reporting to them.
+type Node struct {
+	EmployeeID int64
+	// Reports are ordered by ID
+	Reports []*Node
+}
+
+// Tree returns the employee with the employees reporting to them, directly
+// or not.
+func Tree(managers map[int64]int64, employeeID int64) *Node {
+	return newNode(reportsByManager(managers), employeeID, map[int64]bool{})
+}
+
+// Forest returns the trees of every employee in a reporting line, rooted at
+// the employees reporting to nobody and ordered by ID.
+func Forest(managers map[int64]int64) []*Node {
+	reports := reportsByManager(managers)
+	visited := map[int64]bool{}
+	roots := []*Node{}
+	for _, employeeID := range EmployeeIDs(managers) {
+		if _, ok := managers[employeeID]; !ok {
+			roots = append(roots, newNode(reports, employeeID, visited))
+		}
+	}
+	return roots
+}
+
+func reportsByManager(managers map[int64]int64) map[int64][]int64 {
+	reports := map[int64][]int64{}
+	for employeeID, managerID := range managers {
+		reports[managerID] = append(reports[managerID], employeeID)
+	}
+	for _, ids := range reports {
+		slices.Sort(ids)
+	}
+	return reports
+}
+
+func newNode(reports map[int64][]int64, employeeID int64, visited map[int64]bool) *Node {
+	visited[employeeID] = true
+	node := &Node{EmployeeID: employeeID, Reports: []*Node{}}
+	for _, reportID := range reports[employeeID] {
+		// A cycle is refused on write, this only guards the recursion
+		if visited[reportID] {
+			continue
+		}
+		node.Reports = append(node.Reports, newNode(reports, reportID, visited))
+	}
+	return node
+}
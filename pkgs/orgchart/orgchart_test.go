@@ -0,0 +1,120 @@
+package orgchart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func line(employeeID int64, managerID int64, startDate time.Time) *models.ReportingLine {
+	l := &models.ReportingLine{EmployeeID: employeeID, StartDate: startDate}
+	if managerID != 0 {
+		l.ManagerID = lo.ToPtr(managerID)
+	}
+	return l
+}
+
+func TestManagers(t *testing.T) {
+	Convey("Given the lines of employees over time", t, func() {
+		january := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		lines := []*models.ReportingLine{
+			line(2, 1, january),
+			line(3, 1, january),
+			line(3, 2, june),
+			line(2, 0, june),
+		}
+
+		Convey("The latest line started on the date should be in effect", func() {
+			So(Managers(lines, january), ShouldResemble, map[int64]int64{2: 1, 3: 1})
+			So(Managers(lines, june), ShouldResemble, map[int64]int64{3: 2})
+			So(Managers(lines, january.AddDate(0, 0, -1)), ShouldBeEmpty)
+		})
+
+		Convey("The chain should go up to the top of the chart", func() {
+			managers := map[int64]int64{4: 3, 3: 2, 2: 1}
+			So(Chain(managers, 4), ShouldResemble, []int64{3, 2, 1})
+			So(Chain(managers, 1), ShouldBeEmpty)
+		})
+
+		Convey("The chain should stop on a cycle", func() {
+			So(Chain(map[int64]int64{1: 2, 2: 3, 3: 2}, 1), ShouldResemble, []int64{2, 3})
+		})
+	})
+}
+
+func TestHasCycle(t *testing.T) {
+	Convey("Given a chain of three employees", t, func() {
+		january := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		lines := []*models.ReportingLine{
+			line(2, 1, january),
+			line(3, 2, january),
+		}
+
+		Convey("Reporting to a report should be a cycle", func() {
+			So(HasCycle(lines, line(1, 3, june)), ShouldBeTrue)
+			So(HasCycle(lines, line(1, 2, january)), ShouldBeTrue)
+		})
+
+		Convey("Reporting to oneself should be a cycle", func() {
+			So(HasCycle(lines, line(1, 1, june)), ShouldBeTrue)
+		})
+
+		Convey("Reporting sideways or to nobody should not be a cycle", func() {
+			So(HasCycle(lines, line(3, 1, june)), ShouldBeFalse)
+			So(HasCycle(lines, line(2, 0, june)), ShouldBeFalse)
+		})
+
+		Convey("A line replaced on the same day should be ignored", func() {
+			So(HasCycle(lines, line(2, 3, january)), ShouldBeTrue)
+			So(HasCycle(append(lines, line(3, 0, june)), line(1, 3, june)), ShouldBeFalse)
+		})
+
+		Convey("A future line closing a cycle should be found", func() {
+			// 1 reports to 4 from March, 4 to 3 from September
+			future := append(lines, line(4, 3, time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)))
+			So(HasCycle(future, line(1, 4, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))), ShouldBeTrue)
+		})
+
+		Convey("A cycle ended before the line starts should not count", func() {
+			// 3 stops reporting to 2 before 1 reports to 3
+			ended := append(lines, line(3, 0, june))
+			So(HasCycle(ended, line(1, 3, june.AddDate(0, 1, 0))), ShouldBeFalse)
+		})
+	})
+}
+
+func TestTree(t *testing.T) {
+	Convey("Given two charts", t, func() {
+		managers := map[int64]int64{2: 1, 3: 1, 4: 2, 6: 5}
+
+		Convey("The tree should hold the direct and transitive reports", func() {
+			tree := Tree(managers, 1)
+			So(tree.EmployeeID, ShouldEqual, 1)
+			So(tree.Reports, ShouldHaveLength, 2)
+			So(tree.Reports[0].EmployeeID, ShouldEqual, 2)
+			So(tree.Reports[0].Reports[0].EmployeeID, ShouldEqual, 4)
+			So(tree.Reports[1].Reports, ShouldBeEmpty)
+
+			So(Tree(managers, 4).Reports, ShouldBeEmpty)
+		})
+
+		Convey("The forest should be rooted at the employees reporting to nobody", func() {
+			roots := Forest(managers)
+			So(roots, ShouldHaveLength, 2)
+			So(roots[0].EmployeeID, ShouldEqual, 1)
+			So(roots[1].EmployeeID, ShouldEqual, 5)
+			So(roots[1].Reports[0].EmployeeID, ShouldEqual, 6)
+		})
+
+		Convey("Pruned managers should leave their reports at the top", func() {
+			pruned := Prune(managers, func(employeeID int64) bool { return employeeID != 2 })
+			So(pruned, ShouldResemble, map[int64]int64{3: 1, 6: 5})
+			So(EmployeeIDs(pruned), ShouldResemble, []int64{1, 3, 5, 6})
+		})
+	})
+}
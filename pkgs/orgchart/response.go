@@ -0,0 +1,61 @@
+package orgchart
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewLineV1Response renders a reporting line.
+func NewLineV1Response(line *models.ReportingLine, formatter utils.TimeFormatter) dtos.ReportingLineV1Response {
+	return dtos.ReportingLineV1Response{
+		ReportingLineID: line.ID,
+		EmployeeID:      line.EmployeeID,
+		ManagerID:       line.ManagerID,
+		StartDate:       line.StartDate.Format(DateLayout),
+		CreatedAt:       formatter.Time(line.CreatedAt),
+		UpdatedAt:       formatter.Time(line.UpdatedAt),
+	}
+}
+
+// NewEmployeeV1Response renders an employee with the position they hold on
+// the date of the chart.
+func NewEmployeeV1Response(
+	employeeID int64,
+	employeeInfos map[int64]*models.EmployeeInfo,
+	employeePositions map[int64]*models.EmployeePosition,
+) dtos.OrgChartEmployeeV1Response {
+	resp := dtos.OrgChartEmployeeV1Response{
+		EmployeeID: employeeID,
+	}
+	if employeeInfo, ok := employeeInfos[employeeID]; ok {
+		resp.Name = employeeInfo.Name
+	}
+	if employeePosition, ok := employeePositions[employeeID]; ok {
+		resp.Position = employeePosition.Position
+		resp.Department = employeePosition.Department
+	}
+	return resp
+}
+
+// NewNodeV1Response renders a tree of reports.
+func NewNodeV1Response(
+	node *Node,
+	employeeInfos map[int64]*models.EmployeeInfo,
+	employeePositions map[int64]*models.EmployeePosition,
+) dtos.OrgChartNodeV1Response {
+	employee := NewEmployeeV1Response(node.EmployeeID, employeeInfos, employeePositions)
+	resp := dtos.OrgChartNodeV1Response{
+		EmployeeID: employee.EmployeeID,
+		Name:       employee.Name,
+		Position:   employee.Position,
+		Department: employee.Department,
+		Reports:    make([]dtos.OrgChartNodeV1Response, 0, len(node.Reports)),
+	}
+	for _, report := range node.Reports {
+		resp.Reports = append(resp.Reports, NewNodeV1Response(report, employeeInfos, employeePositions))
+	}
+	return resp
+}
@@ -0,0 +1,129 @@
+// Package payroll prorates the monthly salaries of the positions over the
+// days of a pay period. Days are UTC calendar days.
+package payroll
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const DateLayout = "2006-01-02"
+
+// StatusPreview is the status of a run computed but not saved.
+const StatusPreview = "preview"
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Calculate returns the lines of the period from to to inclusive, one per
+// employee and position effective on a day of it. The positions are grouped
+// by employee and ordered by start date, a position ends the day before the
+// next one starts. Employees missing from employeeInfos are left out, the
+// terminated ones are paid up to their termination date.
+func Calculate(from, to time.Time, employeeInfos []*models.EmployeeInfo, positions []*models.EmployeePosition) []*models.PayrollLine {
+	from, to = Day(from), Day(to)
+	employeeInfoByID := make(map[int64]*models.EmployeeInfo, len(employeeInfos))
+	for _, employeeInfo := range employeeInfos {
+		employeeInfoByID[employeeInfo.ID] = employeeInfo
+	}
+
+	var lines []*models.PayrollLine
+	for i, position := range positions {
+		employeeInfo, ok := employeeInfoByID[position.EmployeeID]
+		if !ok {
+			continue
+		}
+
+		start := laterDay(Day(position.StartDate), from)
+		end := to
+		if i+1 < len(positions) && positions[i+1].EmployeeID == position.EmployeeID {
+			end = earlierDay(end, Day(positions[i+1].StartDate).AddDate(0, 0, -1))
+		}
+		if employeeInfo.TerminatedAt != nil {
+			end = earlierDay(end, Day(*employeeInfo.TerminatedAt))
+		}
+		if end.Before(start) {
+			continue
+		}
+
+		lines = append(lines, &models.PayrollLine{
+			EmployeeID:    employeeInfo.ID,
+			EmployeeName:  employeeInfo.Name,
+			PositionID:    position.ID,
+			Position:      position.Position,
+			DepartmentID:  position.DepartmentID,
+			Department:    position.Department,
+			StartDate:     start,
+			EndDate:       end,
+			Days:          Days(start, end),
+			MonthlySalary: position.Salary,
+			Pay:           Prorate(position.Salary, start, end),
+		})
+	}
+	return lines
+}
+
+// NewRun returns the draft run of the period paying the lines.
+func NewRun(from, to time.Time, lines []*models.PayrollLine) *models.PayrollRun {
+	employeeIDs := make(map[int64]struct{})
+	for _, line := range lines {
+		employeeIDs[line.EmployeeID] = struct{}{}
+	}
+
+	return &models.PayrollRun{
+		PeriodStart:   Day(from),
+		PeriodEnd:     Day(to),
+		Status:        models.PayrollRunStatusDraft,
+		EmployeeCount: len(employeeIDs),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Prorate returns the share of a monthly salary earned from start to end
+// inclusive. Every day is worth the salary divided by the days of its month,
+// so a full calendar month pays the salary whatever its length. The share is
+// rounded once, to the minor unit.
+func Prorate(monthlySalary money.Money, start, end time.Time) money.Money {
+	share := new(big.Rat)
+	for monthStart := start; !monthStart.After(end); {
+		monthEnd := earlierDay(end, lastDayOfMonth(monthStart))
+		share.Add(share, big.NewRat(int64(Days(monthStart, monthEnd)), int64(lastDayOfMonth(monthStart).Day())))
+		monthStart = monthEnd.AddDate(0, 0, 1)
+	}
+	return monthlySalary.MulRat(share)
+}
+
+// Days counts the days from start to end inclusive.
+func Days(start, end time.Time) int {
+	return int(end.Sub(start).Hours()/24) + 1
+}
+
+// Day returns the calendar day of t, at midnight UTC.
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func lastDayOfMonth(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+}
+
+func laterDay(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierDay(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
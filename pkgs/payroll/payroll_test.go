@@ -0,0 +1,120 @@
+package payroll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// usd returns the amount in cents
+func usd(cents int64) money.Money {
+	return money.New(cents, "USD")
+}
+
+func TestProrate(t *testing.T) {
+	Convey("Given a monthly salary of 3000 USD", t, func() {
+		salary := usd(300000)
+
+		Convey("A full month should pay the salary whatever its length", func() {
+			So(Prorate(salary, date(2025, 2, 1), date(2025, 2, 28)), ShouldResemble, salary)
+			So(Prorate(salary, date(2025, 5, 1), date(2025, 5, 31)), ShouldResemble, salary)
+		})
+
+		Convey("Days should be worth the salary divided by the days of their month", func() {
+			So(Prorate(salary, date(2025, 6, 1), date(2025, 6, 10)), ShouldResemble, usd(100000))
+			So(Prorate(usd(310000), date(2025, 5, 1), date(2025, 5, 1)), ShouldResemble, usd(10000))
+		})
+
+		Convey("A range across months should prorate each month on its own", func() {
+			// 10 of 30 days of June and 31 of 31 days of July
+			So(Prorate(salary, date(2025, 6, 21), date(2025, 7, 31)), ShouldResemble, usd(400000))
+		})
+
+		Convey("The share should be rounded once, to the cent", func() {
+			So(Prorate(usd(100000), date(2025, 6, 1), date(2025, 6, 1)), ShouldResemble, usd(3333))
+			// A full month pays the salary, not the sum of its rounded days
+			So(Prorate(usd(100000), date(2025, 6, 1), date(2025, 6, 30)), ShouldResemble, usd(100000))
+		})
+
+		Convey("Currencies without a minor unit should be rounded to the unit", func() {
+			So(Prorate(money.New(300000, "JPY"), date(2025, 6, 1), date(2025, 6, 1)), ShouldResemble, money.New(10000, "JPY"))
+			So(Prorate(money.New(100000, "JPY"), date(2025, 6, 1), date(2025, 6, 1)), ShouldResemble, money.New(3333, "JPY"))
+		})
+	})
+}
+
+func TestCalculate(t *testing.T) {
+	Convey("Given employees with position changes during June", t, func() {
+		from, to := date(2025, 6, 1), date(2025, 6, 30)
+		promoted := &models.EmployeeInfo{ID: 1, Name: "Alice"}
+		hired := &models.EmployeeInfo{ID: 2, Name: "Bob"}
+		terminatedAt := date(2025, 6, 15)
+		terminated := &models.EmployeeInfo{ID: 3, Name: "Carol", TerminatedAt: &terminatedAt}
+
+		positions := []*models.EmployeePosition{
+			{ID: 10, EmployeeID: 1, Position: "Engineer", DepartmentID: 1, Department: "R&D", Salary: usd(300000), StartDate: date(2024, 1, 1)},
+			{ID: 11, EmployeeID: 1, Position: "Senior Engineer", DepartmentID: 1, Department: "R&D", Salary: usd(450000), StartDate: date(2025, 6, 21)},
+			{ID: 20, EmployeeID: 2, Position: "Designer", DepartmentID: 2, Department: "Design", Salary: usd(300000), StartDate: date(2025, 6, 11)},
+			{ID: 30, EmployeeID: 3, Position: "Analyst", DepartmentID: 3, Department: "Finance", Salary: usd(600000), StartDate: date(2023, 3, 1)},
+			{ID: 40, EmployeeID: 4, Position: "Ghost", Salary: usd(900000), StartDate: date(2023, 3, 1)},
+		}
+		lines := Calculate(from, to, []*models.EmployeeInfo{promoted, hired, terminated}, positions)
+
+		Convey("A promotion should split the month between the two salaries", func() {
+			So(lines, ShouldHaveLength, 4)
+			So(lines[0].PositionID, ShouldEqual, 10)
+			So(lines[0].StartDate, ShouldEqual, from)
+			So(lines[0].EndDate, ShouldEqual, date(2025, 6, 20))
+			So(lines[0].Days, ShouldEqual, 20)
+			So(lines[0].Pay, ShouldResemble, usd(200000))
+			So(lines[1].PositionID, ShouldEqual, 11)
+			So(lines[1].StartDate, ShouldEqual, date(2025, 6, 21))
+			So(lines[1].EndDate, ShouldEqual, to)
+			So(lines[1].Pay, ShouldResemble, usd(150000))
+			So(lines[1].EmployeeName, ShouldEqual, "Alice")
+		})
+
+		Convey("A new hire should be paid from their first day", func() {
+			So(lines[2].EmployeeID, ShouldEqual, 2)
+			So(lines[2].Days, ShouldEqual, 20)
+			So(lines[2].Pay, ShouldResemble, usd(200000))
+		})
+
+		Convey("A terminated employee should be paid up to their termination date", func() {
+			So(lines[3].EmployeeID, ShouldEqual, 3)
+			So(lines[3].EndDate, ShouldEqual, terminatedAt)
+			So(lines[3].Pay, ShouldResemble, usd(300000))
+		})
+
+		Convey("The run should total the lines", func() {
+			run := NewRun(from, to, lines)
+			So(run.Status, ShouldEqual, models.PayrollRunStatusDraft)
+			So(run.EmployeeCount, ShouldEqual, 3)
+
+			resp := NewRunV1Response(run, lines, utils.V1TimeFormatter)
+			So(resp.Items, ShouldHaveLength, 3)
+			So(resp.Totals, ShouldResemble, []money.Money{usd(850000)})
+			So(resp.Items[0].Totals, ShouldResemble, []money.Money{usd(350000)})
+			So(resp.Items[0].Lines, ShouldHaveLength, 2)
+			So(resp.CreatedAt, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given an employee terminated before the period", t, func() {
+		terminatedAt := date(2025, 5, 31)
+		employeeInfo := &models.EmployeeInfo{ID: 1, TerminatedAt: &terminatedAt}
+		positions := []*models.EmployeePosition{{ID: 10, EmployeeID: 1, Salary: usd(300000), StartDate: date(2024, 1, 1)}}
+
+		Convey("They should not be paid", func() {
+			So(Calculate(date(2025, 6, 1), date(2025, 6, 30), []*models.EmployeeInfo{employeeInfo}, positions), ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package payroll
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewRunV1Response renders a run with its lines grouped by employee. The
+// lines are expected grouped by employee already.
+func NewRunV1Response(run *models.PayrollRun, lines []*models.PayrollLine, formatter utils.TimeFormatter) dtos.PayrollRunV1Response {
+	resp := dtos.PayrollRunV1Response{
+		PayrollRunID:  run.ID,
+		PeriodStart:   run.PeriodStart.Format(DateLayout),
+		PeriodEnd:     run.PeriodEnd.Format(DateLayout),
+		Status:        run.Status,
+		EmployeeCount: run.EmployeeCount,
+		Totals:        money.Totals(lo.Map(lines, pay)...),
+		Items:         []dtos.PayrollEmployeeV1Response{},
+	}
+	if run.FinalizedAt != nil {
+		resp.FinalizedAt = formatter.Time(*run.FinalizedAt)
+	}
+	// A preview is never created
+	if !run.CreatedAt.IsZero() {
+		resp.CreatedAt = formatter.Time(run.CreatedAt)
+	}
+
+	for _, employeeLines := range lo.PartitionBy(lines, employeeID) {
+		item := dtos.PayrollEmployeeV1Response{
+			EmployeeID: employeeLines[0].EmployeeID,
+			Name:       employeeLines[0].EmployeeName,
+			Totals:     money.Totals(lo.Map(employeeLines, pay)...),
+		}
+		for _, line := range employeeLines {
+			item.Lines = append(item.Lines, newLineV1Response(line))
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp
+}
+
+func newLineV1Response(line *models.PayrollLine) dtos.PayrollLineV1Response {
+	return dtos.PayrollLineV1Response{
+		PositionID:    line.PositionID,
+		Position:      line.Position,
+		DepartmentID:  line.DepartmentID,
+		Department:    line.Department,
+		StartDate:     line.StartDate.Format(DateLayout),
+		EndDate:       line.EndDate.Format(DateLayout),
+		Days:          line.Days,
+		MonthlySalary: line.MonthlySalary,
+		Amount:        line.Pay,
+	}
+}
+
+func employeeID(line *models.PayrollLine) int64 {
+	return line.EmployeeID
+}
+
+func pay(line *models.PayrollLine, _ int) money.Money {
+	return line.Pay
+}
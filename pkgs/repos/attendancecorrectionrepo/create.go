@@ -0,0 +1,18 @@
+package attendancecorrectionrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+func (r *repo) Create(ctx context.Context, tx *gorm.DB, data *models.AttendanceCorrection) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create attendance correction: %w", err)
+	}
+
+	return nil
+}
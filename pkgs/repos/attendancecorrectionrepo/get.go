@@ -0,0 +1,49 @@
+package attendancecorrectionrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *repo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.AttendanceCorrection, error) {
+	// Create a variable to hold the result
+	var correction models.AttendanceCorrection
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&correction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get attendance correction: %w", err)
+	}
+
+	// Return the result
+	return &correction, nil
+}
+
+// GetForUpdate is Get with a row lock held until the end of the transaction,
+// so a correction is reviewed once.
+func (r *repo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.AttendanceCorrection, error) {
+	return r.Get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+// GetPendingByAttendanceID returns the correction of the session waiting for
+// review, if any.
+func (r *repo) GetPendingByAttendanceID(ctx context.Context, tx *gorm.DB, attendanceID int64) (*models.AttendanceCorrection, error) {
+	var correction models.AttendanceCorrection
+	if err := tx.Where("attendance_id = ?", attendanceID).
+		Where("status = ?", models.AttendanceCorrectionStatusPending).
+		First(&correction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pending attendance correction: %w", err)
+	}
+
+	return &correction, nil
+}
@@ -0,0 +1,54 @@
+package attendancecorrectionrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListParams struct {
+	// Zero values do not filter
+	EmployeeID int64
+	Status     string
+
+	Cursor *utils.Cursor
+	Limit  int
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// List returns the corrections ordered by ID, continuing after params.Cursor.
+func (r *repo) List(ctx context.Context, tx *gorm.DB, params ListParams) ([]*models.AttendanceCorrection, error) {
+	query := tx.Model(&models.AttendanceCorrection{})
+	if params.EmployeeID != 0 {
+		query = query.Where("employee_id = ?", params.EmployeeID)
+	}
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Cursor != nil {
+		query = query.Where("id > ?", params.Cursor.ID)
+	}
+
+	query = query.Order("id ASC")
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	}
+
+	var corrections []*models.AttendanceCorrection
+	if err := query.Find(&corrections).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attendance corrections: %w", err)
+	}
+
+	return corrections, nil
+}
+
+// ListCursor returns the cursor pointing right after the given correction.
+func ListCursor(correction *models.AttendanceCorrection) utils.Cursor {
+	return utils.Cursor{ID: correction.ID}
+}
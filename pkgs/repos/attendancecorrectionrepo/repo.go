@@ -0,0 +1,7 @@
+package attendancecorrectionrepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
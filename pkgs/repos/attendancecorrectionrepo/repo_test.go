@@ -0,0 +1,125 @@
+package attendancecorrectionrepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo_CRUD(t *testing.T) {
+	Convey("TestRepo_CRUD", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		// Prepare test data
+		correction := models.DummyAttendanceCorrection(faker)
+		correction.Kind = models.AttendanceCorrectionKindChange
+		correction.AttendanceID = lo.ToPtr(int64(7))
+		testutils.MustClearTable(t, db, models.AttendanceCorrection{})
+
+		// Create
+		{
+			Print("Create")
+			So(repo.Create(ctx, db, correction), ShouldBeNil)
+			So(correction.ID, ShouldNotEqual, 0)
+			So(correction.CreatedAt.IsZero(), ShouldBeFalse)
+		}
+		// Get
+		{
+			Print("Get")
+			correctionRes, err := repo.GetForUpdate(ctx, db, correction.ID)
+			So(err, ShouldBeNil)
+			So(correctionRes, ShouldNotBeNil)
+			So(correctionRes.EmployeeID, ShouldEqual, correction.EmployeeID)
+			So(*correctionRes.AttendanceID, ShouldEqual, 7)
+			So(correctionRes.ClockIn, ShouldHappenWithin, time.Second, correction.ClockIn)
+			So(correctionRes.Status, ShouldEqual, models.AttendanceCorrectionStatusPending)
+
+			correctionRes, err = repo.Get(ctx, db, correction.ID+1)
+			So(err, ShouldBeNil)
+			So(correctionRes, ShouldBeNil)
+		}
+		// Pending by attendance
+		{
+			Print("Pending by attendance")
+			correctionRes, err := repo.GetPendingByAttendanceID(ctx, db, 7)
+			So(err, ShouldBeNil)
+			So(correctionRes, ShouldNotBeNil)
+			So(correctionRes.ID, ShouldEqual, correction.ID)
+		}
+		// Save
+		{
+			Print("Save")
+			correction.Status = models.AttendanceCorrectionStatusRejected
+			correction.ReviewerID = lo.ToPtr(int64(99))
+			So(repo.Save(ctx, db, correction), ShouldBeNil)
+
+			correctionRes, err := repo.GetPendingByAttendanceID(ctx, db, 7)
+			So(err, ShouldBeNil)
+			So(correctionRes, ShouldBeNil)
+		}
+	})
+}
+
+func TestRepo_List(t *testing.T) {
+	Convey("TestRepo_List", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		testutils.MustClearTable(t, db, models.AttendanceCorrection{})
+
+		// Prepare test data
+		corrections := make([]*models.AttendanceCorrection, 4)
+		for i := range corrections {
+			corrections[i] = models.DummyAttendanceCorrection(faker)
+			corrections[i].EmployeeID = int64(1 + i%2)
+			So(repo.Create(ctx, db, corrections[i]), ShouldBeNil)
+		}
+		corrections[2].Status = models.AttendanceCorrectionStatusApproved
+		So(repo.Save(ctx, db, corrections[2]), ShouldBeNil)
+
+		// Filter by employee and status
+		{
+			Print("Filter by employee and status")
+			res, err := repo.List(ctx, db, ListParams{EmployeeID: 1})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+
+			res, err = repo.List(ctx, db, ListParams{EmployeeID: 1, Status: models.AttendanceCorrectionStatusPending})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, corrections[0].ID)
+		}
+		// Paginate
+		{
+			Print("Paginate")
+			res, err := repo.List(ctx, db, ListParams{Limit: 3})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 3)
+
+			cursor := ListCursor(res[2])
+			res, err = repo.List(ctx, db, ListParams{Cursor: &cursor, Limit: 3})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, corrections[3].ID)
+		}
+	})
+}
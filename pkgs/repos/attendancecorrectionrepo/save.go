@@ -0,0 +1,17 @@
+package attendancecorrectionrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+func (r *repo) Save(ctx context.Context, tx *gorm.DB, data *models.AttendanceCorrection) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save attendance correction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package departmentrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+func (r *repo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Department, error) {
+	// Create a variable to hold the result
+	var department models.Department
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&department).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get department: %w", err)
+	}
+
+	// Return the result
+	return &department, nil
+}
+
+// GetByName returns the department with the given name. The comparison
+// follows the column collation, which ignores case.
+func (r *repo) GetByName(ctx context.Context, tx *gorm.DB, name string) (*models.Department, error) {
+	// Create a variable to hold the result
+	var department models.Department
+
+	// Execute the query
+	if err := tx.Where("name = ?", name).First(&department).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get department by name: %w", err)
+	}
+
+	// Return the result
+	return &department, nil
+}
@@ -0,0 +1,39 @@
+package departmentrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// List returns every department ordered by ID.
+func (r *repo) List(ctx context.Context, tx *gorm.DB) ([]*models.Department, error) {
+	// Create a variable to hold the result
+	var departments []*models.Department
+
+	// Execute the query
+	if err := tx.Order("id ASC").Find(&departments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list departments: %w", err)
+	}
+
+	return departments, nil
+}
+
+// ListByParentID returns the direct children of the department ordered by ID.
+func (r *repo) ListByParentID(ctx context.Context, tx *gorm.DB, parentID int64) ([]*models.Department, error) {
+	// Create a variable to hold the result
+	var departments []*models.Department
+
+	// Execute the query
+	if err := tx.Where("parent_id = ?", parentID).
+		Order("id ASC").
+		Find(&departments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list child departments: %w", err)
+	}
+
+	return departments, nil
+}
@@ -0,0 +1,7 @@
+package departmentrepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
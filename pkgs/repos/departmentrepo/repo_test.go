@@ -0,0 +1,132 @@
+package departmentrepo
+
+import (
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/samber/lo"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo_CRUD(t *testing.T) {
+	Convey("TestRepo_CRUD", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		// Prepare test data
+		parent := models.DummyDepartment(faker)
+		child := models.DummyDepartment(faker)
+
+		testutils.MustClearTable(t, db, models.Department{})
+
+		// Create
+		{
+			Print("Create")
+
+			err := repo.Create(ctx, db, parent)
+			So(err, ShouldBeNil)
+			So(parent.ID, ShouldNotBeZeroValue)
+
+			child.ParentID = lo.ToPtr(parent.ID)
+			child.HeadEmployeeID = lo.ToPtr(int64(42))
+			err = repo.Create(ctx, db, child)
+			So(err, ShouldBeNil)
+		}
+
+		// Create a duplicate name
+		{
+			Print("Create a duplicate name")
+
+			duplicate := &models.Department{Name: parent.Name}
+			err := repo.Create(ctx, db, duplicate)
+			So(err, ShouldNotBeNil)
+		}
+
+		// Get
+		{
+			Print("Get")
+
+			departmentRes, err := repo.Get(ctx, db, child.ID)
+			So(err, ShouldBeNil)
+			So(departmentRes, ShouldNotBeNil)
+			So(departmentRes.Name, ShouldEqual, child.Name)
+			So(*departmentRes.ParentID, ShouldEqual, parent.ID)
+			So(*departmentRes.HeadEmployeeID, ShouldEqual, 42)
+
+			departmentRes, err = repo.Get(ctx, db, child.ID+100)
+			So(err, ShouldBeNil)
+			So(departmentRes, ShouldBeNil)
+		}
+
+		// GetByName
+		{
+			Print("GetByName")
+
+			departmentRes, err := repo.GetByName(ctx, db, parent.Name)
+			So(err, ShouldBeNil)
+			So(departmentRes, ShouldNotBeNil)
+			So(departmentRes.ID, ShouldEqual, parent.ID)
+
+			departmentRes, err = repo.GetByName(ctx, db, "no such department")
+			So(err, ShouldBeNil)
+			So(departmentRes, ShouldBeNil)
+		}
+
+		// List and ListByParentID
+		{
+			Print("List and ListByParentID")
+
+			departments, err := repo.List(ctx, db)
+			So(err, ShouldBeNil)
+			So(departments, ShouldHaveLength, 2)
+			So(departments[0].ID, ShouldEqual, parent.ID)
+
+			children, err := repo.ListByParentID(ctx, db, parent.ID)
+			So(err, ShouldBeNil)
+			So(children, ShouldHaveLength, 1)
+			So(children[0].ID, ShouldEqual, child.ID)
+		}
+
+		// Save
+		{
+			Print("Save")
+
+			child.ParentID = nil
+			child.HeadEmployeeID = nil
+			err := repo.Save(ctx, db, child)
+			So(err, ShouldBeNil)
+
+			departmentRes, err := repo.Get(ctx, db, child.ID)
+			So(err, ShouldBeNil)
+			So(departmentRes.ParentID, ShouldBeNil)
+			So(departmentRes.HeadEmployeeID, ShouldBeNil)
+		}
+
+		// Delete
+		{
+			Print("Delete")
+
+			deleted, err := repo.Delete(ctx, db, child.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+
+			deleted, err = repo.Delete(ctx, db, child.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeFalse)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package departmentrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) Save(ctx context.Context, tx *gorm.DB, data *models.Department) error {
+	// Save writes the nil parent and head as NULL
+	if err := tx.
+		Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save department: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the department. It returns false when it does not exist.
+func (r *repo) Delete(ctx context.Context, tx *gorm.DB, id int64) (bool, error) {
+	result := tx.Delete(&models.Department{}, id)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete department: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
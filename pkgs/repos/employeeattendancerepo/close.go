@@ -0,0 +1,58 @@
+package employeeattendancerepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CloseOpenByEmployeeID clocks out the employee's open session, if any. A
+// session opened after clockOutTime cannot end then, it is voided instead.
+// It returns nil when the employee has no open session.
+func (r *repo) CloseOpenByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, clockOutTime time.Time) (*models.EmployeeAttendance, error) {
+	employeeAttendance, err := r.Last(ctx, tx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last employee attendance: %w", err)
+	}
+	if employeeAttendance == nil || !employeeAttendance.IsOpen() {
+		return nil, nil
+	}
+	if employeeAttendance.ClockIn.After(clockOutTime) {
+		employeeAttendance.Status = models.AttendanceStatusVoided
+		if err := tx.Save(employeeAttendance).Error; err != nil {
+			return nil, fmt.Errorf("failed to void employee attendance: %w", err)
+		}
+		return employeeAttendance, nil
+	}
+
+	return r.UpdateForClockOut(ctx, tx, employeeAttendance.ID, clockOutTime)
+}
+
+// AutoCloseOpenBefore closes every session opened before clockInBefore and
+// still open, clocking it out maxShift after its clock-in. The sessions are
+// marked auto-closed for review and returned.
+func (r *repo) AutoCloseOpenBefore(ctx context.Context, tx *gorm.DB, clockInBefore time.Time, maxShift time.Duration) ([]*models.EmployeeAttendance, error) {
+	var employeeAttendances []*models.EmployeeAttendance
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("status = ?", models.AttendanceStatusOpen).
+		Where("clock_in < ?", clockInBefore).
+		Order("id ASC").
+		Find(&employeeAttendances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list open employee attendance: %w", err)
+	}
+
+	for _, employeeAttendance := range employeeAttendances {
+		clockOutTime := employeeAttendance.ClockIn.Add(maxShift)
+		employeeAttendance.ClockOut = &clockOutTime
+		employeeAttendance.Status = models.AttendanceStatusAutoClosed
+		if err := tx.Save(employeeAttendance).Error; err != nil {
+			return nil, fmt.Errorf("failed to auto-close employee attendance: %w", err)
+		}
+	}
+
+	return employeeAttendances, nil
+}
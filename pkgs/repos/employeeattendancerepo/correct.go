@@ -0,0 +1,58 @@
+package employeeattendancerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Correct records the current values of the session as a revision, then
+// replaces its times. A corrected session is closed. It returns the revision.
+func (r *repo) Correct(
+	ctx context.Context,
+	tx *gorm.DB,
+	employeeAttendance *models.EmployeeAttendance,
+	correctionID int64,
+	clockInTime time.Time,
+	clockOutTime time.Time,
+) (*models.AttendanceRevision, error) {
+	revision := &models.AttendanceRevision{
+		AttendanceID: employeeAttendance.ID,
+		CorrectionID: correctionID,
+		ClockIn:      employeeAttendance.ClockIn,
+		ClockOut:     employeeAttendance.ClockOut,
+		Status:       employeeAttendance.Status,
+	}
+	if err := tx.Create(revision).Error; err != nil {
+		return nil, fmt.Errorf("failed to create attendance revision: %w", err)
+	}
+
+	employeeAttendance.ClockIn = clockInTime
+	employeeAttendance.ClockOut = &clockOutTime
+	employeeAttendance.Status = models.AttendanceStatusClosed
+	if err := tx.Save(employeeAttendance).Error; err != nil {
+		return nil, fmt.Errorf("failed to correct employee attendance: %w", err)
+	}
+
+	return revision, nil
+}
+
+// GetRevisionByCorrectionID returns the values the correction replaced, nil
+// when it did not replace any.
+func (r *repo) GetRevisionByCorrectionID(ctx context.Context, tx *gorm.DB, correctionID int64) (*models.AttendanceRevision, error) {
+	var revision models.AttendanceRevision
+	if err := tx.Where("correction_id = ?", correctionID).First(&revision).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get attendance revision: %w", err)
+	}
+
+	return &revision, nil
+}
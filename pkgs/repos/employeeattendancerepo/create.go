@@ -15,7 +15,7 @@ func (r *repo) CreateForClockIn(ctx context.Context, tx *gorm.DB, employeeID int
 		EmployeeID: employeeID,
 		PositionID: positionID,
 		ClockIn:    clockInTime,
-		ClockOut:   clockInTime, // Initialize ClockOut to the same time as ClockIn
+		Status:     models.AttendanceStatusOpen,
 	}
 
 	// Execute the insert query
@@ -26,3 +26,12 @@ func (r *repo) CreateForClockIn(ctx context.Context, tx *gorm.DB, employeeID int
 	// Return the created record
 	return employeeAttendance, nil
 }
+
+// Create inserts a session as is, e.g. a closed session added by a correction.
+func (r *repo) Create(ctx context.Context, tx *gorm.DB, data *models.EmployeeAttendance) error {
+	if err := tx.Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create employee attendance: %w", err)
+	}
+
+	return nil
+}
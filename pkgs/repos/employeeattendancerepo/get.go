@@ -0,0 +1,32 @@
+package employeeattendancerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *repo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeAttendance, error) {
+	// Create a variable to hold the result
+	var employeeAttendance models.EmployeeAttendance
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&employeeAttendance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get employee attendance: %w", err)
+	}
+
+	// Return the result
+	return &employeeAttendance, nil
+}
+
+// GetForUpdate is Get with a row lock held until the end of the transaction.
+func (r *repo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeAttendance, error) {
+	return r.Get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
@@ -9,13 +9,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// Last returns the session of the employee clocked in last. Sessions added
+// later by a correction are back-dated, so the insertion order does not tell.
 func (r *repo) Last(ctx context.Context, tx *gorm.DB, employeeID int64) (*models.EmployeeAttendance, error) {
 	// Create a variable to hold the result
 	var employeeAttendance models.EmployeeAttendance
 
 	// Execute the query
 	if err := tx.Where("employee_id = ?", employeeID).
-		Order("created_at DESC").
+		Order("clock_in DESC, id DESC").
 		First(&employeeAttendance).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
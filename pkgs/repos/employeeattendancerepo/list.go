@@ -0,0 +1,101 @@
+package employeeattendancerepo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListParams struct {
+	EmployeeID int64
+
+	// Sessions overlapping [From, To) are returned, an open session overlaps
+	// every window starting before it
+	From time.Time
+	To   time.Time
+
+	Cursor *utils.Cursor
+	Limit  int
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// List returns the employee's sessions in the window ordered by clock-in
+// time, continuing after params.Cursor.
+func (r *repo) List(ctx context.Context, tx *gorm.DB, params ListParams) ([]*models.EmployeeAttendance, error) {
+	query := whereOverlaps(tx.Where("employee_id = ?", params.EmployeeID), params.From, params.To)
+
+	// Keyset pagination: continue strictly after the cursor
+	if params.Cursor != nil {
+		clockIn, err := listCursorValue(params.Cursor.Value)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(
+			"(clock_in > ? OR (clock_in = ? AND id > ?))",
+			clockIn, clockIn, params.Cursor.ID,
+		)
+	}
+	query = query.Order("clock_in ASC").Order("id ASC")
+
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	}
+
+	var employeeAttendances []*models.EmployeeAttendance
+	if err := query.Find(&employeeAttendances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee attendance: %w", err)
+	}
+
+	return employeeAttendances, nil
+}
+
+// ListByEmployeeIDs returns every session of the employees overlapping
+// [from, to), ordered by employee and clock-in time.
+func (r *repo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.EmployeeAttendance, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+
+	var employeeAttendances []*models.EmployeeAttendance
+	if err := whereOverlaps(tx.Where("employee_id IN ?", employeeIDs), from, to).
+		Order("employee_id ASC").
+		Order("clock_in ASC").
+		Order("id ASC").
+		Find(&employeeAttendances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee attendance: %w", err)
+	}
+
+	return employeeAttendances, nil
+}
+
+// ListCursor returns the cursor pointing right after the given session.
+func ListCursor(employeeAttendance *models.EmployeeAttendance) utils.Cursor {
+	return utils.Cursor{
+		Value: strconv.FormatInt(employeeAttendance.ClockIn.UnixNano(), 10),
+		ID:    employeeAttendance.ID,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func listCursorValue(value string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cursor value: %w", err)
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+func whereOverlaps(query *gorm.DB, from, to time.Time) *gorm.DB {
+	return query.Where("clock_in < ?", to).
+		// An open session has no clock_out yet
+		Where("(clock_out > ? OR clock_out IS NULL)", from)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
 	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/samber/lo"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -42,7 +43,8 @@ func TestRepo_CRUD(t *testing.T) {
 			So(employeeAttendanceRes.EmployeeID, ShouldEqual, employeeAttendance.EmployeeID)
 			So(employeeAttendanceRes.PositionID, ShouldEqual, employeeAttendance.PositionID)
 			So(employeeAttendanceRes.ClockIn, ShouldHappenWithin, time.Second, employeeAttendance.ClockIn)
-			So(employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, employeeAttendance.ClockIn)
+			So(employeeAttendanceRes.ClockOut, ShouldBeNil)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusOpen)
 			So(employeeAttendanceRes.CreatedAt.IsZero(), ShouldBeFalse)
 			So(employeeAttendanceRes.UpdatedAt.IsZero(), ShouldBeFalse)
 			So(employeeAttendanceRes.ID, ShouldNotEqual, 0)
@@ -61,7 +63,8 @@ func TestRepo_CRUD(t *testing.T) {
 			So(employeeAttendanceRes.EmployeeID, ShouldEqual, employeeAttendance.EmployeeID)
 			So(employeeAttendanceRes.PositionID, ShouldEqual, employeeAttendance.PositionID)
 			So(employeeAttendanceRes.ClockIn, ShouldHappenWithin, time.Second, employeeAttendance.ClockIn)
-			So(employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, employeeAttendance.ClockIn)
+			So(employeeAttendanceRes.ClockOut, ShouldBeNil)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusOpen)
 			So(employeeAttendanceRes.CreatedAt.IsZero(), ShouldBeFalse)
 			So(employeeAttendanceRes.UpdatedAt.IsZero(), ShouldBeFalse)
 		}
@@ -70,14 +73,15 @@ func TestRepo_CRUD(t *testing.T) {
 		{
 			Print("Update")
 
-			employeeAttendanceRes, err := repo.UpdateForClockOut(ctx, db, employeeAttendance.ID, employeeAttendance.ClockOut)
+			employeeAttendanceRes, err := repo.UpdateForClockOut(ctx, db, employeeAttendance.ID, *employeeAttendance.ClockOut)
 			So(err, ShouldBeNil)
 			So(employeeAttendanceRes, ShouldNotBeNil)
 			So(employeeAttendanceRes.ID, ShouldEqual, employeeAttendance.ID)
 			So(employeeAttendanceRes.EmployeeID, ShouldEqual, employeeAttendance.EmployeeID)
 			So(employeeAttendanceRes.PositionID, ShouldEqual, employeeAttendance.PositionID)
 			So(employeeAttendanceRes.ClockIn, ShouldHappenWithin, time.Second, employeeAttendance.ClockIn)
-			So(employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, employeeAttendance.ClockOut)
+			So(*employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, *employeeAttendance.ClockOut)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusClosed)
 			So(employeeAttendanceRes.CreatedAt.IsZero(), ShouldBeFalse)
 			So(employeeAttendanceRes.UpdatedAt.IsZero(), ShouldBeFalse)
 		}
@@ -85,10 +89,323 @@ func TestRepo_CRUD(t *testing.T) {
 		{
 			Print("Update - Already clocked out")
 
-			employeeAttendanceRes, err := repo.UpdateForClockOut(ctx, db, employeeAttendance.ID, employeeAttendance.ClockOut)
+			employeeAttendanceRes, err := repo.UpdateForClockOut(ctx, db, employeeAttendance.ID, *employeeAttendance.ClockOut)
 			So(err, ShouldNotBeNil)
 			So(employeeAttendanceRes, ShouldBeNil)
 			So(err.Error(), ShouldEqual, "attendance record already clocked out")
 		}
 	})
 }
+
+func TestRepo_CloseOpenByEmployeeID(t *testing.T) {
+	Convey("TestRepo_CloseOpenByEmployeeID", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		// Prepare test data
+		employeeAttendance := models.DummyEmployeeAttendance(faker)
+
+		testutils.MustClearTable(t, db, models.EmployeeAttendance{})
+
+		// No session yet
+		{
+			Print("No session yet")
+
+			employeeAttendanceRes, err := repo.CloseOpenByEmployeeID(ctx, db, employeeAttendance.EmployeeID, *employeeAttendance.ClockOut)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldBeNil)
+		}
+
+		// Open session
+		{
+			Print("Open session")
+
+			created, err := repo.CreateForClockIn(ctx, db, employeeAttendance.EmployeeID, employeeAttendance.PositionID, employeeAttendance.ClockIn)
+			So(err, ShouldBeNil)
+
+			employeeAttendanceRes, err := repo.CloseOpenByEmployeeID(ctx, db, employeeAttendance.EmployeeID, *employeeAttendance.ClockOut)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldNotBeNil)
+			So(employeeAttendanceRes.ID, ShouldEqual, created.ID)
+			So(*employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, *employeeAttendance.ClockOut)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusClosed)
+		}
+
+		// Clocking out within the same second as clocking in
+		{
+			Print("Clocking out within the same second as clocking in")
+			created, err := repo.CreateForClockIn(ctx, db, employeeAttendance.EmployeeID, employeeAttendance.PositionID, employeeAttendance.ClockIn)
+			So(err, ShouldBeNil)
+			employeeAttendanceRes, err := repo.CloseOpenByEmployeeID(ctx, db, employeeAttendance.EmployeeID, employeeAttendance.ClockIn)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldNotBeNil)
+			So(employeeAttendanceRes.ID, ShouldEqual, created.ID)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusClosed)
+		}
+		// Already closed
+		{
+			Print("Already closed")
+
+			employeeAttendanceRes, err := repo.CloseOpenByEmployeeID(ctx, db, employeeAttendance.EmployeeID, *employeeAttendance.ClockOut)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldBeNil)
+		}
+
+		// A session added by an approved correction while clocked in
+		{
+			Print("A session added by an approved correction while clocked in")
+
+			clockIn := employeeAttendance.ClockIn.Add(48 * time.Hour)
+			created, err := repo.CreateForClockIn(ctx, db, employeeAttendance.EmployeeID, employeeAttendance.PositionID, clockIn)
+			So(err, ShouldBeNil)
+
+			// The missing session of the day before is inserted afterwards
+			added := &models.EmployeeAttendance{
+				EmployeeID: employeeAttendance.EmployeeID,
+				PositionID: employeeAttendance.PositionID,
+				ClockIn:    clockIn.Add(-24 * time.Hour),
+				ClockOut:   lo.ToPtr(clockIn.Add(-16 * time.Hour)),
+				Status:     models.AttendanceStatusClosed,
+			}
+			So(repo.Create(ctx, db, added), ShouldBeNil)
+
+			last, err := repo.Last(ctx, db, employeeAttendance.EmployeeID)
+			So(err, ShouldBeNil)
+			So(last.ID, ShouldEqual, created.ID)
+			So(last.IsOpen(), ShouldBeTrue)
+
+			employeeAttendanceRes, err := repo.CloseOpenByEmployeeID(ctx, db, employeeAttendance.EmployeeID, clockIn.Add(8*time.Hour))
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldNotBeNil)
+			So(employeeAttendanceRes.ID, ShouldEqual, created.ID)
+		}
+
+		// Closing before the clock-in, as a back-dated termination does
+		{
+			Print("Closing before the clock-in, as a back-dated termination does")
+
+			clockIn := employeeAttendance.ClockIn.Add(96 * time.Hour)
+			created, err := repo.CreateForClockIn(ctx, db, employeeAttendance.EmployeeID, employeeAttendance.PositionID, clockIn)
+			So(err, ShouldBeNil)
+
+			employeeAttendanceRes, err := repo.CloseOpenByEmployeeID(ctx, db, employeeAttendance.EmployeeID, clockIn.Add(-24*time.Hour))
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldNotBeNil)
+			So(employeeAttendanceRes.ID, ShouldEqual, created.ID)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusVoided)
+			So(employeeAttendanceRes.ClockOut, ShouldBeNil)
+		}
+	})
+}
+
+func TestRepo_List(t *testing.T) {
+	Convey("TestRepo_List", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		employeeID := int64(1)
+		day := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		testutils.MustClearTable(t, db, models.EmployeeAttendance{})
+
+		// Prepare test data: a session across midnight, two sessions on the
+		// next day, the last one still open, and a session of another employee
+		sessions := []*models.EmployeeAttendance{
+			{EmployeeID: employeeID, PositionID: 1, ClockIn: day.Add(-2 * time.Hour), ClockOut: lo.ToPtr(day.Add(2 * time.Hour)), Status: models.AttendanceStatusClosed},
+			{EmployeeID: employeeID, PositionID: 1, ClockIn: day.Add(9 * time.Hour), ClockOut: lo.ToPtr(day.Add(12 * time.Hour)), Status: models.AttendanceStatusClosed},
+			{EmployeeID: employeeID, PositionID: 1, ClockIn: day.Add(13 * time.Hour), Status: models.AttendanceStatusOpen},
+			{EmployeeID: employeeID + 1, PositionID: 2, ClockIn: day.Add(9 * time.Hour), ClockOut: lo.ToPtr(day.Add(17 * time.Hour)), Status: models.AttendanceStatusClosed},
+		}
+		for _, session := range sessions {
+			So(db.Create(session).Error, ShouldBeNil)
+		}
+
+		// Sessions overlapping the window
+		{
+			Print("Sessions overlapping the window")
+
+			res, err := repo.List(ctx, db, ListParams{EmployeeID: employeeID, From: day, To: day.AddDate(0, 0, 1)})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 3)
+			So(res[0].ID, ShouldEqual, sessions[0].ID)
+			So(res[2].ID, ShouldEqual, sessions[2].ID)
+		}
+
+		// Paginate
+		{
+			Print("Paginate")
+
+			res, err := repo.List(ctx, db, ListParams{EmployeeID: employeeID, From: day, To: day.AddDate(0, 0, 1), Limit: 2})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+
+			cursor := ListCursor(res[1])
+			res, err = repo.List(ctx, db, ListParams{EmployeeID: employeeID, From: day, To: day.AddDate(0, 0, 1), Cursor: &cursor, Limit: 2})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, sessions[2].ID)
+		}
+
+		// A window after the closed sessions still holds the open one
+		{
+			Print("A window after the closed sessions still holds the open one")
+
+			res, err := repo.List(ctx, db, ListParams{EmployeeID: employeeID, From: day.AddDate(0, 0, 1), To: day.AddDate(0, 0, 2)})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, sessions[2].ID)
+		}
+	})
+}
+
+func TestRepo_ListByEmployeeIDs(t *testing.T) {
+	Convey("TestRepo_ListByEmployeeIDs", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		day := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+		testutils.MustClearTable(t, db, models.EmployeeAttendance{})
+
+		// Prepare test data
+		sessions := []*models.EmployeeAttendance{
+			{EmployeeID: 2, PositionID: 2, ClockIn: day.Add(9 * time.Hour), ClockOut: lo.ToPtr(day.Add(17 * time.Hour)), Status: models.AttendanceStatusClosed},
+			{EmployeeID: 1, PositionID: 1, ClockIn: day.Add(10 * time.Hour), Status: models.AttendanceStatusOpen},
+			{EmployeeID: 1, PositionID: 1, ClockIn: day.Add(8 * time.Hour), ClockOut: lo.ToPtr(day.Add(9 * time.Hour)), Status: models.AttendanceStatusClosed},
+			{EmployeeID: 3, PositionID: 3, ClockIn: day.Add(9 * time.Hour), ClockOut: lo.ToPtr(day.Add(17 * time.Hour)), Status: models.AttendanceStatusClosed},
+			{EmployeeID: 1, PositionID: 1, ClockIn: day.AddDate(0, 0, -1), ClockOut: lo.ToPtr(day.AddDate(0, 0, -1).Add(time.Hour)), Status: models.AttendanceStatusClosed},
+		}
+		for _, session := range sessions {
+			So(db.Create(session).Error, ShouldBeNil)
+		}
+
+		// Sessions of the employees in the window
+		{
+			Print("Sessions of the employees in the window")
+			res, err := repo.ListByEmployeeIDs(ctx, db, []int64{1, 2}, day, day.AddDate(0, 0, 1))
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 3)
+			So(res[0].ID, ShouldEqual, sessions[2].ID)
+			So(res[1].ID, ShouldEqual, sessions[1].ID)
+			So(res[2].ID, ShouldEqual, sessions[0].ID)
+		}
+		// No employees
+		{
+			Print("No employees")
+			res, err := repo.ListByEmployeeIDs(ctx, db, nil, day, day.AddDate(0, 0, 1))
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		}
+	})
+}
+
+func TestRepo_AutoCloseOpenBefore(t *testing.T) {
+	Convey("TestRepo_AutoCloseOpenBefore", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		nowTime := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+		testutils.MustClearTable(t, db, models.EmployeeAttendance{})
+
+		// Prepare test data: a forgotten session, a session still in its
+		// shift and a session closed long ago
+		sessions := []*models.EmployeeAttendance{
+			{EmployeeID: 1, PositionID: 1, ClockIn: nowTime.AddDate(0, 0, -3), Status: models.AttendanceStatusOpen},
+			{EmployeeID: 2, PositionID: 2, ClockIn: nowTime.Add(-2 * time.Hour), Status: models.AttendanceStatusOpen},
+			{EmployeeID: 3, PositionID: 3, ClockIn: nowTime.AddDate(0, 0, -5), ClockOut: lo.ToPtr(nowTime.AddDate(0, 0, -5).Add(8 * time.Hour)), Status: models.AttendanceStatusClosed},
+		}
+		for _, session := range sessions {
+			So(db.Create(session).Error, ShouldBeNil)
+		}
+
+		// Close the forgotten sessions
+		{
+			Print("Close the forgotten sessions")
+			res, err := repo.AutoCloseOpenBefore(ctx, db, nowTime.Add(-16*time.Hour), 16*time.Hour)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, sessions[0].ID)
+
+			employeeAttendanceRes, err := repo.Last(ctx, db, 1)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusAutoClosed)
+			So(*employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, sessions[0].ClockIn.Add(16*time.Hour))
+
+			employeeAttendanceRes, err = repo.Last(ctx, db, 2)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes.IsOpen(), ShouldBeTrue)
+		}
+		// Nothing left to close
+		{
+			Print("Nothing left to close")
+			res, err := repo.AutoCloseOpenBefore(ctx, db, nowTime.Add(-16*time.Hour), 16*time.Hour)
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		}
+	})
+}
+
+func TestRepo_Correct(t *testing.T) {
+	Convey("TestRepo_Correct", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		testutils.MustClearTable(t, db, models.EmployeeAttendance{})
+		testutils.MustClearTable(t, db, models.AttendanceRevision{})
+
+		// Prepare test data
+		employeeAttendance := models.DummyEmployeeAttendance(faker)
+		So(repo.Create(ctx, db, employeeAttendance), ShouldBeNil)
+		original := *employeeAttendance
+
+		// Get
+		{
+			Print("Get")
+			employeeAttendanceRes, err := repo.GetForUpdate(ctx, db, employeeAttendance.ID)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldNotBeNil)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusClosed)
+
+			employeeAttendanceRes, err = repo.Get(ctx, db, employeeAttendance.ID+1)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes, ShouldBeNil)
+		}
+		// Correct
+		{
+			Print("Correct")
+			clockIn := original.ClockIn.Add(-time.Hour)
+			clockOut := original.ClockOut.Add(time.Hour)
+			revision, err := repo.Correct(ctx, db, employeeAttendance, 42, clockIn, clockOut)
+			So(err, ShouldBeNil)
+			So(revision.ID, ShouldNotBeZeroValue)
+
+			employeeAttendanceRes, err := repo.Get(ctx, db, employeeAttendance.ID)
+			So(err, ShouldBeNil)
+			So(employeeAttendanceRes.ClockIn, ShouldHappenWithin, time.Second, clockIn)
+			So(*employeeAttendanceRes.ClockOut, ShouldHappenWithin, time.Second, clockOut)
+			So(employeeAttendanceRes.Status, ShouldEqual, models.AttendanceStatusClosed)
+		}
+		// The original values are kept as a revision
+		{
+			Print("The original values are kept as a revision")
+			revision, err := repo.GetRevisionByCorrectionID(ctx, db, 42)
+			So(err, ShouldBeNil)
+			So(revision, ShouldNotBeNil)
+			So(revision.AttendanceID, ShouldEqual, employeeAttendance.ID)
+			So(revision.ClockIn, ShouldHappenWithin, time.Second, original.ClockIn)
+			So(*revision.ClockOut, ShouldHappenWithin, time.Second, *original.ClockOut)
+			So(revision.Status, ShouldEqual, original.Status)
+
+			revision, err = repo.GetRevisionByCorrectionID(ctx, db, 43)
+			So(err, ShouldBeNil)
+			So(revision, ShouldBeNil)
+		}
+	})
+}
@@ -21,12 +21,13 @@ func (r *repo) UpdateForClockOut(ctx context.Context, tx *gorm.DB, attendanceID
 		return nil, fmt.Errorf("failed to get employee attendance: %w", err)
 	}
 	// Check if the attendance record is already clocked out
-	if employeeAttendance.ClockOut != employeeAttendance.ClockIn {
+	if !employeeAttendance.IsOpen() {
 		return nil, fmt.Errorf("attendance record already clocked out")
 	}
 
 	// Update the clock-out time
-	employeeAttendance.ClockOut = clockOutTime
+	employeeAttendance.ClockOut = &clockOutTime
+	employeeAttendance.Status = models.AttendanceStatusClosed
 	// Save the updated record
 	if err := tx.Save(&employeeAttendance).Error; err != nil {
 		return nil, fmt.Errorf("failed to update employee attendance: %w", err)
@@ -9,6 +9,11 @@ import (
 )
 
 func (r *repo) Create(ctx context.Context, tx *gorm.DB, data *models.EmployeeInfo) error {
+	// New records start at the first version
+	if data.Version == 0 {
+		data.Version = 1
+	}
+
 	if err := tx.
 		Create(data).Error; err != nil {
 		return fmt.Errorf("failed to create employee info: %w", err)
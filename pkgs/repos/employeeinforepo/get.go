@@ -7,6 +7,7 @@ import (
 
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func (r *repo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
@@ -36,3 +37,44 @@ func (r *repo) MustGet(ctx context.Context, tx *gorm.DB, id int64) (*models.Empl
 
 	return employeeInfo, nil
 }
+
+// ListByIDs returns the employee infos of the ids, the missing ones are left
+// out.
+func (r *repo) ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var employeeInfos []*models.EmployeeInfo
+	if err := tx.Where("id IN ?", ids).Order("id ASC").Find(&employeeInfos).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee infos: %w", err)
+	}
+
+	return employeeInfos, nil
+}
+
+// ListByEmails returns the active employees using one of the emails. The
+// comparison follows the collation of the column.
+func (r *repo) ListByEmails(ctx context.Context, tx *gorm.DB, emails []string) ([]*models.EmployeeInfo, error) {
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	var employeeInfos []*models.EmployeeInfo
+	if err := tx.Where("email IN ?", emails).Order("id ASC").Find(&employeeInfos).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee infos: %w", err)
+	}
+
+	return employeeInfos, nil
+}
+
+// ListByIDsWithTerminated is ListByIDs including the terminated employees.
+func (r *repo) ListByIDsWithTerminated(ctx context.Context, tx *gorm.DB, ids []int64) ([]*models.EmployeeInfo, error) {
+	return r.ListByIDs(ctx, tx.Unscoped(), ids)
+}
+
+// GetForUpdate is Get with a row lock held until the end of the transaction.
+// It serializes the writes that depend on the state of one employee.
+func (r *repo) GetForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	return r.Get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
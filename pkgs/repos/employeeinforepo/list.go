@@ -0,0 +1,166 @@
+package employeeinforepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ErrInvalidCursor is returned by List when the cursor was not issued for the
+// requested sort.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type ListSort string
+
+const (
+	ListSortID        ListSort = "id"
+	ListSortName      ListSort = "name"
+	ListSortCreatedAt ListSort = "created_at"
+)
+
+type ListParams struct {
+	// Filters on the employee info
+	Name  string
+	Email string
+
+	// Filters on the position effective at AsOf
+	Department   string
+	DepartmentID int64
+	Position     string
+	AsOf         time.Time
+
+	SortBy ListSort
+	Desc   bool
+	Cursor *utils.Cursor
+	Limit  int
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// List returns the employees that hold a position effective at params.AsOf,
+// ordered by params.SortBy and continuing after params.Cursor.
+func (r *repo) List(ctx context.Context, tx *gorm.DB, params ListParams) ([]*models.EmployeeInfo, error) {
+	sortColumn, err := listSortColumn(params.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Join the position effective at AsOf, the same one GetCurrentByEmployeeID returns
+	query := tx.Model(&models.EmployeeInfo{}).
+		Select("employeeinfo.*").
+		Joins(
+			"JOIN employeeposition ep ON ep.id = ("+
+				"SELECT p.id FROM employeeposition p "+
+				"WHERE p.employee_id = employeeinfo.id AND p.start_date <= ? "+
+				"ORDER BY p.start_date DESC, p.id DESC LIMIT 1)",
+			params.AsOf,
+		)
+
+	if params.Name != "" {
+		query = query.Where("employeeinfo.name LIKE ?", "%"+escapeLike(params.Name)+"%")
+	}
+	if params.Email != "" {
+		query = query.Where("employeeinfo.email LIKE ?", "%"+escapeLike(params.Email)+"%")
+	}
+	if params.Department != "" {
+		query = query.Where("ep.department = ?", params.Department)
+	}
+	if params.DepartmentID != 0 {
+		query = query.Where("ep.department_id = ?", params.DepartmentID)
+	}
+	if params.Position != "" {
+		query = query.Where("ep.position = ?", params.Position)
+	}
+
+	// Keyset pagination: continue strictly after the cursor in sort order
+	op, direction := ">", "ASC"
+	if params.Desc {
+		op, direction = "<", "DESC"
+	}
+	if params.Cursor != nil {
+		if sortColumn == "employeeinfo.id" {
+			query = query.Where("employeeinfo.id "+op+" ?", params.Cursor.ID)
+		} else {
+			value, err := listCursorValue(params.SortBy, params.Cursor.Value)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(
+				"("+sortColumn+" "+op+" ? OR ("+sortColumn+" = ? AND employeeinfo.id "+op+" ?))",
+				value, value, params.Cursor.ID,
+			)
+		}
+	}
+	if sortColumn != "employeeinfo.id" {
+		query = query.Order(sortColumn + " " + direction)
+	}
+	query = query.Order("employeeinfo.id " + direction)
+
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	}
+
+	var employeeInfos []*models.EmployeeInfo
+	if err := query.Find(&employeeInfos).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee info: %w", err)
+	}
+
+	return employeeInfos, nil
+}
+
+// ListCursor returns the cursor pointing right after the given employee.
+func ListCursor(sortBy ListSort, employeeInfo *models.EmployeeInfo) utils.Cursor {
+	switch sortBy {
+	case ListSortName:
+		return utils.Cursor{Value: employeeInfo.Name, ID: employeeInfo.ID}
+	case ListSortCreatedAt:
+		return utils.Cursor{Value: strconv.FormatInt(employeeInfo.CreatedAt.UnixNano(), 10), ID: employeeInfo.ID}
+	default:
+		return utils.Cursor{ID: employeeInfo.ID}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func listSortColumn(sortBy ListSort) (string, error) {
+	switch sortBy {
+	case "", ListSortID:
+		return "employeeinfo.id", nil
+	case ListSortName:
+		return "employeeinfo.name", nil
+	case ListSortCreatedAt:
+		return "employeeinfo.created_at", nil
+	default:
+		return "", fmt.Errorf("unsupported sort: %s", sortBy)
+	}
+}
+
+func listCursorValue(sortBy ListSort, value string) (any, error) {
+	if sortBy != ListSortCreatedAt {
+		return value, nil
+	}
+
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern with the default escape
+// character of MySQL.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes every character of s match itself in a LIKE pattern.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -1,7 +1,10 @@
 package employeeinforepo
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
 	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
@@ -38,6 +41,7 @@ func TestRepo_CRUD(t *testing.T) {
 
 			err := repo.Create(ctx, db, employeeInfo)
 			So(err, ShouldBeNil)
+			So(employeeInfo.Version, ShouldEqual, 1)
 		}
 
 		// Get
@@ -56,6 +60,41 @@ func TestRepo_CRUD(t *testing.T) {
 			So(employeeInfoRes.CreatedAt, ShouldHappenOnOrAfter, employeeInfo.CreatedAt)
 			So(employeeInfoRes.UpdatedAt, ShouldHappenOnOrAfter, employeeInfo.UpdatedAt)
 			So(employeeInfoRes.DeleteAt, ShouldResemble, gorm.DeletedAt{})
+			So(employeeInfoRes.TimeZone, ShouldEqual, models.DefaultTimeZone)
+		}
+
+		// ListByIDs
+		{
+			Print("ListByIDs")
+
+			employeeInfos, err := repo.ListByIDs(ctx, db, []int64{employeeInfo.ID, employeeInfo.ID + 1})
+			So(err, ShouldBeNil)
+			So(employeeInfos, ShouldHaveLength, 1)
+			So(employeeInfos[0].ID, ShouldEqual, employeeInfo.ID)
+		}
+
+		// ListByEmails
+		{
+			Print("ListByEmails")
+
+			employeeInfos, err := repo.ListByEmails(ctx, db, []string{employeeInfo.Email, "nobody@example.com"})
+			So(err, ShouldBeNil)
+			So(employeeInfos, ShouldHaveLength, 1)
+			So(employeeInfos[0].ID, ShouldEqual, employeeInfo.ID)
+		}
+
+		// GetForUpdate
+		{
+			Print("GetForUpdate")
+
+			err := db.Transaction(func(tx *gorm.DB) error {
+				employeeInfoRes, err := repo.GetForUpdate(ctx, tx, employeeInfo.ID)
+				So(err, ShouldBeNil)
+				So(employeeInfoRes, ShouldNotBeNil)
+				So(employeeInfoRes.ID, ShouldEqual, employeeInfo.ID)
+				return nil
+			})
+			So(err, ShouldBeNil)
 		}
 
 		// Save
@@ -83,6 +122,206 @@ func TestRepo_CRUD(t *testing.T) {
 			So(employeeInfoRes.CreatedAt, ShouldHappenOnOrAfter, employeeInfo.CreatedAt)
 			So(employeeInfoRes.UpdatedAt, ShouldHappenOnOrAfter, employeeInfo.UpdatedAt)
 			So(employeeInfoRes.DeleteAt, ShouldResemble, gorm.DeletedAt{})
+			So(employeeInfoRes.Version, ShouldEqual, 2)
+			So(employeeInfo.Version, ShouldEqual, 2)
+		}
+
+		// Save a stale copy
+		{
+			Print("Save a stale copy")
+
+			staleEmployeeInfo := *employeeInfo
+			staleEmployeeInfo.Version = 1
+			staleEmployeeInfo.Name = faker.Name()
+
+			err := repo.Save(ctx, db, &staleEmployeeInfo)
+			So(err, ShouldEqual, ErrVersionConflict)
+			So(staleEmployeeInfo.Version, ShouldEqual, 1)
+
+			employeeInfoRes, err := repo.Get(ctx, db, employeeInfo.ID)
+			So(err, ShouldBeNil)
+			So(employeeInfoRes.Name, ShouldEqual, employeeInfo.Name)
+			So(employeeInfoRes.Version, ShouldEqual, 2)
+		}
+	})
+}
+
+func TestRepo_List(t *testing.T) {
+	Convey("TestRepo_List", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		nowTime := time.Now()
+
+		testutils.MustClearTable(t, db, models.EmployeeInfo{})
+		testutils.MustClearTable(t, db, models.EmployeePosition{})
+
+		// Prepare test data
+		employeeInfos := make([]*models.EmployeeInfo, 0, 3)
+		departmentIDs := map[string]int64{"Engineering": 1, "Product": 2}
+		for i, department := range []string{"Engineering", "Engineering", "Product"} {
+			employeeInfo := models.DummyEmployeeInfo(faker)
+			employeeInfo.Name = fmt.Sprintf("employee-%d", i)
+			So(repo.Create(ctx, db, employeeInfo), ShouldBeNil)
+			employeeInfos = append(employeeInfos, employeeInfo)
+
+			employeePosition := models.DummyEmployeePosition(faker)
+			employeePosition.EmployeeID = employeeInfo.ID
+			employeePosition.Department = department
+			employeePosition.DepartmentID = departmentIDs[department]
+			employeePosition.StartDate = nowTime.AddDate(0, 0, -1)
+			So(db.Create(employeePosition).Error, ShouldBeNil)
+		}
+
+		// Filter by department
+		{
+			Print("Filter by department")
+
+			res, err := repo.List(ctx, db, ListParams{Department: "Engineering", AsOf: nowTime})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, employeeInfos[0].ID)
+			So(res[1].ID, ShouldEqual, employeeInfos[1].ID)
+		}
+
+		// Filter by department ID
+		{
+			Print("Filter by department ID")
+
+			res, err := repo.List(ctx, db, ListParams{DepartmentID: departmentIDs["Product"], AsOf: nowTime})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, employeeInfos[2].ID)
+		}
+
+		// Paginate by name descending
+		{
+			Print("Paginate by name descending")
+
+			res, err := repo.List(ctx, db, ListParams{AsOf: nowTime, SortBy: ListSortName, Desc: true, Limit: 2})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, employeeInfos[2].ID)
+
+			cursor := ListCursor(ListSortName, res[1])
+			res, err = repo.List(ctx, db, ListParams{AsOf: nowTime, SortBy: ListSortName, Desc: true, Cursor: &cursor, Limit: 2})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, employeeInfos[0].ID)
+		}
+
+		// A cursor of another sort
+		{
+			Print("A cursor of another sort")
+
+			cursor := ListCursor(ListSortName, employeeInfos[0])
+			_, err := repo.List(ctx, db, ListParams{AsOf: nowTime, SortBy: ListSortCreatedAt, Cursor: &cursor})
+			So(errors.Is(err, ErrInvalidCursor), ShouldBeTrue)
+		}
+
+		// Wildcards in the filters match themselves
+		{
+			Print("Wildcards in the filters match themselves")
+
+			res, err := repo.List(ctx, db, ListParams{Name: "ee-2", AsOf: nowTime})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, employeeInfos[2].ID)
+
+			res, err = repo.List(ctx, db, ListParams{Name: "_", AsOf: nowTime})
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+
+			res, err = repo.List(ctx, db, ListParams{Name: "e%-2", AsOf: nowTime})
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+
+			res, err = repo.List(ctx, db, ListParams{Email: `\`, AsOf: nowTime})
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		}
+
+		// Employees without an effective position are excluded
+		{
+			Print("Employees without an effective position are excluded")
+
+			res, err := repo.List(ctx, db, ListParams{AsOf: nowTime.AddDate(0, 0, -2)})
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		}
+	})
+}
+
+func TestRepo_TerminateAndReinstate(t *testing.T) {
+	Convey("TestRepo_TerminateAndReinstate", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		// Prepare test data
+		employeeInfo := models.DummyEmployeeInfo(faker)
+
+		testutils.MustClearTable(t, db, models.EmployeeInfo{})
+		So(repo.Create(ctx, db, employeeInfo), ShouldBeNil)
+
+		// Reinstate an active employee
+		{
+			Print("Reinstate an active employee")
+
+			employeeInfoRes, err := repo.Reinstate(ctx, db, employeeInfo.ID)
+			So(err, ShouldBeNil)
+			So(employeeInfoRes, ShouldBeNil)
+		}
+
+		// Terminate
+		{
+			Print("Terminate")
+
+			terminatedAt := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+			err := repo.Terminate(ctx, db, employeeInfo.ID, terminatedAt, "resigned")
+			So(err, ShouldBeNil)
+
+			employeeInfoRes, err := repo.Get(ctx, db, employeeInfo.ID)
+			So(err, ShouldBeNil)
+			So(employeeInfoRes, ShouldBeNil)
+
+			var terminated models.EmployeeInfo
+			So(db.Unscoped().First(&terminated, employeeInfo.ID).Error, ShouldBeNil)
+			So(terminated.TerminatedAt, ShouldNotBeNil)
+			So(terminated.TerminationReason, ShouldEqual, "resigned")
+			So(terminated.DeleteAt.Valid, ShouldBeTrue)
+
+			employeeInfos, err := repo.ListByIDsWithTerminated(ctx, db, []int64{employeeInfo.ID})
+			So(err, ShouldBeNil)
+			So(employeeInfos, ShouldHaveLength, 1)
+			So(employeeInfos[0].TerminatedAt, ShouldNotBeNil)
+		}
+
+		// Reinstate
+		{
+			Print("Reinstate")
+
+			employeeInfoRes, err := repo.Reinstate(ctx, db, employeeInfo.ID)
+			So(err, ShouldBeNil)
+			So(employeeInfoRes, ShouldNotBeNil)
+
+			employeeInfoRes, err = repo.Get(ctx, db, employeeInfo.ID)
+			So(err, ShouldBeNil)
+			So(employeeInfoRes, ShouldNotBeNil)
+			So(employeeInfoRes.TerminatedAt, ShouldBeNil)
+			So(employeeInfoRes.TerminationReason, ShouldBeEmpty)
+		}
+
+		// Terminate an unknown employee
+		{
+			Print("Terminate an unknown employee")
+
+			err := repo.Terminate(ctx, db, -1, time.Now(), "resigned")
+			So(err, ShouldNotBeNil)
 		}
 	})
 }
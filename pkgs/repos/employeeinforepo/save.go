@@ -2,12 +2,21 @@ package employeeinforepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
 	"gorm.io/gorm"
 )
 
+////////////////////////////////////////////////////////////////////////////////
+
+// ErrVersionConflict is returned by Save when the record was written by someone
+// else since it was read.
+var ErrVersionConflict = errors.New("employee info version conflict")
+
+// Save updates the record only if it is still at the version of data, and bumps
+// the version. It creates the record if it does not exist yet.
 func (r *repo) Save(ctx context.Context, tx *gorm.DB, data *models.EmployeeInfo) error {
 	// Check if the employee info already exists
 	existingEmployeeInfo, err := r.Get(ctx, tx, data.ID)
@@ -20,9 +29,21 @@ func (r *repo) Save(ctx context.Context, tx *gorm.DB, data *models.EmployeeInfo)
 		return r.Create(ctx, tx, data)
 	}
 
-	// If it exists, update the existing record
-	if err := tx.Save(data).Error; err != nil {
-		return fmt.Errorf("failed to save employee info: %w", err)
+	// If it exists, update the existing record at the expected version
+	version := data.Version
+	data.Version = version + 1
+	result := tx.Model(data).
+		Where("version = ?", version).
+		Select("*").
+		Omit("id", "created_at", "delete_at").
+		Updates(data)
+	if result.Error != nil {
+		data.Version = version
+		return fmt.Errorf("failed to save employee info: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		data.Version = version
+		return ErrVersionConflict
 	}
 
 	return nil
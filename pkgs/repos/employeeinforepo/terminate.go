@@ -0,0 +1,67 @@
+package employeeinforepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Terminate records the termination date and reason, then soft deletes the employee.
+func (r *repo) Terminate(ctx context.Context, tx *gorm.DB, id int64, terminatedAt time.Time, reason string) error {
+	result := tx.Model(&models.EmployeeInfo{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"terminated_at":      terminatedAt,
+			"termination_reason": reason,
+			"version":            gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to record employee termination: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("employee info not found")
+	}
+
+	if err := tx.Delete(&models.EmployeeInfo{}, id).Error; err != nil {
+		return fmt.Errorf("failed to delete employee info: %w", err)
+	}
+
+	return nil
+}
+
+// Reinstate undoes Terminate. It returns nil if the employee is not terminated.
+func (r *repo) Reinstate(ctx context.Context, tx *gorm.DB, id int64) (*models.EmployeeInfo, error) {
+	// Create a variable to hold the result
+	var employeeInfo models.EmployeeInfo
+
+	// Only soft deleted records can be reinstated
+	if err := tx.Unscoped().
+		Where("id = ? AND delete_at IS NOT NULL", id).
+		First(&employeeInfo).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get terminated employee info: %w", err)
+	}
+
+	if err := tx.Unscoped().
+		Model(&employeeInfo).
+		Updates(map[string]any{
+			"delete_at":          nil,
+			"terminated_at":      nil,
+			"termination_reason": "",
+			"version":            gorm.Expr("version + 1"),
+		}).Error; err != nil {
+		return nil, fmt.Errorf("failed to reinstate employee info: %w", err)
+	}
+	employeeInfo.Version++
+
+	// Return the result
+	return &employeeInfo, nil
+}
@@ -0,0 +1,25 @@
+package employeepositionrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// DeletePending removes a position that has not taken effect at nowtime.
+// It returns false when no such position exists.
+func (r *repo) DeletePending(ctx context.Context, tx *gorm.DB, id int64, nowtime time.Time) (bool, error) {
+	// The start_date condition keeps positions already in effect untouched
+	result := tx.Where("id = ? AND start_date > ?", id, nowtime).
+		Delete(&models.EmployeePosition{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete pending employee position: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,37 @@
+package employeepositionrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ListEmployeeIDsByDepartmentID returns the employees holding or having held a
+// position in the department, ordered by ID.
+func (r *repo) ListEmployeeIDsByDepartmentID(ctx context.Context, tx *gorm.DB, departmentID int64) ([]int64, error) {
+	var employeeIDs []int64
+	if err := tx.Model(&models.EmployeePosition{}).
+		Where("department_id = ?", departmentID).
+		Distinct().
+		Order("employee_id ASC").
+		Pluck("employee_id", &employeeIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employees of department: %w", err)
+	}
+
+	return employeeIDs, nil
+}
+
+// RenameDepartment updates the department name copied on the positions.
+func (r *repo) RenameDepartment(ctx context.Context, tx *gorm.DB, departmentID int64, name string) error {
+	if err := tx.Model(&models.EmployeePosition{}).
+		Where("department_id = ?", departmentID).
+		Update("department", name).Error; err != nil {
+		return fmt.Errorf("failed to rename department of employee positions: %w", err)
+	}
+
+	return nil
+}
@@ -59,3 +59,124 @@ func (r *repo) GetCurrentByEmployeeID(ctx context.Context, tx *gorm.DB, employee
 	// Return the result
 	return &employeePosition, nil
 }
+
+// ListCurrentByEmployeeIDs returns the position effective at nowtime for each
+// of the given employees, keyed by employee ID.
+func (r *repo) ListCurrentByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, nowtime time.Time) (map[int64]*models.EmployeePosition, error) {
+	result := make(map[int64]*models.EmployeePosition, len(employeeIDs))
+	if len(employeeIDs) == 0 {
+		return result, nil
+	}
+
+	// Execute the query
+	var employeePositions []*models.EmployeePosition
+	if err := tx.Where("employee_id IN ? AND start_date <= ?", employeeIDs, nowtime).
+		Order("employee_id ASC, start_date DESC, id DESC").
+		Find(&employeePositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list current employee positions: %w", err)
+	}
+
+	// Keep the latest position of each employee
+	for _, employeePosition := range employeePositions {
+		if _, ok := result[employeePosition.EmployeeID]; !ok {
+			result[employeePosition.EmployeeID] = employeePosition
+		}
+	}
+
+	return result, nil
+}
+
+// ListByEmployeeID returns every position of the employee ordered by start date.
+func (r *repo) ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.EmployeePosition, error) {
+	// Create a variable to hold the result
+	var employeePositions []*models.EmployeePosition
+
+	// Execute the query
+	if err := tx.Where("employee_id = ?", employeeID).
+		Order("start_date ASC, id ASC").
+		Find(&employeePositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee positions: %w", err)
+	}
+
+	// Return the result
+	return employeePositions, nil
+}
+
+// ListByEmployeeIDs returns every position of the employees, grouped by
+// employee and ordered by start date.
+func (r *repo) ListByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64) ([]*models.EmployeePosition, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+
+	// Create a variable to hold the result
+	var employeePositions []*models.EmployeePosition
+
+	// Execute the query
+	if err := tx.Where("employee_id IN ?", employeeIDs).
+		Order("employee_id ASC, start_date ASC, id ASC").
+		Find(&employeePositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list employee positions: %w", err)
+	}
+
+	return employeePositions, nil
+}
+
+// ListEffectiveBetween returns the positions of all employees effective on a
+// day of [from, to]: the one current at from and those starting until to,
+// grouped by employee and ordered by start date.
+func (r *repo) ListEffectiveBetween(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.EmployeePosition, error) {
+	// Create a variable to hold the result
+	var employeePositions []*models.EmployeePosition
+
+	// Execute the query, leaving out the positions replaced by from
+	if err := tx.Table("employeeposition p").
+		Select("p.*").
+		Where("p.start_date <= ?", to).
+		Where(
+			"NOT EXISTS (SELECT 1 FROM employeeposition n "+
+				"WHERE n.employee_id = p.employee_id AND n.start_date <= ? "+
+				"AND (n.start_date > p.start_date OR (n.start_date = p.start_date AND n.id > p.id)))",
+			from,
+		).
+		Order("p.employee_id ASC, p.start_date ASC, p.id ASC").
+		Find(&employeePositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list effective employee positions: %w", err)
+	}
+
+	return employeePositions, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ListPending returns the positions of all employees that take effect after nowtime.
+func (r *repo) ListPending(ctx context.Context, tx *gorm.DB, nowtime time.Time) ([]*models.EmployeePosition, error) {
+	// Create a variable to hold the result
+	var employeePositions []*models.EmployeePosition
+
+	// Execute the query
+	if err := tx.Where("start_date > ?", nowtime).
+		Order("start_date ASC, id ASC").
+		Find(&employeePositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list pending employee positions: %w", err)
+	}
+
+	// Return the result
+	return employeePositions, nil
+}
+
+// ListPendingByEmployeeID returns the positions of the employee that take effect after nowtime.
+func (r *repo) ListPendingByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64, nowtime time.Time) ([]*models.EmployeePosition, error) {
+	// Create a variable to hold the result
+	var employeePositions []*models.EmployeePosition
+
+	// Execute the query
+	if err := tx.Where("employee_id = ? AND start_date > ?", employeeID, nowtime).
+		Order("start_date ASC, id ASC").
+		Find(&employeePositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list pending employee positions: %w", err)
+	}
+
+	// Return the result
+	return employeePositions, nil
+}
@@ -108,5 +108,203 @@ func TestRepo_CRUD(t *testing.T) {
 			So(employeePositionRes.StartDate, ShouldEqual, employeePosition2.StartDate)
 			So(employeePositionRes.CreatedAt, ShouldHappenOnOrAfter, employeePosition2.CreatedAt)
 		}
+
+		// ListByEmployeeID
+		{
+			Print("ListByEmployeeID")
+
+			employeePositionsRes, err := repo.ListByEmployeeID(ctx, db, employeePosition.EmployeeID)
+			So(err, ShouldBeNil)
+			So(employeePositionsRes, ShouldHaveLength, 2)
+			So(employeePositionsRes[0].ID, ShouldEqual, employeePosition.ID)
+			So(employeePositionsRes[1].StartDate, ShouldHappenAfter, employeePositionsRes[0].StartDate)
+		}
+	})
+}
+
+func TestRepo_ListCurrentByEmployeeIDs(t *testing.T) {
+	Convey("TestRepo_ListCurrentByEmployeeIDs", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		testutils.MustClearTable(t, db, models.EmployeePosition{})
+
+		// Prepare test data
+		oldPosition := models.DummyEmployeePosition(faker)
+		So(repo.Create(ctx, db, oldPosition, time.Now()), ShouldBeNil)
+
+		newPosition := models.DummyEmployeePosition(faker)
+		newPosition.EmployeeID = oldPosition.EmployeeID
+		newPosition.StartDate = oldPosition.StartDate.AddDate(0, 1, 0)
+		So(repo.Create(ctx, db, newPosition, time.Now()), ShouldBeNil)
+
+		// Before the promotion
+		{
+			Print("Before the promotion")
+
+			res, err := repo.ListCurrentByEmployeeIDs(ctx, db, []int64{oldPosition.EmployeeID}, oldPosition.StartDate)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[oldPosition.EmployeeID].ID, ShouldEqual, oldPosition.ID)
+		}
+
+		// After the promotion
+		{
+			Print("After the promotion")
+
+			res, err := repo.ListCurrentByEmployeeIDs(ctx, db, []int64{oldPosition.EmployeeID, -1}, newPosition.StartDate)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[oldPosition.EmployeeID].ID, ShouldEqual, newPosition.ID)
+		}
+
+		// Every position
+		{
+			Print("Every position")
+
+			res, err := repo.ListByEmployeeIDs(ctx, db, []int64{oldPosition.EmployeeID, -1})
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, oldPosition.ID)
+			So(res[1].ID, ShouldEqual, newPosition.ID)
+		}
+
+		// Effective during a period
+		{
+			Print("ListEffectiveBetween")
+
+			res, err := repo.ListEffectiveBetween(ctx, db, oldPosition.StartDate, newPosition.StartDate.AddDate(0, 0, -1))
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, oldPosition.ID)
+
+			res, err = repo.ListEffectiveBetween(ctx, db, oldPosition.StartDate.AddDate(0, 0, 1), newPosition.StartDate)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, oldPosition.ID)
+			So(res[1].ID, ShouldEqual, newPosition.ID)
+
+			res, err = repo.ListEffectiveBetween(ctx, db, newPosition.StartDate, newPosition.StartDate.AddDate(0, 1, 0))
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, newPosition.ID)
+		}
+	})
+}
+
+func TestRepo_Pending(t *testing.T) {
+	Convey("TestRepo_Pending", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		testutils.MustClearTable(t, db, models.EmployeePosition{})
+
+		// Prepare test data
+		currentPosition := models.DummyEmployeePosition(faker)
+		So(repo.Create(ctx, db, currentPosition, time.Now()), ShouldBeNil)
+
+		pendingPosition := models.DummyEmployeePosition(faker)
+		pendingPosition.EmployeeID = currentPosition.EmployeeID
+		pendingPosition.StartDate = currentPosition.StartDate.AddDate(0, 1, 0)
+		So(repo.Create(ctx, db, pendingPosition, currentPosition.StartDate), ShouldBeNil)
+
+		nowTime := currentPosition.StartDate.AddDate(0, 0, 1)
+
+		// ListPending
+		{
+			Print("ListPending")
+
+			employeePositionsRes, err := repo.ListPending(ctx, db, nowTime)
+			So(err, ShouldBeNil)
+			So(employeePositionsRes, ShouldHaveLength, 1)
+			So(employeePositionsRes[0].ID, ShouldEqual, pendingPosition.ID)
+		}
+
+		// ListPendingByEmployeeID
+		{
+			Print("ListPendingByEmployeeID")
+
+			employeePositionsRes, err := repo.ListPendingByEmployeeID(ctx, db, currentPosition.EmployeeID, nowTime)
+			So(err, ShouldBeNil)
+			So(employeePositionsRes, ShouldHaveLength, 1)
+			So(employeePositionsRes[0].ID, ShouldEqual, pendingPosition.ID)
+		}
+
+		// DeletePending
+		{
+			Print("DeletePending")
+
+			deleted, err := repo.DeletePending(ctx, db, currentPosition.ID, nowTime)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeFalse)
+
+			deleted, err = repo.DeletePending(ctx, db, pendingPosition.ID, nowTime)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+
+			employeePositionRes, err := repo.Get(ctx, db, pendingPosition.ID)
+			So(err, ShouldBeNil)
+			So(employeePositionRes, ShouldBeNil)
+		}
+	})
+}
+
+func TestRepo_Department(t *testing.T) {
+	Convey("TestRepo_Department", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		testutils.MustClearTable(t, db, models.EmployeePosition{})
+
+		// Prepare test data
+		positions := []*models.EmployeePosition{
+			models.DummyEmployeePosition(faker),
+			models.DummyEmployeePosition(faker),
+			models.DummyEmployeePosition(faker),
+		}
+		positions[0].EmployeeID, positions[0].DepartmentID = 2, 1
+		positions[1].EmployeeID, positions[1].DepartmentID = 1, 1
+		positions[2].EmployeeID, positions[2].DepartmentID = 3, 2
+		for _, position := range positions {
+			So(db.Create(position).Error, ShouldBeNil)
+		}
+
+		// ListEmployeeIDsByDepartmentID
+		{
+			Print("ListEmployeeIDsByDepartmentID")
+
+			employeeIDs, err := repo.ListEmployeeIDsByDepartmentID(ctx, db, 1)
+			So(err, ShouldBeNil)
+			So(employeeIDs, ShouldResemble, []int64{1, 2})
+
+			employeeIDs, err = repo.ListEmployeeIDsByDepartmentID(ctx, db, 3)
+			So(err, ShouldBeNil)
+			So(employeeIDs, ShouldBeEmpty)
+		}
+
+		// RenameDepartment
+		{
+			Print("RenameDepartment")
+
+			err := repo.RenameDepartment(ctx, db, 1, "Platform")
+			So(err, ShouldBeNil)
+
+			employeePositionRes, err := repo.Get(ctx, db, positions[0].ID)
+			So(err, ShouldBeNil)
+			So(employeePositionRes.Department, ShouldEqual, "Platform")
+
+			employeePositionRes, err = repo.Get(ctx, db, positions[2].ID)
+			So(err, ShouldBeNil)
+			So(employeePositionRes.Department, ShouldEqual, positions[2].Department)
+		}
 	})
 }
@@ -0,0 +1,92 @@
+package holidayrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) SaveAssignment(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendarAssignment) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save holiday calendar assignment: %w", err)
+	}
+
+	return nil
+}
+
+// GetAssignmentByTarget returns the assignment of the employee, or of the
+// department when employeeID is nil.
+func (r *repo) GetAssignmentByTarget(ctx context.Context, tx *gorm.DB, employeeID *int64, departmentID *int64) (*models.HolidayCalendarAssignment, error) {
+	query := tx
+	if employeeID != nil {
+		query = query.Where("employee_id = ?", *employeeID)
+	} else {
+		query = query.Where("department_id = ?", departmentID)
+	}
+
+	// Create a variable to hold the result
+	var assignment models.HolidayCalendarAssignment
+
+	// Execute the query
+	if err := query.First(&assignment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get holiday calendar assignment: %w", err)
+	}
+
+	// Return the result
+	return &assignment, nil
+}
+
+// DeleteAssignment removes the assignment of the calendar. It returns false
+// when it does not exist.
+func (r *repo) DeleteAssignment(ctx context.Context, tx *gorm.DB, calendarID int64, id int64) (bool, error) {
+	result := tx.Where("calendar_id = ?", calendarID).Delete(&models.HolidayCalendarAssignment{}, id)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete holiday calendar assignment: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListAssignmentsByCalendarID returns the assignments of the calendar ordered
+// by ID.
+func (r *repo) ListAssignmentsByCalendarID(ctx context.Context, tx *gorm.DB, calendarID int64) ([]*models.HolidayCalendarAssignment, error) {
+	// Create a variable to hold the result
+	var assignments []*models.HolidayCalendarAssignment
+
+	// Execute the query
+	if err := tx.Where("calendar_id = ?", calendarID).
+		Order("id ASC").
+		Find(&assignments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list holiday calendar assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// ListAssignments returns the assignments of the employees or the
+// departments.
+func (r *repo) ListAssignments(ctx context.Context, tx *gorm.DB, employeeIDs []int64, departmentIDs []int64) ([]*models.HolidayCalendarAssignment, error) {
+	if len(employeeIDs) == 0 && len(departmentIDs) == 0 {
+		return nil, nil
+	}
+
+	// Create a variable to hold the result
+	var assignments []*models.HolidayCalendarAssignment
+
+	// Execute the query
+	if err := tx.Where("(employee_id IN ? OR department_id IN ?)", employeeIDs, departmentIDs).
+		Order("id ASC").
+		Find(&assignments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list holiday calendar assignments: %w", err)
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,89 @@
+package holidayrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) CreateCalendar(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendar) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create holiday calendar: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) GetCalendar(ctx context.Context, tx *gorm.DB, id int64) (*models.HolidayCalendar, error) {
+	// Create a variable to hold the result
+	var calendar models.HolidayCalendar
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&calendar).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get holiday calendar: %w", err)
+	}
+
+	// Return the result
+	return &calendar, nil
+}
+
+func (r *repo) GetCalendarByName(ctx context.Context, tx *gorm.DB, name string) (*models.HolidayCalendar, error) {
+	// Create a variable to hold the result
+	var calendar models.HolidayCalendar
+
+	// Execute the query
+	if err := tx.Where("name = ?", name).First(&calendar).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get holiday calendar by name: %w", err)
+	}
+
+	// Return the result
+	return &calendar, nil
+}
+
+// ListCalendars returns every calendar ordered by name.
+func (r *repo) ListCalendars(ctx context.Context, tx *gorm.DB) ([]*models.HolidayCalendar, error) {
+	// Create a variable to hold the result
+	var calendars []*models.HolidayCalendar
+
+	// Execute the query
+	if err := tx.Order("name ASC").Find(&calendars).Error; err != nil {
+		return nil, fmt.Errorf("failed to list holiday calendars: %w", err)
+	}
+
+	return calendars, nil
+}
+
+func (r *repo) SaveCalendar(ctx context.Context, tx *gorm.DB, data *models.HolidayCalendar) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save holiday calendar: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCalendar removes the calendar along with its holidays. It returns
+// false when the calendar does not exist.
+func (r *repo) DeleteCalendar(ctx context.Context, tx *gorm.DB, id int64) (bool, error) {
+	if err := tx.Where("calendar_id = ?", id).Delete(&models.Holiday{}).Error; err != nil {
+		return false, fmt.Errorf("failed to delete holidays: %w", err)
+	}
+
+	result := tx.Delete(&models.HolidayCalendar{}, id)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete holiday calendar: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,91 @@
+package holidayrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const upsertBatchSize = 500
+
+func (r *repo) CreateHoliday(ctx context.Context, tx *gorm.DB, data *models.Holiday) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create holiday: %w", err)
+	}
+
+	return nil
+}
+
+// GetHolidayByDate returns the holiday of the calendar on the day.
+func (r *repo) GetHolidayByDate(ctx context.Context, tx *gorm.DB, calendarID int64, date time.Time) (*models.Holiday, error) {
+	// Create a variable to hold the result
+	var holiday models.Holiday
+
+	// Execute the query
+	if err := tx.Where("calendar_id = ? AND date = ?", calendarID, date).First(&holiday).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get holiday: %w", err)
+	}
+
+	// Return the result
+	return &holiday, nil
+}
+
+// UpsertHolidays creates the holidays, renaming the ones of a calendar
+// already on the same day.
+func (r *repo) UpsertHolidays(ctx context.Context, tx *gorm.DB, data []*models.Holiday) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "calendar_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "uid", "updated_at"}),
+	}).CreateInBatches(data, upsertBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to upsert holidays: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteHoliday removes the holiday of the calendar. It returns false when
+// it does not exist.
+func (r *repo) DeleteHoliday(ctx context.Context, tx *gorm.DB, calendarID int64, id int64) (bool, error) {
+	result := tx.Where("calendar_id = ?", calendarID).Delete(&models.Holiday{}, id)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete holiday: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListHolidays returns the holidays of the calendars falling in [from, to),
+// ordered by date.
+func (r *repo) ListHolidays(ctx context.Context, tx *gorm.DB, calendarIDs []int64, from, to time.Time) ([]*models.Holiday, error) {
+	if len(calendarIDs) == 0 {
+		return nil, nil
+	}
+
+	// Create a variable to hold the result
+	var holidays []*models.Holiday
+
+	// Execute the query
+	if err := tx.Where("calendar_id IN ?", calendarIDs).
+		Where("date >= ? AND date < ?", from, to).
+		Order("date ASC, calendar_id ASC").
+		Find(&holidays).Error; err != nil {
+		return nil, fmt.Errorf("failed to list holidays: %w", err)
+	}
+
+	return holidays, nil
+}
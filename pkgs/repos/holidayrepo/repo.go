@@ -0,0 +1,7 @@
+package holidayrepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
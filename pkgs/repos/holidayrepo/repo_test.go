@@ -0,0 +1,213 @@
+package holidayrepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo_Calendars(t *testing.T) {
+	Convey("TestRepo_Calendars", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+
+		// Prepare test data
+		testutils.MustClearTable(t, db, models.Holiday{})
+		testutils.MustClearTable(t, db, models.HolidayCalendar{})
+		taiwan := &models.HolidayCalendar{Name: "Taiwan", Description: "Taipei office"}
+		japan := &models.HolidayCalendar{Name: "Japan"}
+
+		// Create and get
+		{
+			Print("Create and get")
+			So(repo.CreateCalendar(ctx, db, taiwan), ShouldBeNil)
+			So(repo.CreateCalendar(ctx, db, japan), ShouldBeNil)
+
+			calendar, err := repo.GetCalendar(ctx, db, taiwan.ID)
+			So(err, ShouldBeNil)
+			So(calendar.Description, ShouldEqual, "Taipei office")
+
+			calendar, err = repo.GetCalendarByName(ctx, db, "Japan")
+			So(err, ShouldBeNil)
+			So(calendar.ID, ShouldEqual, japan.ID)
+
+			calendar, err = repo.GetCalendar(ctx, db, japan.ID+1)
+			So(err, ShouldBeNil)
+			So(calendar, ShouldBeNil)
+		}
+		// List and save
+		{
+			Print("List and save")
+			japan.Description = "Tokyo office"
+			So(repo.SaveCalendar(ctx, db, japan), ShouldBeNil)
+
+			calendars, err := repo.ListCalendars(ctx, db)
+			So(err, ShouldBeNil)
+			So(calendars, ShouldHaveLength, 2)
+			So(calendars[0].Name, ShouldEqual, "Japan")
+			So(calendars[0].Description, ShouldEqual, "Tokyo office")
+		}
+		// Delete with the holidays
+		{
+			Print("Delete")
+			So(repo.CreateHoliday(ctx, db, &models.Holiday{
+				CalendarID: japan.ID,
+				Date:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Name:       "New Year's Day",
+			}), ShouldBeNil)
+
+			deleted, err := repo.DeleteCalendar(ctx, db, japan.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+
+			holidays, err := repo.ListHolidays(ctx, db, []int64{japan.ID}, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+			So(holidays, ShouldBeEmpty)
+
+			deleted, err = repo.DeleteCalendar(ctx, db, japan.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeFalse)
+		}
+	})
+}
+
+func TestRepo_Holidays(t *testing.T) {
+	Convey("TestRepo_Holidays", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+
+		// Prepare test data
+		testutils.MustClearTable(t, db, models.Holiday{})
+		calendarID := int64(1)
+		newYear := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		labour := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		// Create and get
+		{
+			Print("Create and get")
+			So(repo.CreateHoliday(ctx, db, &models.Holiday{CalendarID: calendarID, Date: newYear, Name: "New Year"}), ShouldBeNil)
+
+			holiday, err := repo.GetHolidayByDate(ctx, db, calendarID, newYear)
+			So(err, ShouldBeNil)
+			So(holiday.Name, ShouldEqual, "New Year")
+
+			holiday, err = repo.GetHolidayByDate(ctx, db, calendarID+1, newYear)
+			So(err, ShouldBeNil)
+			So(holiday, ShouldBeNil)
+		}
+		// Upsert
+		{
+			Print("Upsert")
+			So(repo.UpsertHolidays(ctx, db, []*models.Holiday{
+				{CalendarID: calendarID, Date: newYear, Name: "New Year's Day", UID: "new-year@example.com"},
+				{CalendarID: calendarID, Date: labour, Name: "Labour Day", UID: "labour@example.com"},
+				{CalendarID: calendarID + 1, Date: labour, Name: "Labour Day"},
+			}), ShouldBeNil)
+
+			holidays, err := repo.ListHolidays(ctx, db, []int64{calendarID}, newYear, newYear.AddDate(1, 0, 0))
+			So(err, ShouldBeNil)
+			So(holidays, ShouldHaveLength, 2)
+			So(holidays[0].Name, ShouldEqual, "New Year's Day")
+			So(holidays[0].UID, ShouldEqual, "new-year@example.com")
+			So(holidays[1].Date.Format(time.DateOnly), ShouldEqual, "2025-05-01")
+		}
+		// List by range
+		{
+			Print("List by range")
+			holidays, err := repo.ListHolidays(ctx, db, []int64{calendarID, calendarID + 1}, newYear.AddDate(0, 0, 1), labour.AddDate(0, 0, 1))
+			So(err, ShouldBeNil)
+			So(holidays, ShouldHaveLength, 2)
+			So(holidays[0].Date.Format(time.DateOnly), ShouldEqual, "2025-05-01")
+		}
+		// Delete
+		{
+			Print("Delete")
+			holiday, err := repo.GetHolidayByDate(ctx, db, calendarID, labour)
+			So(err, ShouldBeNil)
+
+			deleted, err := repo.DeleteHoliday(ctx, db, calendarID+1, holiday.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeFalse)
+
+			deleted, err = repo.DeleteHoliday(ctx, db, calendarID, holiday.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+		}
+	})
+}
+
+func TestRepo_Assignments(t *testing.T) {
+	Convey("TestRepo_Assignments", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+
+		// Prepare test data
+		testutils.MustClearTable(t, db, models.HolidayCalendarAssignment{})
+		employee := &models.HolidayCalendarAssignment{CalendarID: 1, EmployeeID: lo.ToPtr(int64(123))}
+		department := &models.HolidayCalendarAssignment{CalendarID: 2, DepartmentID: lo.ToPtr(int64(10))}
+
+		// Save and get
+		{
+			Print("Save and get")
+			So(repo.SaveAssignment(ctx, db, employee), ShouldBeNil)
+			So(repo.SaveAssignment(ctx, db, department), ShouldBeNil)
+
+			assignment, err := repo.GetAssignmentByTarget(ctx, db, nil, lo.ToPtr(int64(10)))
+			So(err, ShouldBeNil)
+			So(assignment.ID, ShouldEqual, department.ID)
+
+			assignment, err = repo.GetAssignmentByTarget(ctx, db, lo.ToPtr(int64(10)), nil)
+			So(err, ShouldBeNil)
+			So(assignment, ShouldBeNil)
+
+			// Reassign the employee
+			employee.CalendarID = 2
+			So(repo.SaveAssignment(ctx, db, employee), ShouldBeNil)
+		}
+		// List
+		{
+			Print("List")
+			assignments, err := repo.ListAssignmentsByCalendarID(ctx, db, 2)
+			So(err, ShouldBeNil)
+			So(assignments, ShouldHaveLength, 2)
+
+			assignments, err = repo.ListAssignments(ctx, db, []int64{123}, nil)
+			So(err, ShouldBeNil)
+			So(assignments, ShouldHaveLength, 1)
+			So(assignments[0].CalendarID, ShouldEqual, 2)
+
+			assignments, err = repo.ListAssignments(ctx, db, nil, nil)
+			So(err, ShouldBeNil)
+			So(assignments, ShouldBeEmpty)
+		}
+		// Delete
+		{
+			Print("Delete")
+			deleted, err := repo.DeleteAssignment(ctx, db, 1, department.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeFalse)
+
+			deleted, err = repo.DeleteAssignment(ctx, db, 2, department.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+		}
+	})
+}
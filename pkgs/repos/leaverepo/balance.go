@@ -0,0 +1,64 @@
+package leaverepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// GetBalance returns the balance of the employee for the leave type, nil
+// when the employee has none yet.
+func (r *repo) GetBalance(ctx context.Context, tx *gorm.DB, employeeID int64, leaveTypeID int64) (*models.LeaveBalance, error) {
+	// Create a variable to hold the result
+	var balance models.LeaveBalance
+
+	// Execute the query
+	if err := tx.Where("employee_id = ? AND leave_type_id = ?", employeeID, leaveTypeID).
+		First(&balance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get leave balance: %w", err)
+	}
+
+	// Return the result
+	return &balance, nil
+}
+
+// GetBalanceForUpdate is GetBalance with a row lock held until the end of
+// the transaction.
+func (r *repo) GetBalanceForUpdate(ctx context.Context, tx *gorm.DB, employeeID int64, leaveTypeID int64) (*models.LeaveBalance, error) {
+	return r.GetBalance(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), employeeID, leaveTypeID)
+}
+
+// ListBalancesByEmployeeID returns the balances of the employee keyed by
+// leave type ID.
+func (r *repo) ListBalancesByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) (map[int64]*models.LeaveBalance, error) {
+	// Execute the query
+	var balances []*models.LeaveBalance
+	if err := tx.Where("employee_id = ?", employeeID).Find(&balances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list leave balances: %w", err)
+	}
+
+	result := make(map[int64]*models.LeaveBalance, len(balances))
+	for _, balance := range balances {
+		result[balance.LeaveTypeID] = balance
+	}
+
+	return result, nil
+}
+
+// SaveBalance creates the balance or updates it.
+func (r *repo) SaveBalance(ctx context.Context, tx *gorm.DB, data *models.LeaveBalance) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save leave balance: %w", err)
+	}
+
+	return nil
+}
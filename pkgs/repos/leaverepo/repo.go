@@ -0,0 +1,7 @@
+package leaverepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
@@ -0,0 +1,176 @@
+package leaverepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo_Types(t *testing.T) {
+	Convey("TestRepo_Types", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+
+		// The migration provides the usual leave types
+		{
+			Print("Default types")
+			leaveTypes, err := repo.ListTypes(ctx, db)
+			So(err, ShouldBeNil)
+			So(len(leaveTypes), ShouldBeGreaterThanOrEqualTo, 3)
+
+			unpaid, err := repo.GetTypeByCode(ctx, db, models.LeaveTypeCodeUnpaid)
+			So(err, ShouldBeNil)
+			So(unpaid, ShouldNotBeNil)
+			So(unpaid.Paid, ShouldBeFalse)
+			So(unpaid.TracksBalance, ShouldBeFalse)
+		}
+		// Create and save
+		{
+			Print("Create and save")
+			parental := &models.LeaveType{Code: "parental-test", Name: "Parental leave", Paid: true, TracksBalance: true}
+			So(repo.CreateType(ctx, db, parental), ShouldBeNil)
+
+			parental.AccrualDaysPerMonth = 0.5
+			So(repo.SaveType(ctx, db, parental), ShouldBeNil)
+
+			leaveType, err := repo.GetType(ctx, db, parental.ID)
+			So(err, ShouldBeNil)
+			So(leaveType.AccrualDaysPerMonth, ShouldEqual, 0.5)
+
+			So(db.Delete(parental).Error, ShouldBeNil)
+		}
+	})
+}
+
+func TestRepo_Balances(t *testing.T) {
+	Convey("TestRepo_Balances", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		testutils.MustClearTable(t, db, models.LeaveBalance{})
+
+		balance := &models.LeaveBalance{
+			EmployeeID:     1,
+			LeaveTypeID:    2,
+			BalanceDays:    3.5,
+			AccruedThrough: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		// Save creates then updates
+		{
+			Print("Save")
+			So(repo.SaveBalance(ctx, db, balance), ShouldBeNil)
+			balance.BalanceDays = 1.25
+			So(repo.SaveBalance(ctx, db, balance), ShouldBeNil)
+
+			balanceRes, err := repo.GetBalanceForUpdate(ctx, db, 1, 2)
+			So(err, ShouldBeNil)
+			So(balanceRes.BalanceDays, ShouldEqual, 1.25)
+
+			balanceRes, err = repo.GetBalance(ctx, db, 1, 3)
+			So(err, ShouldBeNil)
+			So(balanceRes, ShouldBeNil)
+		}
+		// By employee
+		{
+			Print("By employee")
+			balances, err := repo.ListBalancesByEmployeeID(ctx, db, 1)
+			So(err, ShouldBeNil)
+			So(balances, ShouldHaveLength, 1)
+			So(balances[2].ID, ShouldEqual, balance.ID)
+		}
+	})
+}
+
+func TestRepo_Requests(t *testing.T) {
+	Convey("TestRepo_Requests", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		testutils.MustClearTable(t, db, models.LeaveRequest{})
+
+		// Prepare test data
+		pending := models.DummyLeaveRequest(faker)
+		pending.EmployeeID = 1
+		approved := models.DummyLeaveRequest(faker)
+		approved.EmployeeID = 1
+		approved.StartDate = pending.StartDate.AddDate(0, 0, 14)
+		approved.EndDate = approved.StartDate
+		approved.Days = 1
+		approved.Status = models.LeaveStatusApproved
+		for _, request := range []*models.LeaveRequest{pending, approved} {
+			So(repo.CreateRequest(ctx, db, request), ShouldBeNil)
+		}
+
+		// Get
+		{
+			Print("Get")
+			requestRes, err := repo.GetRequestForUpdate(ctx, db, pending.ID)
+			So(err, ShouldBeNil)
+			So(requestRes.Days, ShouldEqual, 5)
+			So(requestRes.StartDate, ShouldEqual, pending.StartDate)
+
+			requestRes, err = repo.GetRequest(ctx, db, approved.ID+1)
+			So(err, ShouldBeNil)
+			So(requestRes, ShouldBeNil)
+		}
+		// List
+		{
+			Print("List")
+			requests, err := repo.ListRequests(ctx, db, ListRequestsParams{EmployeeID: 1, Limit: 1})
+			So(err, ShouldBeNil)
+			So(requests, ShouldHaveLength, 1)
+
+			cursor := ListRequestsCursor(requests[0])
+			requests, err = repo.ListRequests(ctx, db, ListRequestsParams{EmployeeID: 1, Cursor: &cursor, Limit: 10})
+			So(err, ShouldBeNil)
+			So(requests, ShouldHaveLength, 1)
+			So(requests[0].ID, ShouldEqual, approved.ID)
+
+			requests, err = repo.ListRequests(ctx, db, ListRequestsParams{Status: models.LeaveStatusApproved, Cursor: &utils.Cursor{}, Limit: 10})
+			So(err, ShouldBeNil)
+			So(requests, ShouldHaveLength, 1)
+		}
+		// Overlapping
+		{
+			Print("Overlapping")
+			requests, err := repo.ListActiveOverlapping(ctx, db, 1, pending.EndDate, pending.EndDate.AddDate(0, 0, 3))
+			So(err, ShouldBeNil)
+			So(requests, ShouldHaveLength, 1)
+			So(requests[0].ID, ShouldEqual, pending.ID)
+		}
+		// Pending days
+		{
+			Print("Pending days")
+			days, err := repo.ListPendingDays(ctx, db, 1)
+			So(err, ShouldBeNil)
+			So(days[pending.LeaveTypeID], ShouldEqual, 5)
+		}
+		// Approved in a window
+		{
+			Print("Approved in a window")
+			requests, err := repo.ListApprovedByEmployeeIDs(ctx, db, []int64{1, 2}, approved.StartDate, approved.StartDate.AddDate(0, 0, 1))
+			So(err, ShouldBeNil)
+			So(requests, ShouldHaveLength, 1)
+			So(requests[0].ID, ShouldEqual, approved.ID)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package leaverepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) CreateRequest(ctx context.Context, tx *gorm.DB, data *models.LeaveRequest) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create leave request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) GetRequest(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveRequest, error) {
+	// Create a variable to hold the result
+	var request models.LeaveRequest
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&request).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get leave request: %w", err)
+	}
+
+	// Return the result
+	return &request, nil
+}
+
+// GetRequestForUpdate is GetRequest with a row lock held until the end of the
+// transaction, so a request changes status once.
+func (r *repo) GetRequestForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveRequest, error) {
+	return r.GetRequest(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *repo) SaveRequest(ctx context.Context, tx *gorm.DB, data *models.LeaveRequest) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save leave request: %w", err)
+	}
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ListRequestsParams struct {
+	// Zero values do not filter
+	EmployeeID int64
+	Status     string
+
+	Cursor *utils.Cursor
+	Limit  int
+}
+
+// ListRequests returns the requests ordered by ID, continuing after
+// params.Cursor.
+func (r *repo) ListRequests(ctx context.Context, tx *gorm.DB, params ListRequestsParams) ([]*models.LeaveRequest, error) {
+	query := tx.Model(&models.LeaveRequest{})
+	if params.EmployeeID != 0 {
+		query = query.Where("employee_id = ?", params.EmployeeID)
+	}
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Cursor != nil {
+		query = query.Where("id > ?", params.Cursor.ID)
+	}
+
+	var requests []*models.LeaveRequest
+	if err := query.Order("id ASC").Limit(params.Limit).Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list leave requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ListRequestsCursor returns the cursor pointing right after the given
+// request.
+func ListRequestsCursor(request *models.LeaveRequest) utils.Cursor {
+	return utils.Cursor{ID: request.ID}
+}
+
+// ListActiveOverlapping returns the pending and approved requests of the
+// employee sharing a day with [startDate, endDate].
+func (r *repo) ListActiveOverlapping(ctx context.Context, tx *gorm.DB, employeeID int64, startDate, endDate time.Time) ([]*models.LeaveRequest, error) {
+	var requests []*models.LeaveRequest
+	if err := tx.Where("employee_id = ?", employeeID).
+		Where("status IN ?", []string{models.LeaveStatusPending, models.LeaveStatusApproved}).
+		Where("start_date <= ? AND end_date >= ?", endDate, startDate).
+		Order("start_date ASC, id ASC").
+		Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list overlapping leave requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ListPendingDays sums the days of the pending requests of the employee,
+// keyed by leave type ID.
+func (r *repo) ListPendingDays(ctx context.Context, tx *gorm.DB, employeeID int64) (map[int64]float64, error) {
+	var rows []struct {
+		LeaveTypeID int64
+		Days        float64
+	}
+	if err := tx.Model(&models.LeaveRequest{}).
+		Select("leave_type_id, SUM(days) AS days").
+		Where("employee_id = ? AND status = ?", employeeID, models.LeaveStatusPending).
+		Group("leave_type_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to sum pending leave days: %w", err)
+	}
+
+	result := make(map[int64]float64, len(rows))
+	for _, row := range rows {
+		result[row.LeaveTypeID] = row.Days
+	}
+
+	return result, nil
+}
+
+// ListApprovedByEmployeeIDs returns the approved requests of the employees
+// sharing a day with [from, to).
+func (r *repo) ListApprovedByEmployeeIDs(ctx context.Context, tx *gorm.DB, employeeIDs []int64, from, to time.Time) ([]*models.LeaveRequest, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+
+	var requests []*models.LeaveRequest
+	if err := tx.Where("employee_id IN ?", employeeIDs).
+		Where("status = ?", models.LeaveStatusApproved).
+		// end_date is the inclusive last day
+		Where("start_date < ? AND end_date >= ?", to, from).
+		Order("employee_id ASC, start_date ASC").
+		Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list approved leave requests: %w", err)
+	}
+
+	return requests, nil
+}
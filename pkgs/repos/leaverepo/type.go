@@ -0,0 +1,76 @@
+package leaverepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) CreateType(ctx context.Context, tx *gorm.DB, data *models.LeaveType) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create leave type: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) GetType(ctx context.Context, tx *gorm.DB, id int64) (*models.LeaveType, error) {
+	// Create a variable to hold the result
+	var leaveType models.LeaveType
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&leaveType).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get leave type: %w", err)
+	}
+
+	// Return the result
+	return &leaveType, nil
+}
+
+// GetTypeByCode returns the leave type with the given code. The comparison
+// follows the column collation, which ignores case.
+func (r *repo) GetTypeByCode(ctx context.Context, tx *gorm.DB, code string) (*models.LeaveType, error) {
+	// Create a variable to hold the result
+	var leaveType models.LeaveType
+
+	// Execute the query
+	if err := tx.Where("code = ?", code).First(&leaveType).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get leave type by code: %w", err)
+	}
+
+	// Return the result
+	return &leaveType, nil
+}
+
+// ListTypes returns every leave type ordered by ID.
+func (r *repo) ListTypes(ctx context.Context, tx *gorm.DB) ([]*models.LeaveType, error) {
+	// Create a variable to hold the result
+	var leaveTypes []*models.LeaveType
+
+	// Execute the query
+	if err := tx.Order("id ASC").Find(&leaveTypes).Error; err != nil {
+		return nil, fmt.Errorf("failed to list leave types: %w", err)
+	}
+
+	return leaveTypes, nil
+}
+
+func (r *repo) SaveType(ctx context.Context, tx *gorm.DB, data *models.LeaveType) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save leave type: %w", err)
+	}
+
+	return nil
+}
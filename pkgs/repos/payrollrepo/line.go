@@ -0,0 +1,39 @@
+package payrollrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateLines writes the lines of a run.
+func (r *repo) CreateLines(ctx context.Context, tx *gorm.DB, data []*models.PayrollLine) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := tx.CreateInBatches(data, createLinesBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create payroll lines: %w", err)
+	}
+
+	return nil
+}
+
+const createLinesBatchSize = 500
+
+// ListLinesByRunID returns the lines of the run grouped by employee and
+// ordered by start date.
+func (r *repo) ListLinesByRunID(ctx context.Context, tx *gorm.DB, runID int64) ([]*models.PayrollLine, error) {
+	var lines []*models.PayrollLine
+	if err := tx.Where("run_id = ?", runID).
+		Order("employee_id ASC, start_date ASC, id ASC").
+		Find(&lines).Error; err != nil {
+		return nil, fmt.Errorf("failed to list payroll lines: %w", err)
+	}
+
+	return lines, nil
+}
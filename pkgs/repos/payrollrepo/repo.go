@@ -0,0 +1,7 @@
+package payrollrepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
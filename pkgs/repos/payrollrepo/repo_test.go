@@ -0,0 +1,135 @@
+package payrollrepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo_Runs(t *testing.T) {
+	Convey("TestRepo_Runs", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		testutils.MustClearTable(t, db, models.PayrollLine{})
+		testutils.MustClearTable(t, db, models.PayrollRun{})
+
+		run := models.DummyPayrollRun(faker)
+
+		// Create and get
+		{
+			Print("Create and get")
+			So(repo.CreateRun(ctx, db, run), ShouldBeNil)
+
+			runRes, err := repo.GetRun(ctx, db, run.ID)
+			So(err, ShouldBeNil)
+			So(runRes, ShouldNotBeNil)
+			So(runRes.PeriodStart.Equal(run.PeriodStart), ShouldBeTrue)
+			So(runRes.Status, ShouldEqual, models.PayrollRunStatusDraft)
+
+			runRes, err = repo.GetRun(ctx, db, run.ID+1)
+			So(err, ShouldBeNil)
+			So(runRes, ShouldBeNil)
+		}
+		// Only finalized runs overlap
+		{
+			Print("ListFinalizedOverlapping")
+			runs, err := repo.ListFinalizedOverlapping(ctx, db, run.PeriodStart, run.PeriodEnd)
+			So(err, ShouldBeNil)
+			So(runs, ShouldBeEmpty)
+
+			err = db.Transaction(func(tx *gorm.DB) error {
+				locked, err := repo.GetRunForUpdate(ctx, tx, run.ID)
+				So(err, ShouldBeNil)
+				So(locked, ShouldNotBeNil)
+
+				finalizedAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+				locked.Status = models.PayrollRunStatusFinalized
+				locked.FinalizedAt = &finalizedAt
+				return repo.SaveRun(ctx, tx, locked)
+			})
+			So(err, ShouldBeNil)
+
+			runs, err = repo.ListFinalizedOverlapping(ctx, db, run.PeriodEnd, run.PeriodEnd.AddDate(0, 0, 14))
+			So(err, ShouldBeNil)
+			So(runs, ShouldHaveLength, 1)
+			So(runs[0].ID, ShouldEqual, run.ID)
+
+			runs, err = repo.ListFinalizedOverlapping(ctx, db, run.PeriodEnd.AddDate(0, 0, 1), run.PeriodEnd.AddDate(0, 1, 0))
+			So(err, ShouldBeNil)
+			So(runs, ShouldBeEmpty)
+		}
+		// Drafts are locked along the finalized runs
+		{
+			Print("ListOverlappingForUpdate")
+			draft := models.DummyPayrollRun(faker)
+			draft.PeriodStart = run.PeriodEnd
+			draft.PeriodEnd = run.PeriodEnd.AddDate(0, 0, 14)
+			So(repo.CreateRun(ctx, db, draft), ShouldBeNil)
+
+			err := db.Transaction(func(tx *gorm.DB) error {
+				runs, err := repo.ListOverlappingForUpdate(ctx, tx, run.PeriodEnd, run.PeriodEnd)
+				So(err, ShouldBeNil)
+				So(runs, ShouldHaveLength, 2)
+				So(runs[0].ID, ShouldEqual, run.ID)
+				So(runs[1].ID, ShouldEqual, draft.ID)
+				So(runs[1].Status, ShouldEqual, models.PayrollRunStatusDraft)
+
+				runs, err = repo.ListOverlappingForUpdate(ctx, tx, draft.PeriodEnd.AddDate(0, 0, 1), draft.PeriodEnd.AddDate(0, 1, 0))
+				So(err, ShouldBeNil)
+				So(runs, ShouldBeEmpty)
+				return nil
+			})
+			So(err, ShouldBeNil)
+		}
+	})
+}
+
+func TestRepo_Lines(t *testing.T) {
+	Convey("TestRepo_Lines", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		testutils.MustClearTable(t, db, models.PayrollLine{})
+
+		first := models.DummyPayrollLine(faker)
+		first.RunID = 1
+		second := models.DummyPayrollLine(faker)
+		second.RunID = 1
+		second.EmployeeID = first.EmployeeID
+		second.StartDate = first.EndDate.AddDate(0, 0, 1)
+		second.EndDate = second.StartDate.AddDate(0, 0, 15)
+		other := models.DummyPayrollLine(faker)
+		other.RunID = 2
+
+		// Create and list
+		{
+			Print("CreateLines")
+			So(repo.CreateLines(ctx, db, nil), ShouldBeNil)
+			So(repo.CreateLines(ctx, db, []*models.PayrollLine{second, first, other}), ShouldBeNil)
+
+			lines, err := repo.ListLinesByRunID(ctx, db, 1)
+			So(err, ShouldBeNil)
+			So(lines, ShouldHaveLength, 2)
+			So(lines[0].ID, ShouldEqual, first.ID)
+			So(lines[1].ID, ShouldEqual, second.ID)
+		}
+	})
+}
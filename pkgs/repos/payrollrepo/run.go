@@ -0,0 +1,82 @@
+package payrollrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) CreateRun(ctx context.Context, tx *gorm.DB, data *models.PayrollRun) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create payroll run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) GetRun(ctx context.Context, tx *gorm.DB, id int64) (*models.PayrollRun, error) {
+	// Create a variable to hold the result
+	var run models.PayrollRun
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get payroll run: %w", err)
+	}
+
+	// Return the result
+	return &run, nil
+}
+
+// GetRunForUpdate is GetRun with a row lock held until the end of the
+// transaction, so a run is finalized once.
+func (r *repo) GetRunForUpdate(ctx context.Context, tx *gorm.DB, id int64) (*models.PayrollRun, error) {
+	return r.GetRun(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *repo) SaveRun(ctx context.Context, tx *gorm.DB, data *models.PayrollRun) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save payroll run: %w", err)
+	}
+
+	return nil
+}
+
+// ListFinalizedOverlapping returns the finalized runs sharing a day with
+// [from, to].
+func (r *repo) ListFinalizedOverlapping(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollRun, error) {
+	var runs []*models.PayrollRun
+	if err := tx.Where("status = ?", models.PayrollRunStatusFinalized).
+		Where("period_start <= ? AND period_end >= ?", to, from).
+		Order("period_start ASC, id ASC").
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list overlapping payroll runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// ListOverlappingForUpdate returns every run sharing a day with [from, to],
+// drafts included, with a row lock held until the end of the transaction, so
+// two overlapping runs are not finalized at once.
+func (r *repo) ListOverlappingForUpdate(ctx context.Context, tx *gorm.DB, from, to time.Time) ([]*models.PayrollRun, error) {
+	var runs []*models.PayrollRun
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("period_start <= ? AND period_end >= ?", to, from).
+		Order("id ASC").
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock overlapping payroll runs: %w", err)
+	}
+
+	return runs, nil
+}
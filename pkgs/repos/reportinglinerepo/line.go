@@ -0,0 +1,84 @@
+package reportinglinerepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) Create(ctx context.Context, tx *gorm.DB, data *models.ReportingLine) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create reporting line: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) Save(ctx context.Context, tx *gorm.DB, data *models.ReportingLine) error {
+	if err := tx.Save(data).Error; err != nil {
+		return fmt.Errorf("failed to save reporting line: %w", err)
+	}
+
+	return nil
+}
+
+// ListByEmployeeID returns every line of the employee ordered by start date.
+func (r *repo) ListByEmployeeID(ctx context.Context, tx *gorm.DB, employeeID int64) ([]*models.ReportingLine, error) {
+	// Create a variable to hold the result
+	var lines []*models.ReportingLine
+
+	// Execute the query
+	if err := tx.Where("employee_id = ?", employeeID).
+		Order("start_date ASC").
+		Find(&lines).Error; err != nil {
+		return nil, fmt.Errorf("failed to list reporting lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// ListForUpdate returns every line, past and future ones included, with the
+// lines locked until the end of the transaction. Writes checking the whole
+// reporting tree for cycles run one after the other.
+func (r *repo) ListForUpdate(ctx context.Context, tx *gorm.DB) ([]*models.ReportingLine, error) {
+	// Create a variable to hold the result
+	var lines []*models.ReportingLine
+
+	// Execute the query
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Order("employee_id ASC, start_date ASC").
+		Find(&lines).Error; err != nil {
+		return nil, fmt.Errorf("failed to list reporting lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// ListEffective returns the line of every employee in effect on the date,
+// the lines ending the reporting included.
+func (r *repo) ListEffective(ctx context.Context, tx *gorm.DB, date time.Time) ([]*models.ReportingLine, error) {
+	// Execute the query
+	var lines []*models.ReportingLine
+	if err := tx.Where("start_date <= ?", date).
+		Order("employee_id ASC, start_date DESC").
+		Find(&lines).Error; err != nil {
+		return nil, fmt.Errorf("failed to list effective reporting lines: %w", err)
+	}
+
+	// Keep the latest line of each employee
+	result := make([]*models.ReportingLine, 0, len(lines))
+	for _, line := range lines {
+		if len(result) == 0 || result[len(result)-1].EmployeeID != line.EmployeeID {
+			result = append(result, line)
+		}
+	}
+
+	return result, nil
+}
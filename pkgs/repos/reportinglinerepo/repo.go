@@ -0,0 +1,7 @@
+package reportinglinerepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
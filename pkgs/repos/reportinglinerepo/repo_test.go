@@ -0,0 +1,95 @@
+package reportinglinerepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo(t *testing.T) {
+	Convey("TestRepo", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		testutils.MustClearTable(t, db, models.ReportingLine{})
+
+		january := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		lines := []*models.ReportingLine{
+			{EmployeeID: 2, ManagerID: lo.ToPtr(int64(1)), StartDate: january},
+			{EmployeeID: 2, ManagerID: lo.ToPtr(int64(3)), StartDate: june},
+			{EmployeeID: 3, ManagerID: lo.ToPtr(int64(1)), StartDate: january},
+			{EmployeeID: 3, ManagerID: nil, StartDate: june},
+		}
+
+		// Create
+		{
+			Print("Create")
+			for _, line := range lines {
+				So(repo.Create(ctx, db, line), ShouldBeNil)
+			}
+
+			// An employee has a single line per day
+			So(repo.Create(ctx, db, &models.ReportingLine{EmployeeID: 2, StartDate: june}), ShouldNotBeNil)
+		}
+		// ListByEmployeeID
+		{
+			Print("ListByEmployeeID")
+			res, err := repo.ListByEmployeeID(ctx, db, 2)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, lines[0].ID)
+			So(*res[1].ManagerID, ShouldEqual, 3)
+		}
+		// ListEffective keeps the latest line started on the date
+		{
+			Print("ListEffective")
+			res, err := repo.ListEffective(ctx, db, june.AddDate(0, 0, -1))
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, lines[0].ID)
+			So(res[1].ID, ShouldEqual, lines[2].ID)
+
+			res, err = repo.ListEffective(ctx, db, june)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+			So(res[0].ID, ShouldEqual, lines[1].ID)
+			So(res[1].ManagerID, ShouldBeNil)
+
+			res, err = repo.ListEffective(ctx, db, january.AddDate(0, 0, -1))
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		}
+		// ListForUpdate and Save
+		{
+			Print("ListForUpdate and Save")
+			err := db.Transaction(func(tx *gorm.DB) error {
+				res, err := repo.ListForUpdate(ctx, tx)
+				So(err, ShouldBeNil)
+				So(res, ShouldHaveLength, 4)
+
+				res[3].ManagerID = lo.ToPtr(int64(4))
+				return repo.Save(ctx, tx, res[3])
+			})
+			So(err, ShouldBeNil)
+
+			res, err := repo.ListByEmployeeID(ctx, db, 3)
+			So(err, ShouldBeNil)
+			So(*res[1].ManagerID, ShouldEqual, 4)
+		}
+	})
+}
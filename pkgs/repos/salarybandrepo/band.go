@@ -0,0 +1,106 @@
+package salarybandrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) Create(ctx context.Context, tx *gorm.DB, data *models.SalaryBand) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create salary band: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.SalaryBand, error) {
+	// Create a variable to hold the result
+	var band models.SalaryBand
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&band).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get salary band: %w", err)
+	}
+
+	// Return the result
+	return &band, nil
+}
+
+// GetEffective returns the band of the position and currency in effect on
+// the date, nil when there is none. The position comparison follows the
+// column collation, which ignores case.
+func (r *repo) GetEffective(ctx context.Context, tx *gorm.DB, position string, currency string, date time.Time) (*models.SalaryBand, error) {
+	// Create a variable to hold the result
+	var band models.SalaryBand
+
+	// Execute the query
+	if err := tx.Where("position = ? AND currency = ?", position, currency).
+		Where("effective_date <= ?", date).
+		Order("effective_date DESC").
+		First(&band).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get effective salary band: %w", err)
+	}
+
+	// Return the result
+	return &band, nil
+}
+
+// GetByKey returns the band of the position and currency taking effect on
+// the date, which identify a band.
+func (r *repo) GetByKey(ctx context.Context, tx *gorm.DB, position string, currency string, effectiveDate time.Time) (*models.SalaryBand, error) {
+	// Create a variable to hold the result
+	var band models.SalaryBand
+
+	// Execute the query
+	if err := tx.Where("position = ? AND currency = ? AND effective_date = ?", position, currency, effectiveDate).
+		First(&band).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get salary band by key: %w", err)
+	}
+
+	// Return the result
+	return &band, nil
+}
+
+// List returns the bands ordered by position, currency and effective date,
+// the bands of a single position when it is not empty.
+func (r *repo) List(ctx context.Context, tx *gorm.DB, position string) ([]*models.SalaryBand, error) {
+	// Create a variable to hold the result
+	var bands []*models.SalaryBand
+
+	// Execute the query
+	query := tx.Order("position ASC, currency ASC, effective_date ASC")
+	if position != "" {
+		query = query.Where("position = ?", position)
+	}
+	if err := query.Find(&bands).Error; err != nil {
+		return nil, fmt.Errorf("failed to list salary bands: %w", err)
+	}
+
+	return bands, nil
+}
+
+func (r *repo) Delete(ctx context.Context, tx *gorm.DB, id int64) (bool, error) {
+	result := tx.Delete(&models.SalaryBand{}, id)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete salary band: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
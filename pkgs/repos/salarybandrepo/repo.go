@@ -0,0 +1,7 @@
+package salarybandrepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
@@ -0,0 +1,110 @@
+package salarybandrepo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo(t *testing.T) {
+	Convey("TestRepo", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+		testutils.MustClearTable(t, db, models.SalaryBand{})
+
+		band := models.DummySalaryBand(faker)
+		band.Position = "Developer"
+		nextBand := models.DummySalaryBand(faker)
+		nextBand.Position = band.Position
+		nextBand.EffectiveDate = band.EffectiveDate.AddDate(1, 0, 0)
+		otherCurrency := models.DummySalaryBand(faker)
+		otherCurrency.Position = band.Position
+		otherCurrency.Currency = "EUR"
+
+		// Create and get
+		{
+			Print("Create and get")
+			for _, b := range []*models.SalaryBand{nextBand, band, otherCurrency} {
+				So(repo.Create(ctx, db, b), ShouldBeNil)
+			}
+
+			bandRes, err := repo.Get(ctx, db, band.ID)
+			So(err, ShouldBeNil)
+			So(bandRes, ShouldNotBeNil)
+			So(bandRes.Mid, ShouldEqual, band.Mid)
+			So(bandRes.EffectiveDate.Equal(band.EffectiveDate), ShouldBeTrue)
+
+			bandRes, err = repo.GetByKey(ctx, db, band.Position, band.Currency, band.EffectiveDate)
+			So(err, ShouldBeNil)
+			So(bandRes, ShouldNotBeNil)
+			So(bandRes.ID, ShouldEqual, band.ID)
+
+			// A key is unique
+			So(repo.Create(ctx, db, &models.SalaryBand{
+				Position:      band.Position,
+				Currency:      band.Currency,
+				EffectiveDate: band.EffectiveDate,
+			}), ShouldNotBeNil)
+		}
+		// The latest band started on the date is in effect
+		{
+			Print("GetEffective")
+			bandRes, err := repo.GetEffective(ctx, db, strings.ToUpper(band.Position), "USD", band.EffectiveDate.AddDate(0, 6, 0))
+			So(err, ShouldBeNil)
+			So(bandRes, ShouldNotBeNil)
+			So(bandRes.ID, ShouldEqual, band.ID)
+
+			bandRes, err = repo.GetEffective(ctx, db, band.Position, "USD", nextBand.EffectiveDate)
+			So(err, ShouldBeNil)
+			So(bandRes.ID, ShouldEqual, nextBand.ID)
+
+			bandRes, err = repo.GetEffective(ctx, db, band.Position, "EUR", nextBand.EffectiveDate)
+			So(err, ShouldBeNil)
+			So(bandRes.ID, ShouldEqual, otherCurrency.ID)
+
+			bandRes, err = repo.GetEffective(ctx, db, band.Position, "USD", band.EffectiveDate.AddDate(0, 0, -1))
+			So(err, ShouldBeNil)
+			So(bandRes, ShouldBeNil)
+		}
+		// List
+		{
+			Print("List")
+			bands, err := repo.List(ctx, db, band.Position)
+			So(err, ShouldBeNil)
+			So(bands, ShouldHaveLength, 3)
+			So(bands[0].ID, ShouldEqual, otherCurrency.ID)
+			So(bands[1].ID, ShouldEqual, band.ID)
+			So(bands[2].ID, ShouldEqual, nextBand.ID)
+
+			bands, err = repo.List(ctx, db, "Designer")
+			So(err, ShouldBeNil)
+			So(bands, ShouldBeEmpty)
+		}
+		// Delete
+		{
+			Print("Delete")
+			deleted, err := repo.Delete(ctx, db, nextBand.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+
+			deleted, err = repo.Delete(ctx, db, nextBand.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeFalse)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package shiftrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (r *repo) CreateAssignment(ctx context.Context, tx *gorm.DB, data *models.ShiftAssignment) error {
+	if err := tx.
+		Create(data).Error; err != nil {
+		return fmt.Errorf("failed to create shift assignment: %w", err)
+	}
+
+	return nil
+}
+
+// ListAssignmentsByShiftID returns the assignments of the shift ordered by
+// effective date.
+func (r *repo) ListAssignmentsByShiftID(ctx context.Context, tx *gorm.DB, shiftID int64) ([]*models.ShiftAssignment, error) {
+	// Create a variable to hold the result
+	var assignments []*models.ShiftAssignment
+
+	// Execute the query
+	if err := tx.Where("shift_id = ?", shiftID).
+		Order("effective_from ASC, id ASC").
+		Find(&assignments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list shift assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// ListAssignments returns the assignments of the employees or the departments
+// effective on any day of [from, to).
+func (r *repo) ListAssignments(
+	ctx context.Context,
+	tx *gorm.DB,
+	employeeIDs []int64,
+	departmentIDs []int64,
+	from, to time.Time,
+) ([]*models.ShiftAssignment, error) {
+	if len(employeeIDs) == 0 && len(departmentIDs) == 0 {
+		return nil, nil
+	}
+
+	// Create a variable to hold the result
+	var assignments []*models.ShiftAssignment
+
+	// Execute the query
+	if err := tx.Where("(employee_id IN ? OR department_id IN ?)", employeeIDs, departmentIDs).
+		Where("effective_from < ?", to).
+		// effective_to is the inclusive last day
+		Where("(effective_to IS NULL OR effective_to >= ?)", from).
+		Order("effective_from ASC, id ASC").
+		Find(&assignments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list shift assignments: %w", err)
+	}
+
+	return assignments, nil
+}
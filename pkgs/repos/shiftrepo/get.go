@@ -0,0 +1,44 @@
+package shiftrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+func (r *repo) Get(ctx context.Context, tx *gorm.DB, id int64) (*models.Shift, error) {
+	// Create a variable to hold the result
+	var shift models.Shift
+
+	// Execute the query
+	if err := tx.Where("id = ?", id).First(&shift).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get shift: %w", err)
+	}
+
+	// Return the result
+	return &shift, nil
+}
+
+// GetByName returns the shift with the given name. The comparison follows the
+// column collation, which ignores case.
+func (r *repo) GetByName(ctx context.Context, tx *gorm.DB, name string) (*models.Shift, error) {
+	// Create a variable to hold the result
+	var shift models.Shift
+
+	// Execute the query
+	if err := tx.Where("name = ?", name).First(&shift).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get shift by name: %w", err)
+	}
+
+	// Return the result
+	return &shift, nil
+}
@@ -0,0 +1,44 @@
+package shiftrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// List returns every shift ordered by ID.
+func (r *repo) List(ctx context.Context, tx *gorm.DB) ([]*models.Shift, error) {
+	// Create a variable to hold the result
+	var shifts []*models.Shift
+
+	// Execute the query
+	if err := tx.Order("id ASC").Find(&shifts).Error; err != nil {
+		return nil, fmt.Errorf("failed to list shifts: %w", err)
+	}
+
+	return shifts, nil
+}
+
+// ListByIDs returns the given shifts keyed by ID.
+func (r *repo) ListByIDs(ctx context.Context, tx *gorm.DB, ids []int64) (map[int64]*models.Shift, error) {
+	result := make(map[int64]*models.Shift, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	// Execute the query
+	var shifts []*models.Shift
+	if err := tx.Where("id IN ?", ids).Find(&shifts).Error; err != nil {
+		return nil, fmt.Errorf("failed to list shifts: %w", err)
+	}
+
+	for _, shift := range shifts {
+		result[shift.ID] = shift
+	}
+
+	return result, nil
+}
@@ -0,0 +1,7 @@
+package shiftrepo
+
+type repo struct{}
+
+func New() *repo {
+	return &repo{}
+}
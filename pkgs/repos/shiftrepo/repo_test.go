@@ -0,0 +1,127 @@
+package shiftrepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestMain(m *testing.M) {
+	testutils.BeforeTestDb(m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestRepo_CRUD(t *testing.T) {
+	Convey("TestRepo_CRUD", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		faker := gofakeit.New(0)
+
+		// Prepare test data
+		day := models.DummyShift(faker)
+		night := models.DummyShift(faker)
+		night.StartMinute = 22 * 60
+		night.EndMinute = 6 * 60
+		testutils.MustClearTable(t, db, models.Shift{})
+
+		// Create
+		{
+			Print("Create")
+			So(repo.Create(ctx, db, day), ShouldBeNil)
+			So(repo.Create(ctx, db, night), ShouldBeNil)
+			So(day.ID, ShouldNotEqual, 0)
+		}
+		// Get
+		{
+			Print("Get")
+			shiftRes, err := repo.Get(ctx, db, night.ID)
+			So(err, ShouldBeNil)
+			So(shiftRes, ShouldNotBeNil)
+			So(shiftRes.StartMinute, ShouldEqual, 22*60)
+			So(shiftRes.WorksOn(time.Monday), ShouldBeTrue)
+			So(shiftRes.WorksOn(time.Sunday), ShouldBeFalse)
+
+			shiftRes, err = repo.GetByName(ctx, db, day.Name)
+			So(err, ShouldBeNil)
+			So(shiftRes.ID, ShouldEqual, day.ID)
+
+			shiftRes, err = repo.Get(ctx, db, night.ID+1)
+			So(err, ShouldBeNil)
+			So(shiftRes, ShouldBeNil)
+		}
+		// List
+		{
+			Print("List")
+			shifts, err := repo.List(ctx, db)
+			So(err, ShouldBeNil)
+			So(shifts, ShouldHaveLength, 2)
+			So(shifts[0].ID, ShouldEqual, day.ID)
+
+			byID, err := repo.ListByIDs(ctx, db, []int64{night.ID})
+			So(err, ShouldBeNil)
+			So(byID, ShouldHaveLength, 1)
+			So(byID[night.ID].Name, ShouldEqual, night.Name)
+		}
+	})
+}
+
+func TestRepo_Assignments(t *testing.T) {
+	Convey("TestRepo_Assignments", t, func() {
+		// Setup
+		ctx := t.Context()
+		db := testutils.GetDB().DB
+		repo := New()
+		testutils.MustClearTable(t, db, models.ShiftAssignment{})
+
+		// Prepare test data
+		may := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		assignments := []*models.ShiftAssignment{
+			// Employee 1 in May only
+			{ShiftID: 1, EmployeeID: lo.ToPtr(int64(1)), EffectiveFrom: may, EffectiveTo: lo.ToPtr(june.AddDate(0, 0, -1))},
+			// Department 10 from June on
+			{ShiftID: 2, DepartmentID: lo.ToPtr(int64(10)), EffectiveFrom: june},
+			// Employee 2 from May on
+			{ShiftID: 1, EmployeeID: lo.ToPtr(int64(2)), EffectiveFrom: may},
+		}
+		for _, assignment := range assignments {
+			So(repo.CreateAssignment(ctx, db, assignment), ShouldBeNil)
+		}
+
+		// By shift
+		{
+			Print("By shift")
+			res, err := repo.ListAssignmentsByShiftID(ctx, db, 1)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+		}
+		// Effective in a window
+		{
+			Print("Effective in a window")
+			res, err := repo.ListAssignments(ctx, db, []int64{1}, []int64{10}, june, june.AddDate(0, 0, 7))
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, assignments[1].ID)
+
+			// The last day of an assignment is included
+			res, err = repo.ListAssignments(ctx, db, []int64{1}, nil, june.AddDate(0, 0, -1), june)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 1)
+			So(res[0].ID, ShouldEqual, assignments[0].ID)
+
+			res, err = repo.ListAssignments(ctx, db, nil, nil, may, june)
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		}
+	})
+}
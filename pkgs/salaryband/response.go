@@ -0,0 +1,38 @@
+package salaryband
+
+import (
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewV1Response renders a salary band.
+func NewV1Response(band *models.SalaryBand, formatter utils.TimeFormatter) dtos.SalaryBandV1Response {
+	low, mid, high := Range(band)
+	return dtos.SalaryBandV1Response{
+		SalaryBandID:  band.ID,
+		Position:      band.Position,
+		Min:           low,
+		Mid:           mid,
+		Max:           high,
+		EffectiveDate: band.EffectiveDate.Format(DateLayout),
+		CreatedAt:     formatter.Time(band.CreatedAt),
+		UpdatedAt:     formatter.Time(band.UpdatedAt),
+	}
+}
+
+// NewCheckV1Response renders where a salary falls in its band.
+func NewCheckV1Response(band *models.SalaryBand, salary money.Money) dtos.SalaryBandCheckV1Response {
+	low, mid, high := Range(band)
+	return dtos.SalaryBandCheckV1Response{
+		SalaryBandID: band.ID,
+		Min:          low,
+		Mid:          mid,
+		Max:          high,
+		CompaRatio:   CompaRatio(band, salary),
+		Status:       Status(band, salary),
+	}
+}
@@ -0,0 +1,68 @@
+// Package salaryband places salaries in the salary band of their position.
+package salaryband
+
+import (
+	"errors"
+	"math"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const DateLayout = "2006-01-02"
+
+// The policies applied to the salaries out of their band
+const (
+	// PolicyOff ignores the bands
+	PolicyOff = "off"
+	// PolicyFlag accepts the salaries and reports where they fall
+	PolicyFlag = "flag"
+	// PolicyReject refuses the salaries out of their band
+	PolicyReject = "reject"
+)
+
+const (
+	StatusBelow  = "below"
+	StatusWithin = "within"
+	StatusAbove  = "above"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Validate checks the range of a band about to be written.
+func Validate(band *models.SalaryBand) error {
+	if band.Min <= 0 {
+		return errors.New("min must be positive")
+	}
+	if band.Min > band.Mid || band.Mid > band.Max {
+		return errors.New("min, mid and max must be in ascending order")
+	}
+	return nil
+}
+
+// Status places a salary in a band of the same currency, bounds included.
+func Status(band *models.SalaryBand, salary money.Money) string {
+	switch {
+	case salary.Amount < band.Min:
+		return StatusBelow
+	case salary.Amount > band.Max:
+		return StatusAbove
+	default:
+		return StatusWithin
+	}
+}
+
+// CompaRatio is the salary divided by the midpoint of its band, rounded to
+// two decimals.
+func CompaRatio(band *models.SalaryBand, salary money.Money) float64 {
+	return math.Round(float64(salary.Amount)/float64(band.Mid)*100) / 100
+}
+
+// Range returns the min, mid and max of a band.
+func Range(band *models.SalaryBand) (low, mid, high money.Money) {
+	return money.New(band.Min, band.Currency),
+		money.New(band.Mid, band.Currency),
+		money.New(band.Max, band.Currency)
+}
@@ -0,0 +1,49 @@
+package salaryband
+
+import (
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/money"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("Given band ranges", t, func() {
+		Convey("An ascending range should be valid", func() {
+			So(Validate(&models.SalaryBand{Min: 300000, Mid: 400000, Max: 500000}), ShouldBeNil)
+			So(Validate(&models.SalaryBand{Min: 400000, Mid: 400000, Max: 400000}), ShouldBeNil)
+		})
+
+		Convey("A range out of order should be invalid", func() {
+			So(Validate(&models.SalaryBand{Min: 300000, Mid: 600000, Max: 500000}), ShouldNotBeNil)
+			So(Validate(&models.SalaryBand{Min: 450000, Mid: 400000, Max: 500000}), ShouldNotBeNil)
+		})
+
+		Convey("A range starting at zero should be invalid", func() {
+			So(Validate(&models.SalaryBand{Min: 0, Mid: 400000, Max: 500000}), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestStatus(t *testing.T) {
+	Convey("Given a band from 3000 to 5000 USD", t, func() {
+		band := &models.SalaryBand{Currency: "USD", Min: 300000, Mid: 400000, Max: 500000}
+
+		Convey("The bounds should be within the band", func() {
+			So(Status(band, money.New(300000, "USD")), ShouldEqual, StatusWithin)
+			So(Status(band, money.New(500000, "USD")), ShouldEqual, StatusWithin)
+		})
+
+		Convey("Salaries past the bounds should be out of the band", func() {
+			So(Status(band, money.New(299999, "USD")), ShouldEqual, StatusBelow)
+			So(Status(band, money.New(500001, "USD")), ShouldEqual, StatusAbove)
+		})
+
+		Convey("The compa-ratio should be relative to the midpoint", func() {
+			So(CompaRatio(band, money.New(400000, "USD")), ShouldEqual, 1)
+			So(CompaRatio(band, money.New(460000, "USD")), ShouldEqual, 1.15)
+			So(CompaRatio(band, money.New(333333, "USD")), ShouldEqual, 0.83)
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const dateLayout = "2006-01-02"
+
+// NewShiftV1Response renders a shift template.
+func NewShiftV1Response(shift *models.Shift, formatter utils.TimeFormatter) dtos.ShiftV1Response {
+	return dtos.ShiftV1Response{
+		ShiftID:      shift.ID,
+		Name:         shift.Name,
+		StartTime:    FormatClock(shift.StartMinute),
+		EndTime:      FormatClock(shift.EndMinute),
+		Weekdays:     FormatWeekdays(shift.Weekdays),
+		GraceMinutes: shift.GraceMinutes,
+		CreatedAt:    formatter.Time(shift.CreatedAt),
+		UpdatedAt:    formatter.Time(shift.UpdatedAt),
+	}
+}
+
+// NewAssignmentV1Response renders a shift assignment.
+func NewAssignmentV1Response(assignment *models.ShiftAssignment) dtos.ShiftAssignmentV1Response {
+	resp := dtos.ShiftAssignmentV1Response{
+		AssignmentID:  assignment.ID,
+		ShiftID:       assignment.ShiftID,
+		EmployeeID:    assignment.EmployeeID,
+		DepartmentID:  assignment.DepartmentID,
+		EffectiveFrom: assignment.EffectiveFrom.Format(dateLayout),
+	}
+	if assignment.EffectiveTo != nil {
+		resp.EffectiveTo = assignment.EffectiveTo.Format(dateLayout)
+	}
+	return resp
+}
+
+// NewReportV1Response renders the report of an employee.
+func NewReportV1Response(employeeID int64, report *Report, formatter utils.TimeFormatter) dtos.ShiftReportV1Response {
+	days := make([]dtos.ShiftReportDayV1Response, 0, len(report.Days))
+	for _, day := range report.Days {
+		resp := dtos.ShiftReportDayV1Response{
+			Date:           day.Date.Format(dateLayout),
+			ShiftID:        day.Shift.ID,
+			ShiftName:      day.Shift.Name,
+			ScheduledStart: formatter.Time(day.Start),
+			ScheduledEnd:   formatter.Time(day.End),
+			Late:           day.Late > 0,
+			LateMinutes:    int64(day.Late / time.Minute),
+			LeftEarly:      day.LeftEarly > 0,
+			EarlyMinutes:   int64(day.LeftEarly / time.Minute),
+			Absent:         day.Absent,
+			OnLeave:        day.OnLeave,
+		}
+		if day.Holiday != nil {
+			resp.Holiday = day.Holiday.Name
+		}
+		if day.ClockIn != nil {
+			resp.ClockInTime = formatter.Time(*day.ClockIn)
+		}
+		if day.ClockOut != nil {
+			resp.ClockOutTime = formatter.Time(*day.ClockOut)
+		}
+		days = append(days, resp)
+	}
+
+	return dtos.ShiftReportV1Response{
+		EmployeeID:     employeeID,
+		Start:          report.From.Format(dateLayout),
+		End:            report.To.AddDate(0, 0, -1).Format(dateLayout),
+		LateCount:      report.Late,
+		LeftEarlyCount: report.LeftEarly,
+		AbsentCount:    report.Absent,
+		OnLeaveCount:   report.OnLeave,
+		HolidayCount:   report.Holidays,
+		Days:           days,
+	}
+}
+
+// NewDepartmentReportV1Response renders the reports of the department members.
+func NewDepartmentReportV1Response(
+	departmentID int64,
+	from, to time.Time,
+	employees []dtos.ShiftReportV1Response,
+) dtos.DepartmentShiftReportV1Response {
+	resp := dtos.DepartmentShiftReportV1Response{
+		DepartmentID: departmentID,
+		Start:        from.Format(dateLayout),
+		End:          to.AddDate(0, 0, -1).Format(dateLayout),
+		Employees:    employees,
+	}
+	for _, employee := range employees {
+		resp.LateCount += employee.LateCount
+		resp.LeftEarlyCount += employee.LeftEarlyCount
+		resp.AbsentCount += employee.AbsentCount
+		resp.OnLeaveCount += employee.OnLeaveCount
+		resp.HolidayCount += employee.HolidayCount
+	}
+	return resp
+}
@@ -0,0 +1,285 @@
+// Package schedule resolves the shift assigned to an employee on each day and
+// compares it with the attendance sessions. Days are calendar dates at midnight
+// UTC, and the clock times of a shift are wall-clock times in the zone of the
+// employee.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const ClockLayout = "15:04"
+
+// ParseClock parses an HH:MM clock time into minutes since midnight.
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse(ClockLayout, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClock formats minutes since midnight as HH:MM.
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// weekdayNames is indexed by time.Weekday
+var weekdayNames = [...]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseWeekdays turns day names such as mon into the bit set of Shift.Weekdays.
+func ParseWeekdays(names []string) (int, error) {
+	weekdays := 0
+	for _, name := range names {
+		found := false
+		for weekday, weekdayName := range weekdayNames {
+			if strings.EqualFold(name, weekdayName) {
+				weekdays |= 1 << weekday
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("invalid weekday %q", name)
+		}
+	}
+	return weekdays, nil
+}
+
+// FormatWeekdays lists the day names of a bit set, starting on Sunday.
+func FormatWeekdays(weekdays int) []string {
+	names := make([]string, 0, len(weekdayNames))
+	for weekday, name := range weekdayNames {
+		if weekdays&(1<<weekday) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Window returns the scheduled [start, end) of the shift starting on the day,
+// its clock times read in loc.
+func Window(shift *models.Shift, date time.Time, loc *time.Location) (time.Time, time.Time) {
+	endDay := date.Day()
+	if shift.EndMinute <= shift.StartMinute {
+		// Overnight shift
+		endDay++
+	}
+	start := time.Date(date.Year(), date.Month(), date.Day(), shift.StartMinute/60, shift.StartMinute%60, 0, 0, loc)
+	end := time.Date(date.Year(), date.Month(), endDay, shift.EndMinute/60, shift.EndMinute%60, 0, 0, loc)
+	return start, end
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Schedule resolves the shift of one employee on each day.
+type Schedule struct {
+	employeeID int64
+	// positions of the employee ordered by start date
+	positions []*models.EmployeePosition
+	// assignments ordered by effective date
+	assignments []*models.ShiftAssignment
+	shifts      map[int64]*models.Shift
+}
+
+func New(
+	employeeID int64,
+	positions []*models.EmployeePosition,
+	assignments []*models.ShiftAssignment,
+	shifts map[int64]*models.Shift,
+) *Schedule {
+	return &Schedule{
+		employeeID:  employeeID,
+		positions:   positions,
+		assignments: assignments,
+		shifts:      shifts,
+	}
+}
+
+// ShiftOn returns the shift assigned on the day, nil when none is. An
+// assignment of the employee wins over the one of the department the employee
+// is in on that day, and the latest effective assignment wins over the others.
+func (s *Schedule) ShiftOn(date time.Time) *models.Shift {
+	departmentID := s.departmentOn(date)
+
+	var employeeAssignment, departmentAssignment *models.ShiftAssignment
+	for _, assignment := range s.assignments {
+		if !assignment.EffectiveOn(date) {
+			continue
+		}
+		if assignment.EmployeeID != nil && *assignment.EmployeeID == s.employeeID {
+			employeeAssignment = assignment
+		}
+		if assignment.DepartmentID != nil && departmentID != 0 && *assignment.DepartmentID == departmentID {
+			departmentAssignment = assignment
+		}
+	}
+
+	switch {
+	case employeeAssignment != nil:
+		return s.shifts[employeeAssignment.ShiftID]
+	case departmentAssignment != nil:
+		return s.shifts[departmentAssignment.ShiftID]
+	default:
+		return nil
+	}
+}
+
+// departmentOn returns the department of the position held on the day, zero
+// before the first position.
+func (s *Schedule) departmentOn(date time.Time) int64 {
+	departmentID := int64(0)
+	for _, position := range s.positions {
+		if position.StartDate.After(date) {
+			break
+		}
+		departmentID = position.DepartmentID
+	}
+	return departmentID
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Day struct {
+	Date  time.Time
+	Shift *models.Shift
+	// Start and End are the scheduled times
+	Start time.Time
+	End   time.Time
+
+	// ClockIn is the first clock-in of the sessions worked during the shift
+	ClockIn *time.Time
+	// ClockOut is the last clock-out, nil while a session is still open
+	ClockOut *time.Time
+
+	// Late and LeftEarly are zero unless beyond the grace period
+	Late      time.Duration
+	LeftEarly time.Duration
+	Absent    bool
+	// OnLeave days are covered by approved leave and never flagged
+	OnLeave bool
+	// Holiday is the public holiday falling on the day, never flagged either
+	Holiday *models.Holiday
+}
+
+type Report struct {
+	From time.Time
+	To   time.Time
+	// Days lists the scheduled days, the others are left out
+	Days      []Day
+	Late      int
+	LeftEarly int
+	Absent    int
+	OnLeave   int
+	Holidays  int
+}
+
+// Evaluate compares the sessions with the shifts scheduled on the days of
+// [from, to), the shifts being worked in loc. Shifts that have not ended by
+// nowTime are left out, and voided sessions are ignored. Public holidays and
+// days covered by the approved leaves are reported as such rather than as
+// absences, a holiday taking precedence over leave.
+func Evaluate(
+	schedule *Schedule,
+	attendances []*models.EmployeeAttendance,
+	leaves []*models.LeaveRequest,
+	holidays []*models.Holiday,
+	from, to, nowTime time.Time,
+	loc *time.Location,
+) *Report {
+	report := &Report{
+		From: from,
+		To:   to,
+	}
+
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		shift := schedule.ShiftOn(date)
+		if shift == nil || !shift.WorksOn(date.Weekday()) {
+			continue
+		}
+		start, end := Window(shift, date, loc)
+		if end.After(nowTime) {
+			continue
+		}
+
+		day := Day{
+			Date:  date,
+			Shift: shift,
+			Start: start,
+			End:   end,
+		}
+		var lastOut time.Time
+		open := false
+		for _, attendance := range attendances {
+			if attendance.Status == models.AttendanceStatusVoided {
+				continue
+			}
+
+			// An open session runs up to now
+			sessionEnd := nowTime
+			if attendance.ClockOut != nil {
+				sessionEnd = *attendance.ClockOut
+			}
+			if !attendance.ClockIn.Before(end) || !sessionEnd.After(start) {
+				continue
+			}
+
+			if day.ClockIn == nil || attendance.ClockIn.Before(*day.ClockIn) {
+				day.ClockIn = &attendance.ClockIn
+			}
+			if sessionEnd.After(lastOut) {
+				lastOut = sessionEnd
+			}
+			open = open || attendance.ClockOut == nil
+		}
+
+		holiday, isHoliday := lo.Find(holidays, func(holiday *models.Holiday) bool {
+			return holiday.Date.Equal(date)
+		})
+		onLeave := lo.ContainsBy(leaves, func(leave *models.LeaveRequest) bool {
+			return leave.Status == models.LeaveStatusApproved && leave.Covers(date)
+		})
+
+		grace := time.Duration(shift.GraceMinutes) * time.Minute
+		switch {
+		case isHoliday:
+			day.Holiday = holiday
+			report.Holidays++
+			if day.ClockIn != nil && !open {
+				day.ClockOut = &lastOut
+			}
+		case onLeave:
+			day.OnLeave = true
+			report.OnLeave++
+			if day.ClockIn != nil && !open {
+				day.ClockOut = &lastOut
+			}
+		case day.ClockIn == nil:
+			day.Absent = true
+			report.Absent++
+		default:
+			if day.ClockIn.After(start.Add(grace)) {
+				day.Late = day.ClockIn.Sub(start)
+				report.Late++
+			}
+			if !open {
+				day.ClockOut = &lastOut
+				if lastOut.Before(end.Add(-grace)) {
+					day.LeftEarly = end.Sub(lastOut)
+					report.LeftEarly++
+				}
+			}
+		}
+
+		report.Days = append(report.Days, day)
+	}
+
+	return report
+}
@@ -0,0 +1,221 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("Given clock times and weekdays", t, func() {
+		Convey("Clock times should round trip", func() {
+			minutes, err := ParseClock("09:30")
+			So(err, ShouldBeNil)
+			So(minutes, ShouldEqual, 570)
+			So(FormatClock(minutes), ShouldEqual, "09:30")
+
+			_, err = ParseClock("9h30")
+			So(err, ShouldNotBeNil)
+			_, err = ParseClock("24:00")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Weekdays should round trip starting on Sunday", func() {
+			weekdays, err := ParseWeekdays([]string{"fri", "Mon", "sun"})
+			So(err, ShouldBeNil)
+			So(FormatWeekdays(weekdays), ShouldResemble, []string{"sun", "mon", "fri"})
+
+			_, err = ParseWeekdays([]string{"monday"})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("An overnight shift should end on the next day", func() {
+			date := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+			start, end := Window(&models.Shift{StartMinute: 22 * 60, EndMinute: 6 * 60}, date, time.UTC)
+			So(start, ShouldEqual, time.Date(2025, 5, 5, 22, 0, 0, 0, time.UTC))
+			So(end, ShouldEqual, time.Date(2025, 5, 6, 6, 0, 0, 0, time.UTC))
+		})
+
+		Convey("The clock times should be read in the zone of the employee", func() {
+			date := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+			taipei := time.FixedZone("UTC+8", 8*60*60)
+			start, end := Window(&models.Shift{StartMinute: 9 * 60, EndMinute: 17 * 60}, date, taipei)
+			So(start.Equal(time.Date(2025, 5, 5, 1, 0, 0, 0, time.UTC)), ShouldBeTrue)
+			So(end.Equal(time.Date(2025, 5, 5, 9, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		})
+	})
+}
+
+func TestShiftOn(t *testing.T) {
+	Convey("Given an employee moving to another department", t, func() {
+		employeeID := int64(1)
+		may := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		positions := []*models.EmployeePosition{
+			{EmployeeID: employeeID, DepartmentID: 10, StartDate: may},
+			{EmployeeID: employeeID, DepartmentID: 20, StartDate: june},
+		}
+		shifts := map[int64]*models.Shift{
+			1: {ID: 1, Name: "day"},
+			2: {ID: 2, Name: "night"},
+			3: {ID: 3, Name: "weekend"},
+		}
+		assignments := []*models.ShiftAssignment{
+			{ShiftID: 1, DepartmentID: lo.ToPtr(int64(10)), EffectiveFrom: may},
+			{ShiftID: 2, DepartmentID: lo.ToPtr(int64(20)), EffectiveFrom: may},
+			// A week on another shift
+			{ShiftID: 3, EmployeeID: lo.ToPtr(employeeID), EffectiveFrom: may.AddDate(0, 0, 7), EffectiveTo: lo.ToPtr(may.AddDate(0, 0, 13))},
+		}
+		schedule := New(employeeID, positions, assignments, shifts)
+
+		Convey("The department shift should follow the position", func() {
+			So(schedule.ShiftOn(may).ID, ShouldEqual, 1)
+			So(schedule.ShiftOn(june).ID, ShouldEqual, 2)
+		})
+
+		Convey("An employee assignment should win over the department", func() {
+			So(schedule.ShiftOn(may.AddDate(0, 0, 7)).ID, ShouldEqual, 3)
+			So(schedule.ShiftOn(may.AddDate(0, 0, 13)).ID, ShouldEqual, 3)
+			So(schedule.ShiftOn(may.AddDate(0, 0, 14)).ID, ShouldEqual, 1)
+		})
+
+		Convey("No shift should be assigned before the first position", func() {
+			So(schedule.ShiftOn(may.AddDate(0, 0, -1)), ShouldBeNil)
+		})
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	Convey("Given a week on a 09:00 to 17:00 shift with 5 minutes of grace", t, func() {
+		employeeID := int64(1)
+		// Monday
+		from := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 7)
+		// Friday 16:00, the Friday shift is not over
+		nowTime := from.AddDate(0, 0, 4).Add(16 * time.Hour)
+
+		shift := &models.Shift{ID: 1, Name: "day", StartMinute: 9 * 60, EndMinute: 17 * 60, Weekdays: 0b0111110, GraceMinutes: 5}
+		schedule := New(
+			employeeID,
+			[]*models.EmployeePosition{{EmployeeID: employeeID, DepartmentID: 10, StartDate: from}},
+			[]*models.ShiftAssignment{{ShiftID: 1, DepartmentID: lo.ToPtr(int64(10)), EffectiveFrom: from}},
+			map[int64]*models.Shift{1: shift},
+		)
+
+		at := func(day int, hour, minute int) time.Time {
+			return from.AddDate(0, 0, day).Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+		}
+		closed := func(clockIn, clockOut time.Time) *models.EmployeeAttendance {
+			return &models.EmployeeAttendance{ClockIn: clockIn, ClockOut: lo.ToPtr(clockOut), Status: models.AttendanceStatusClosed}
+		}
+		attendances := []*models.EmployeeAttendance{
+			// Monday on time, within the grace period
+			closed(at(0, 9, 4), at(0, 17, 0)),
+			// Tuesday late and back from lunch early, leaving early
+			closed(at(1, 9, 30), at(1, 12, 0)),
+			closed(at(1, 13, 0), at(1, 16, 0)),
+			// Wednesday voided, so absent
+			{ClockIn: at(2, 9, 0), ClockOut: lo.ToPtr(at(2, 17, 0)), Status: models.AttendanceStatusVoided},
+			// Thursday still open
+			{ClockIn: at(3, 9, 0), Status: models.AttendanceStatusOpen},
+		}
+
+		report := Evaluate(schedule, attendances, nil, nil, from, to, nowTime, time.UTC)
+
+		Convey("Then only the shifts that ended should be listed", func() {
+			So(report.Days, ShouldHaveLength, 4)
+			So(report.Days[3].Date, ShouldEqual, from.AddDate(0, 0, 3))
+		})
+
+		Convey("Then an arrival within the grace period should not be late", func() {
+			So(report.Days[0].Late, ShouldEqual, 0)
+			So(report.Days[0].LeftEarly, ShouldEqual, 0)
+			So(*report.Days[0].ClockOut, ShouldEqual, at(0, 17, 0))
+		})
+
+		Convey("Then the first arrival and the last departure should be compared", func() {
+			So(report.Days[1].Late, ShouldEqual, 30*time.Minute)
+			So(report.Days[1].LeftEarly, ShouldEqual, time.Hour)
+		})
+
+		Convey("Then a voided session should not count as presence", func() {
+			So(report.Days[2].Absent, ShouldBeTrue)
+		})
+
+		Convey("Then an open session should not be an early departure", func() {
+			So(report.Days[3].ClockOut, ShouldBeNil)
+			So(report.Days[3].LeftEarly, ShouldEqual, 0)
+		})
+
+		Convey("Then the totals should be counted", func() {
+			So(report.Late, ShouldEqual, 1)
+			So(report.LeftEarly, ShouldEqual, 1)
+			So(report.Absent, ShouldEqual, 1)
+		})
+
+		Convey("When the employee works in a zone ahead of UTC", func() {
+			taipei := time.FixedZone("UTC+8", 8*60*60)
+			// Monday 09:04 and Tuesday 09:30 in Taipei
+			attendances := []*models.EmployeeAttendance{
+				closed(at(0, 1, 4), at(0, 9, 0)),
+				closed(at(1, 1, 30), at(1, 9, 0)),
+			}
+			report := Evaluate(schedule, attendances, nil, nil, from, to, nowTime, taipei)
+
+			Convey("Then the sessions should be compared with the local shift", func() {
+				So(report.Days[0].Late, ShouldEqual, 0)
+				So(report.Days[0].LeftEarly, ShouldEqual, 0)
+				So(report.Days[1].Late, ShouldEqual, 30*time.Minute)
+				So(report.Days[2].Absent, ShouldBeTrue)
+				// The Friday shift ended at 09:00 UTC
+				So(report.Days, ShouldHaveLength, 5)
+			})
+		})
+
+		Convey("When Wednesday is covered by approved leave", func() {
+			leaves := []*models.LeaveRequest{
+				{StartDate: from.AddDate(0, 0, 2), EndDate: from.AddDate(0, 0, 2), Status: models.LeaveStatusApproved},
+				// Only approved leave counts
+				{StartDate: from.AddDate(0, 0, 1), EndDate: from.AddDate(0, 0, 1), Status: models.LeaveStatusPending},
+			}
+			report := Evaluate(schedule, attendances, leaves, nil, from, to, nowTime, time.UTC)
+
+			Convey("Then the day should be on leave rather than absent", func() {
+				So(report.Days[2].OnLeave, ShouldBeTrue)
+				So(report.Days[2].Absent, ShouldBeFalse)
+				So(report.Days[1].OnLeave, ShouldBeFalse)
+				So(report.Absent, ShouldEqual, 0)
+				So(report.OnLeave, ShouldEqual, 1)
+				So(report.Late, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When Tuesday and Wednesday are public holidays", func() {
+			holidays := []*models.Holiday{
+				{Date: from.AddDate(0, 0, 1), Name: "Dragon Boat Festival"},
+				{Date: from.AddDate(0, 0, 2), Name: "Bridge Day"},
+			}
+			leaves := []*models.LeaveRequest{
+				{StartDate: from.AddDate(0, 0, 2), EndDate: from.AddDate(0, 0, 2), Status: models.LeaveStatusApproved},
+			}
+			report := Evaluate(schedule, attendances, leaves, holidays, from, to, nowTime, time.UTC)
+
+			Convey("Then the days should be holidays rather than late, absent or on leave", func() {
+				So(report.Days[1].Holiday.Name, ShouldEqual, "Dragon Boat Festival")
+				So(report.Days[1].Late, ShouldEqual, 0)
+				So(report.Days[1].ClockOut, ShouldNotBeNil)
+				So(report.Days[2].Holiday.Name, ShouldEqual, "Bridge Day")
+				So(report.Days[2].OnLeave, ShouldBeFalse)
+				So(report.Days[0].Holiday, ShouldBeNil)
+				So(report.Holidays, ShouldEqual, 2)
+				So(report.OnLeave, ShouldEqual, 0)
+				So(report.Late, ShouldEqual, 0)
+				So(report.Absent, ShouldEqual, 0)
+			})
+		})
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/WangWilly/labs-hr-go/pkgs/repos/departmentrepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeattendancerepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeeinforepo"
 	"github.com/WangWilly/labs-hr-go/pkgs/repos/employeepositionrepo"
@@ -23,10 +24,22 @@ func SeedData(ctx context.Context, db *gorm.DB) error {
 	employeeInfo5 := models.DummyEmployeeInfo(faker)
 
 	// Create repositories
+	departmentRepo := departmentrepo.New()
 	employeeInfoRepo := employeeinforepo.New()
 	employeePositionRepo := employeepositionrepo.New()
 	employeeAttendanceRepo := employeeattendancerepo.New()
 
+	// Insert a small department hierarchy
+	engineering := &models.Department{Name: "Engineering"}
+	if err := departmentRepo.Create(ctx, db, engineering); err != nil {
+		return err
+	}
+	platform := &models.Department{Name: "Platform", ParentID: &engineering.ID}
+	if err := departmentRepo.Create(ctx, db, platform); err != nil {
+		return err
+	}
+	departments := []*models.Department{engineering, platform}
+
 	// Insert employee info records
 	if err := employeeInfoRepo.Create(ctx, db, employeeInfo1); err != nil {
 		return err
@@ -46,9 +59,11 @@ func SeedData(ctx context.Context, db *gorm.DB) error {
 
 	// Create employee positions for each employee
 	employees := []*models.EmployeeInfo{employeeInfo1, employeeInfo2, employeeInfo3, employeeInfo4, employeeInfo5}
-	for _, emp := range employees {
+	for i, emp := range employees {
 		position := models.DummyEmployeePosition(faker)
 		position.EmployeeID = emp.ID
+		position.DepartmentID = departments[i%len(departments)].ID
+		position.Department = departments[i%len(departments)].Name
 
 		if err := employeePositionRepo.Create(ctx, db, position, time.Now()); err != nil {
 			return err
@@ -1,5 +1,7 @@
 package taskmanager
 
+import "time"
+
 ////////////////////////////////////////////////////////////////////////////////
 
 //go:generate mockgen -source=interface.go -destination=taskmanager_mock.go -package=taskmanager
@@ -10,3 +12,10 @@ type Task interface {
 	GetProgress() int64
 	Cancel()
 }
+
+// ScheduledTask is a task that must not run before RunAt, the pool keeps it
+// parked until then.
+type ScheduledTask interface {
+	Task
+	RunAt() time.Time
+}
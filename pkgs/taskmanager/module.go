@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/WangWilly/labs-hr-go/pkgs/utils"
 )
@@ -18,12 +19,22 @@ type TaskPool struct {
 	maxWorkers int
 	tasks      chan Task
 	idTaskMap  map[string]Task
-	retryMap   map[string]Task
+	parkedMap  map[string]*parkedTask
+	mu         sync.Mutex
+	// shutdown is set once ShutdownNow is called, no task is accepted after
+	shutdown   bool
 	wg         sync.WaitGroup
 	ctx        context.Context
 	cancelFunc context.CancelFunc
 }
 
+// parkedTask is a task waiting for its time or its retry, stop is closed when
+// it is cancelled.
+type parkedTask struct {
+	task Task
+	stop chan struct{}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func NewTaskPool(cfg Config) *TaskPool {
@@ -32,7 +43,7 @@ func NewTaskPool(cfg Config) *TaskPool {
 		maxWorkers: cfg.NumWorkers,
 		tasks:      make(chan Task, cfg.NumWorkers*10),
 		idTaskMap:  make(map[string]Task),
-		retryMap:   make(map[string]Task),
+		parkedMap:  make(map[string]*parkedTask),
 		wg:         sync.WaitGroup{},
 		ctx:        ctx,
 		cancelFunc: cancel,
@@ -49,32 +60,93 @@ func (p *TaskPool) SubmitTask(task Task) {
 	if task == nil {
 		return
 	}
-	if _, ok := p.idTaskMap[task.GetID()]; ok {
+	p.mu.Lock()
+	if p.shutdown {
+		p.mu.Unlock()
+		return
+	}
+	if p.tracked(task.GetID()) {
+		p.mu.Unlock()
 		return
 	}
+	// A scheduled task waits for its time parked, without holding a worker
+	if scheduled, ok := task.(ScheduledTask); ok {
+		if delay := time.Until(scheduled.RunAt()); delay > 0 {
+			p.park(task, nil, delay)
+			p.mu.Unlock()
+			return
+		}
+	}
 	p.idTaskMap[task.GetID()] = task
-	p.tasks <- task
+	p.mu.Unlock()
+
+	// The channel is never closed, a full one is given up on at shutdown
+	select {
+	case p.tasks <- task:
+	case <-p.ctx.Done():
+	}
 }
 
 func (p *TaskPool) GetTaskProgress(taskID string) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	if task, ok := p.idTaskMap[taskID]; ok {
 		return task.GetProgress(), nil
 	}
-	if task, ok := p.retryMap[taskID]; ok {
-		return task.GetProgress(), nil
+	if parked, ok := p.parkedMap[taskID]; ok {
+		return parked.task.GetProgress(), nil
 	}
 	return 0, fmt.Errorf("task not found")
 }
 
 func (p *TaskPool) CancelTask(taskID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	if task, ok := p.idTaskMap[taskID]; ok {
 		task.Cancel()
 		delete(p.idTaskMap, taskID)
 		return nil
 	}
+	if parked, ok := p.parkedMap[taskID]; ok {
+		parked.task.Cancel()
+		close(parked.stop)
+		delete(p.parkedMap, taskID)
+		return nil
+	}
 	return fmt.Errorf("task not found")
 }
 
+// RunEvery runs fn at once, then every interval until the pool shuts down.
+// ShutdownNow waits for a running fn to return.
+func (p *TaskPool) RunEvery(interval time.Duration, fn func(ctx context.Context)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.shutdown {
+		return
+	}
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			fn(p.ctx)
+
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
 func (p *TaskPool) Run() {
 	p.runFor(p.createWorker)
 }
@@ -102,31 +174,99 @@ func (p *TaskPool) createWorker() {
 			}
 			done := task.Execute()
 			if done {
+				p.forget(task)
 				logger.Info().Msgf("Task %s completed successfully", task.GetID())
 				continue
 			}
 
-			logger.Warn().Msgf("Task %s failed, retrying...", task.GetID())
 			sig := task.SetRetrySignal()
 			if sig == nil {
-				logger.Warn().Msgf("Task %s retry signal is nil, skipping retry", task.GetID())
+				p.forget(task)
+				logger.Error().Msgf("Task %s failed, giving up", task.GetID())
+				continue
+			}
+			p.mu.Lock()
+			// The task was cancelled while it was running
+			if p.idTaskMap[task.GetID()] != task {
+				p.mu.Unlock()
 				continue
 			}
-			go func() {
-				<-sig
-				delete(p.retryMap, task.GetID())
-				p.SubmitTask(task)
-			}()
-			p.retryMap[task.GetID()] = task
 			delete(p.idTaskMap, task.GetID())
+			p.park(task, sig, 0)
+			p.mu.Unlock()
+			logger.Warn().Msgf("Task %s failed, retrying...", task.GetID())
 		}
 	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// tracked tells whether a task is queued, running or parked, p.mu must be held.
+func (p *TaskPool) tracked(taskID string) bool {
+	if _, ok := p.idTaskMap[taskID]; ok {
+		return true
+	}
+	_, ok := p.parkedMap[taskID]
+	return ok
+}
+
+// forget drops a finished task, unless it was replaced in the meantime.
+func (p *TaskPool) forget(task Task) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.idTaskMap[task.GetID()] == task {
+		delete(p.idTaskMap, task.GetID())
+	}
+}
+
+// park holds a task until its signal fires or its delay passes, then submits
+// it again. Cancelling the task or the pool wakes it up for good. p.mu must be
+// held.
+func (p *TaskPool) park(task Task, sig <-chan struct{}, delay time.Duration) {
+	parked := &parkedTask{task: task, stop: make(chan struct{})}
+	p.parkedMap[task.GetID()] = parked
+
+	go func() {
+		var timeout <-chan time.Time
+		if sig == nil {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+
+		select {
+		case <-sig:
+		case <-timeout:
+		case <-parked.stop:
+			return
+		case <-p.ctx.Done():
+			return
+		}
+
+		p.mu.Lock()
+		waiting := p.parkedMap[task.GetID()] == parked
+		if waiting {
+			delete(p.parkedMap, task.GetID())
+		}
+		p.mu.Unlock()
+
+		// The task was cancelled while it was parked
+		if !waiting {
+			return
+		}
+		p.SubmitTask(task)
+	}()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ShutdownNow stops the workers, the tasks submitted afterwards are dropped.
 func (p *TaskPool) ShutdownNow() {
+	p.mu.Lock()
+	p.shutdown = true
+	p.mu.Unlock()
+
 	p.cancelFunc()
-	close(p.tasks)
 	p.wg.Wait()
 }
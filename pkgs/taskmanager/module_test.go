@@ -214,11 +214,11 @@ func TestRunAndWorkers(t *testing.T) {
 			// Give some time for workers to process
 			time.Sleep(100 * time.Millisecond)
 
-			Convey("Then the task should be executed successfully", func() {
-				// Successful execution doesn't remove the task from the map
-				// in the current implementation
+			Convey("Then the task should be executed and forgotten", func() {
+				pool.mu.Lock()
 				_, exists := pool.idTaskMap[taskID]
-				So(exists, ShouldBeTrue)
+				pool.mu.Unlock()
+				So(exists, ShouldBeFalse)
 			})
 
 			// Clean up
@@ -243,14 +243,42 @@ func TestRunAndWorkers(t *testing.T) {
 			// Give some time for workers to process
 			time.Sleep(100 * time.Millisecond)
 
-			Convey("Then the task should be removed from the map and retry signal requested", func() {
+			Convey("Then the task should be parked until its retry signal", func() {
+				pool.mu.Lock()
 				_, exists := pool.idTaskMap[taskID]
+				_, parked := pool.parkedMap[taskID]
+				pool.mu.Unlock()
 				So(exists, ShouldBeFalse)
+				So(parked, ShouldBeTrue)
 			})
 
 			// Clean up
 			pool.ShutdownNow()
 		})
+
+		Convey("When running the worker pool with a task that gives up", func() {
+			mockTask := NewMockTask(ctrl)
+			taskID := "given-up-task"
+
+			mockTask.EXPECT().GetID().Return(taskID).AnyTimes()
+			mockTask.EXPECT().Execute().Return(false).Times(1)
+			mockTask.EXPECT().SetRetrySignal().Return(nil).Times(1)
+
+			pool.Run()
+			pool.SubmitTask(mockTask)
+			time.Sleep(100 * time.Millisecond)
+
+			Convey("Then the task should be forgotten", func() {
+				pool.mu.Lock()
+				_, exists := pool.idTaskMap[taskID]
+				_, parked := pool.parkedMap[taskID]
+				pool.mu.Unlock()
+				So(exists, ShouldBeFalse)
+				So(parked, ShouldBeFalse)
+			})
+
+			pool.ShutdownNow()
+		})
 	})
 }
 
@@ -297,6 +325,88 @@ func TestShutdownNow(t *testing.T) {
 	})
 }
 
+func TestSubmitTaskAfterShutdown(t *testing.T) {
+	Convey("Given a task pool that was shut down", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pool := NewTaskPool(Config{NumWorkers: 2})
+		pool.Run()
+		pool.ShutdownNow()
+
+		Convey("When submitting a task", func() {
+			mockTask := NewMockTask(ctrl)
+			mockTask.EXPECT().GetID().Return("late-task").AnyTimes()
+
+			Convey("Then the task should be dropped", func() {
+				So(func() { pool.SubmitTask(mockTask) }, ShouldNotPanic)
+				So(pool.idTaskMap, ShouldNotContainKey, "late-task")
+				So(pool.tasks, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestRunEvery(t *testing.T) {
+	Convey("Given a task pool", t, func() {
+		pool := NewTaskPool(Config{NumWorkers: 2})
+
+		Convey("When running a function every interval", func() {
+			runs := make(chan struct{}, 10)
+			pool.RunEvery(20*time.Millisecond, func(ctx context.Context) {
+				runs <- struct{}{}
+			})
+
+			Convey("Then it should run at once and again on every tick", func() {
+				for range 2 {
+					select {
+					case <-runs:
+					case <-time.After(time.Second):
+						t.Fatal("Timeout waiting for the function to run")
+					}
+				}
+
+				pool.ShutdownNow()
+				// The runs are over once ShutdownNow returns
+				count := len(runs)
+				time.Sleep(50 * time.Millisecond)
+				So(runs, ShouldHaveLength, count)
+			})
+		})
+
+		Convey("When the function is still running at shutdown", func() {
+			started := make(chan struct{})
+			finished := false
+			pool.RunEvery(time.Hour, func(ctx context.Context) {
+				close(started)
+				<-ctx.Done()
+				time.Sleep(20 * time.Millisecond)
+				finished = true
+			})
+			<-started
+			pool.ShutdownNow()
+
+			Convey("Then the shutdown should wait for it", func() {
+				So(finished, ShouldBeTrue)
+			})
+		})
+
+		Convey("When the pool was shut down", func() {
+			pool.ShutdownNow()
+
+			ran := false
+			pool.RunEvery(time.Millisecond, func(ctx context.Context) {
+				ran = true
+			})
+			time.Sleep(20 * time.Millisecond)
+
+			Convey("Then the function should never run", func() {
+				So(ran, ShouldBeFalse)
+			})
+		})
+	})
+}
+
 func TestTaskRetryAndResubmission(t *testing.T) {
 	Convey("Given a task pool", t, func() {
 		ctrl := gomock.NewController(t)
@@ -368,6 +478,7 @@ func TestTaskPoolWithContextCancellation(t *testing.T) {
 				maxWorkers: cfg.NumWorkers,
 				tasks:      make(chan Task, cfg.NumWorkers*10),
 				idTaskMap:  make(map[string]Task),
+				parkedMap:  make(map[string]*parkedTask),
 				wg:         sync.WaitGroup{},
 				ctx:        ctx,
 				cancelFunc: cancel,
@@ -423,3 +534,105 @@ func TestTaskPoolWithContextCancellation(t *testing.T) {
 		})
 	})
 }
+
+func TestCancelWaitingTask(t *testing.T) {
+	Convey("Given a task pool with a task waiting for its retry signal", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pool := NewTaskPool(Config{NumWorkers: 2})
+		pool.Run()
+		defer pool.ShutdownNow()
+
+		mockTask := NewMockTask(ctrl)
+		taskID := "waiting-task"
+		retryChan := make(chan struct{}, 1)
+
+		mockTask.EXPECT().GetID().Return(taskID).AnyTimes()
+		mockTask.EXPECT().Execute().Return(false).Times(1)
+		mockTask.EXPECT().SetRetrySignal().Return(retryChan).Times(1)
+
+		pool.SubmitTask(mockTask)
+
+		// Give some time for the initial execution
+		time.Sleep(50 * time.Millisecond)
+
+		Convey("When cancelling the waiting task", func() {
+			mockTask.EXPECT().Cancel().Times(1)
+
+			err := pool.CancelTask(taskID)
+
+			Convey("Then it should be removed and never resubmitted", func() {
+				So(err, ShouldBeNil)
+
+				pool.mu.Lock()
+				_, exists := pool.parkedMap[taskID]
+				pool.mu.Unlock()
+				So(exists, ShouldBeFalse)
+
+				// Firing the signal must not execute the task again
+				retryChan <- struct{}{}
+				time.Sleep(50 * time.Millisecond)
+			})
+		})
+	})
+}
+
+func TestScheduledTask(t *testing.T) {
+	Convey("Given a task pool and a task scheduled in the future", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pool := NewTaskPool(Config{NumWorkers: 2})
+		pool.Run()
+		defer pool.ShutdownNow()
+
+		mockTask := NewMockScheduledTask(ctrl)
+		taskID := "scheduled-task"
+
+		mockTask.EXPECT().GetID().Return(taskID).AnyTimes()
+		mockTask.EXPECT().RunAt().Return(time.Now().Add(100 * time.Millisecond)).AnyTimes()
+
+		Convey("When the task is submitted", func() {
+			executed := make(chan struct{})
+			mockTask.EXPECT().Execute().DoAndReturn(func() bool {
+				close(executed)
+				return true
+			}).Times(1)
+
+			pool.SubmitTask(mockTask)
+
+			Convey("Then it should be parked and run at its time", func() {
+				pool.mu.Lock()
+				_, parked := pool.parkedMap[taskID]
+				pool.mu.Unlock()
+				So(parked, ShouldBeTrue)
+
+				select {
+				case <-executed:
+					So(true, ShouldBeTrue)
+				case <-time.After(1 * time.Second):
+					t.Fatal("Timeout waiting for the scheduled task to run")
+				}
+			})
+		})
+
+		Convey("When the task is cancelled before its time", func() {
+			mockTask.EXPECT().Cancel().Times(1)
+
+			pool.SubmitTask(mockTask)
+			err := pool.CancelTask(taskID)
+
+			Convey("Then it should never run", func() {
+				So(err, ShouldBeNil)
+
+				// Execute is not expected, the mock fails the test if it runs
+				time.Sleep(200 * time.Millisecond)
+
+				pool.mu.Lock()
+				So(pool.tracked(taskID), ShouldBeFalse)
+				pool.mu.Unlock()
+			})
+		})
+	})
+}
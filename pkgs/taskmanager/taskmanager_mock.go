@@ -11,6 +11,7 @@ package taskmanager
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -106,3 +107,109 @@ func (mr *MockTaskMockRecorder) SetRetrySignal() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetrySignal", reflect.TypeOf((*MockTask)(nil).SetRetrySignal))
 }
+
+// MockScheduledTask is a mock of ScheduledTask interface.
+type MockScheduledTask struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledTaskMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduledTaskMockRecorder is the mock recorder for MockScheduledTask.
+type MockScheduledTaskMockRecorder struct {
+	mock *MockScheduledTask
+}
+
+// NewMockScheduledTask creates a new mock instance.
+func NewMockScheduledTask(ctrl *gomock.Controller) *MockScheduledTask {
+	mock := &MockScheduledTask{ctrl: ctrl}
+	mock.recorder = &MockScheduledTaskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledTask) EXPECT() *MockScheduledTaskMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockScheduledTask) Cancel() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Cancel")
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockScheduledTaskMockRecorder) Cancel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockScheduledTask)(nil).Cancel))
+}
+
+// Execute mocks base method.
+func (m *MockScheduledTask) Execute() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockScheduledTaskMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockScheduledTask)(nil).Execute))
+}
+
+// GetID mocks base method.
+func (m *MockScheduledTask) GetID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetID indicates an expected call of GetID.
+func (mr *MockScheduledTaskMockRecorder) GetID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetID", reflect.TypeOf((*MockScheduledTask)(nil).GetID))
+}
+
+// GetProgress mocks base method.
+func (m *MockScheduledTask) GetProgress() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgress")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockScheduledTaskMockRecorder) GetProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockScheduledTask)(nil).GetProgress))
+}
+
+// RunAt mocks base method.
+func (m *MockScheduledTask) RunAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// RunAt indicates an expected call of RunAt.
+func (mr *MockScheduledTaskMockRecorder) RunAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAt", reflect.TypeOf((*MockScheduledTask)(nil).RunAt))
+}
+
+// SetRetrySignal mocks base method.
+func (m *MockScheduledTask) SetRetrySignal() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetrySignal")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// SetRetrySignal indicates an expected call of SetRetrySignal.
+func (mr *MockScheduledTaskMockRecorder) SetRetrySignal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetrySignal", reflect.TypeOf((*MockScheduledTask)(nil).SetRetrySignal))
+}
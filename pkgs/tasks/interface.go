@@ -0,0 +1,15 @@
+package tasks
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interface.go -destination=interface_mock.go -package=tasks
+type TimeModule interface {
+	Now() time.Time
+}
+
+type PositionActivator interface {
+	ActivatePosition(ctx context.Context, employeeID int64, positionID int64) error
+}
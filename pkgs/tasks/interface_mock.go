@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=interface_mock.go -package=tasks
+//
+
+// Package tasks is a generated GoMock package.
+package tasks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTimeModule is a mock of TimeModule interface.
+type MockTimeModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeModuleMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeModuleMockRecorder is the mock recorder for MockTimeModule.
+type MockTimeModuleMockRecorder struct {
+	mock *MockTimeModule
+}
+
+// NewMockTimeModule creates a new mock instance.
+func NewMockTimeModule(ctrl *gomock.Controller) *MockTimeModule {
+	mock := &MockTimeModule{ctrl: ctrl}
+	mock.recorder = &MockTimeModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeModule) EXPECT() *MockTimeModuleMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *MockTimeModule) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockTimeModuleMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockTimeModule)(nil).Now))
+}
+
+// MockPositionActivator is a mock of PositionActivator interface.
+type MockPositionActivator struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionActivatorMockRecorder
+	isgomock struct{}
+}
+
+// MockPositionActivatorMockRecorder is the mock recorder for MockPositionActivator.
+type MockPositionActivatorMockRecorder struct {
+	mock *MockPositionActivator
+}
+
+// NewMockPositionActivator creates a new mock instance.
+func NewMockPositionActivator(ctrl *gomock.Controller) *MockPositionActivator {
+	mock := &MockPositionActivator{ctrl: ctrl}
+	mock.recorder = &MockPositionActivatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositionActivator) EXPECT() *MockPositionActivatorMockRecorder {
+	return m.recorder
+}
+
+// ActivatePosition mocks base method.
+func (m *MockPositionActivator) ActivatePosition(ctx context.Context, employeeID, positionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivatePosition", ctx, employeeID, positionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivatePosition indicates an expected call of ActivatePosition.
+func (mr *MockPositionActivatorMockRecorder) ActivatePosition(ctx, employeeID, positionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivatePosition", reflect.TypeOf((*MockPositionActivator)(nil).ActivatePosition), ctx, employeeID, positionID)
+}
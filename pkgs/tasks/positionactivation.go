@@ -0,0 +1,163 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/utils"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// PositionActivationTask waits until a future-dated position takes effect and
+// then lets the activator refresh everything derived from the current position.
+// It is a scheduled task, so the task pool keeps it parked until the effective
+// time instead of running it early.
+type PositionActivationTask struct {
+	taskID      string
+	employeeID  int64
+	positionID  int64
+	effectiveAt time.Time
+	progress    int64
+
+	timeModule TimeModule
+	activator  PositionActivator
+
+	retries      int
+	retryDelay   time.Duration
+	maxRetries   int
+	retryChannel chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func PositionActivationTaskID(positionID int64) string {
+	return fmt.Sprintf("position-activation-%d", positionID)
+}
+
+func NewPositionActivationTaskWithCtx(
+	ctx context.Context,
+	timeModule TimeModule,
+	activator PositionActivator,
+	employeeID int64,
+	positionID int64,
+	effectiveAt time.Time,
+	retryDelay time.Duration,
+	maxRetries int,
+) *PositionActivationTask {
+	ctx, cancel := context.WithCancel(ctx)
+	return &PositionActivationTask{
+		taskID:      PositionActivationTaskID(positionID),
+		employeeID:  employeeID,
+		positionID:  positionID,
+		effectiveAt: effectiveAt,
+		progress:    0,
+
+		timeModule: timeModule,
+		activator:  activator,
+
+		retries:      0,
+		retryDelay:   retryDelay,
+		maxRetries:   maxRetries,
+		retryChannel: make(chan struct{}, 1),
+
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (t *PositionActivationTask) GetID() string {
+	return t.taskID
+}
+
+func (t *PositionActivationTask) GetProgress() int64 {
+	return t.progress
+}
+
+func (t *PositionActivationTask) GetEmployeeID() int64 {
+	return t.employeeID
+}
+
+func (t *PositionActivationTask) GetPositionID() int64 {
+	return t.positionID
+}
+
+func (t *PositionActivationTask) GetEffectiveAt() time.Time {
+	return t.effectiveAt
+}
+
+// RunAt is the effective time on the wall clock, the time module may be ahead
+// or behind it.
+func (t *PositionActivationTask) RunAt() time.Time {
+	return time.Now().Add(t.effectiveAt.Sub(t.timeModule.Now()))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (t *PositionActivationTask) Execute() bool {
+	logger := utils.GetDetailedLogger().With().Caller().Logger()
+
+	if t.ctx.Err() != nil {
+		logger.Info().Msgf("Position activation canceled: %d", t.positionID)
+		return true
+	}
+
+	// Run too early, e.g. the clock moved back, wait for the retry signal
+	if t.timeModule.Now().Before(t.effectiveAt) {
+		return false
+	}
+
+	t.progress = 30
+	if err := t.activator.ActivatePosition(t.ctx, t.employeeID, t.positionID); err != nil {
+		t.progress = -1
+		if t.retries < t.maxRetries {
+			t.progress = -2
+		}
+		logger.Error().Err(err).Msgf("Position activation failed: %d", t.positionID)
+		return false
+	}
+	t.progress = 100
+
+	logger.Info().Msgf("Position activated: %d", t.positionID)
+	return true
+}
+
+func (t *PositionActivationTask) SetRetrySignal() <-chan struct{} {
+	logger := utils.GetDetailedLogger().With().Caller().Logger()
+
+	// Sleep until the effective time, or back off after a failed activation
+	delay := t.effectiveAt.Sub(t.timeModule.Now())
+	if delay <= 0 {
+		if t.retries >= t.maxRetries {
+			logger.Error().Msgf("Max retries reached for position activation: %d", t.positionID)
+			return nil
+		}
+		t.retries++
+		delay = t.retryDelay
+	}
+
+	go func() {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			t.retryChannel <- struct{}{}
+		case <-t.ctx.Done():
+		}
+	}()
+
+	return t.retryChannel
+}
+
+func (t *PositionActivationTask) Cancel() {
+	logger := utils.GetDetailedLogger().With().Caller().Logger()
+	logger.Info().Msgf("Canceling position activation: %d", t.positionID)
+	t.cancel()
+}
@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+func TestPositionActivationTask(t *testing.T) {
+	Convey("Given a future-dated position", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		timeModule := NewMockTimeModule(ctrl)
+		activator := NewMockPositionActivator(ctrl)
+
+		employeeID := int64(123)
+		positionID := int64(456)
+		nowTime := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+		effectiveAt := nowTime.Add(50 * time.Millisecond)
+
+		task := NewPositionActivationTaskWithCtx(
+			t.Context(),
+			timeModule,
+			activator,
+			employeeID,
+			positionID,
+			effectiveAt,
+			10*time.Millisecond,
+			1,
+		)
+
+		Convey("Then the task should be properly initialized", func() {
+			So(task.GetID(), ShouldEqual, "position-activation-456")
+			So(task.GetEmployeeID(), ShouldEqual, employeeID)
+			So(task.GetPositionID(), ShouldEqual, positionID)
+			So(task.GetEffectiveAt(), ShouldEqual, effectiveAt)
+			So(task.GetProgress(), ShouldEqual, 0)
+		})
+
+		Convey("When asked for its run time", func() {
+			timeModule.EXPECT().Now().Return(nowTime)
+
+			before := time.Now()
+			runAt := task.RunAt()
+
+			Convey("Then it should be the effective time on the wall clock", func() {
+				So(runAt, ShouldHappenOnOrBetween, before.Add(50*time.Millisecond), time.Now().Add(50*time.Millisecond))
+			})
+		})
+
+		Convey("When executed before the effective time", func() {
+			timeModule.EXPECT().Now().Return(nowTime).Times(2)
+
+			done := task.Execute()
+			sig := task.SetRetrySignal()
+
+			Convey("Then it should wait for the effective time", func() {
+				So(done, ShouldBeFalse)
+				So(sig, ShouldNotBeNil)
+
+				select {
+				case <-sig:
+				case <-time.After(time.Second):
+					t.Fatal("Timeout waiting for the retry signal")
+				}
+			})
+		})
+
+		Convey("When executed on the effective time", func() {
+			timeModule.EXPECT().Now().Return(effectiveAt)
+			activator.EXPECT().
+				ActivatePosition(gomock.Any(), employeeID, positionID).
+				Return(nil)
+
+			done := task.Execute()
+
+			Convey("Then it should activate the position", func() {
+				So(done, ShouldBeTrue)
+				So(task.GetProgress(), ShouldEqual, 100)
+			})
+		})
+
+		Convey("When the activation keeps failing", func() {
+			timeModule.EXPECT().Now().Return(effectiveAt).AnyTimes()
+			activator.EXPECT().
+				ActivatePosition(gomock.Any(), employeeID, positionID).
+				Return(errors.New("cache error")).
+				Times(2)
+
+			firstDone := task.Execute()
+			firstSig := task.SetRetrySignal()
+			secondDone := task.Execute()
+			secondSig := task.SetRetrySignal()
+
+			Convey("Then it should retry until the max retries", func() {
+				So(firstDone, ShouldBeFalse)
+				So(firstSig, ShouldNotBeNil)
+				So(secondDone, ShouldBeFalse)
+				So(secondSig, ShouldBeNil)
+				So(task.GetProgress(), ShouldEqual, -1)
+			})
+		})
+
+		Convey("When the task is canceled", func() {
+			timeModule.EXPECT().Now().Return(nowTime)
+
+			sig := task.SetRetrySignal()
+			task.Cancel()
+			done := task.Execute()
+
+			Convey("Then it should complete without activating", func() {
+				So(done, ShouldBeTrue)
+				So(task.ctx.Err(), ShouldEqual, context.Canceled)
+
+				select {
+				case <-sig:
+					t.Fatal("Retry signal fired after cancellation")
+				case <-time.After(100 * time.Millisecond):
+				}
+			})
+		})
+	})
+}
@@ -71,9 +71,26 @@ func (c *TestHttpServer) MustDo(
 	reqBody interface{},
 	respBody interface{},
 ) int {
-	// Encode request
+	return c.MustDoWithHeader(t, method, url, nil, reqBody, respBody)
+}
+
+func (c *TestHttpServer) MustDoWithHeader(
+	t *testing.T,
+	method string,
+	url string,
+	header http.Header,
+	reqBody interface{},
+	respBody interface{},
+) int {
+	// Encode request, raw bodies are sent as is
 	var buf bytes.Buffer
-	if reqBody != nil {
+	switch body := reqBody.(type) {
+	case nil:
+	case []byte:
+		buf.Write(body)
+	case string:
+		buf.WriteString(body)
+	default:
 		err := json.NewEncoder(&buf).Encode(reqBody)
 		if err != nil {
 			t.Fatal(err)
@@ -85,6 +102,11 @@ func (c *TestHttpServer) MustDo(
 	if err != nil {
 		t.Fatal(err)
 	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 
 	// Do request
 	resp, err := c.client.Do(req)
@@ -131,6 +153,19 @@ func (c *TestHttpServer) MustDoAndMatchCode(
 	convey.So(respCode, convey.ShouldEqual, code)
 }
 
+func (c *TestHttpServer) MustDoWithHeaderAndMatchCode(
+	t *testing.T,
+	method string,
+	url string,
+	header http.Header,
+	reqBody any,
+	respBody any,
+	code int,
+) {
+	respCode := c.MustDoWithHeader(t, method, url, header, reqBody, respBody)
+	convey.So(respCode, convey.ShouldEqual, code)
+}
+
 /**
 func (c *TestHttpServer) MustSucceeded(t *testing.T, method string, url string, reqBody interface{}, respBody interface{}, code int) {
 	respCode := c.MustDo(t, method, url, reqBody, respBody)
@@ -0,0 +1,77 @@
+package timesheet
+
+import (
+	"math"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/dtos"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewV1Response renders the timesheet of an employee.
+func NewV1Response(employeeID int64, period Period, sheet *Timesheet) dtos.TimesheetV1Response {
+	days := make([]dtos.TimesheetDayV1Response, 0, len(sheet.Days))
+	for _, day := range sheet.Days {
+		days = append(days, dtos.TimesheetDayV1Response{
+			Date:          day.Date.Format(DateLayout),
+			WorkedSeconds: workedSeconds(day.Worked),
+			WorkedHours:   workedHours(day.Worked),
+			Sessions:      day.Sessions,
+			Open:          day.Open,
+		})
+	}
+
+	return dtos.TimesheetV1Response{
+		EmployeeID:    employeeID,
+		Period:        string(period),
+		Start:         sheet.From.Format(DateLayout),
+		End:           lastDay(sheet.To),
+		WorkedSeconds: workedSeconds(sheet.Worked),
+		WorkedHours:   workedHours(sheet.Worked),
+		Open:          sheet.Open,
+		Days:          days,
+	}
+}
+
+// NewDepartmentV1Response renders the timesheets of the department members.
+func NewDepartmentV1Response(
+	departmentID int64,
+	period Period,
+	from, to time.Time,
+	employees []dtos.TimesheetV1Response,
+) dtos.DepartmentTimesheetV1Response {
+	resp := dtos.DepartmentTimesheetV1Response{
+		DepartmentID: departmentID,
+		Period:       string(period),
+		Start:        from.Format(DateLayout),
+		End:          lastDay(to),
+		Employees:    employees,
+	}
+
+	var worked int64
+	for _, employee := range employees {
+		worked += employee.WorkedSeconds
+		resp.Open = resp.Open || employee.Open
+	}
+	resp.WorkedSeconds = worked
+	resp.WorkedHours = workedHours(time.Duration(worked) * time.Second)
+
+	return resp
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func workedSeconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
+
+// workedHours rounds to the hundredth of an hour.
+func workedHours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100
+}
+
+// lastDay returns the inclusive last day of a window ending at to.
+func lastDay(to time.Time) string {
+	return to.AddDate(0, 0, -1).Format(DateLayout)
+}
@@ -0,0 +1,177 @@
+// Package timesheet aggregates attendance sessions into worked time per day
+// and per period. Days are the calendar days of the zone of the window, the one
+// of the employee.
+package timesheet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Period string
+
+const (
+	PeriodDay   Period = "day"
+	PeriodWeek  Period = "week"
+	PeriodMonth Period = "month"
+)
+
+const DateLayout = "2006-01-02"
+
+// ParseWindow resolves the period and its first day into a [from, to) window of
+// days in loc. The period defaults to a week and the start to the first day of
+// the period containing nowTime, weeks starting on Monday.
+func ParseWindow(period string, start string, nowTime time.Time, loc *time.Location) (Period, time.Time, time.Time, error) {
+	p := Period(period)
+	switch p {
+	case "":
+		p = PeriodWeek
+	case PeriodDay, PeriodWeek, PeriodMonth:
+	default:
+		return "", time.Time{}, time.Time{}, fmt.Errorf("invalid period %q", period)
+	}
+
+	from := PeriodStart(p, nowTime.In(loc))
+	if start != "" {
+		date, err := time.ParseInLocation(DateLayout, start, loc)
+		if err != nil {
+			return "", time.Time{}, time.Time{}, errors.New("invalid start, expected " + DateLayout)
+		}
+		from = date
+	}
+
+	return p, from, periodEnd(p, from), nil
+}
+
+// PeriodStart returns the first day of the period containing t, in the zone
+// of t.
+func PeriodStart(period Period, t time.Time) time.Time {
+	day := truncateDay(t)
+	switch period {
+	case PeriodWeek:
+		// time.Sunday is 0, shift it to the end of the week
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case PeriodMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	default:
+		return day
+	}
+}
+
+// WindowIn returns the window of the same days in loc.
+func WindowIn(from, to time.Time, loc *time.Location) (time.Time, time.Time) {
+	return dayIn(from, loc), dayIn(to, loc)
+}
+
+func dayIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+func periodEnd(period Period, from time.Time) time.Time {
+	switch period {
+	case PeriodWeek:
+		return from.AddDate(0, 0, 7)
+	case PeriodMonth:
+		return from.AddDate(0, 1, 0)
+	default:
+		return from.AddDate(0, 0, 1)
+	}
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Day struct {
+	Date   time.Time
+	Worked time.Duration
+	// Sessions is the number of sessions worked on the day
+	Sessions int
+	// Open is set when a session still open was worked on the day
+	Open bool
+}
+
+type Timesheet struct {
+	From   time.Time
+	To     time.Time
+	Days   []Day
+	Worked time.Duration
+	Open   bool
+}
+
+// Aggregate sums the sessions worked in [from, to) per day of the zone of
+// from, splitting the sessions crossing midnight. Open sessions count up to
+// nowTime and voided sessions are ignored.
+func Aggregate(attendances []*models.EmployeeAttendance, from, to, nowTime time.Time) *Timesheet {
+	sheet := &Timesheet{
+		From: from,
+		To:   to,
+	}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		sheet.Days = append(sheet.Days, Day{Date: day})
+	}
+
+	for _, attendance := range attendances {
+		if attendance.Status == models.AttendanceStatusVoided {
+			continue
+		}
+
+		end := attendance.ClockIn
+		if attendance.ClockOut != nil {
+			end = *attendance.ClockOut
+		} else if attendance.IsOpen() {
+			end = nowTime
+		}
+
+		// Clip the session to the window
+		start := attendance.ClockIn
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		for i := range sheet.Days {
+			day := &sheet.Days[i]
+			dayEnd := day.Date.AddDate(0, 0, 1)
+			if !start.Before(dayEnd) || !end.After(day.Date) {
+				continue
+			}
+
+			worked := minTime(end, dayEnd).Sub(maxTime(start, day.Date))
+			day.Worked += worked
+			day.Sessions++
+			day.Open = day.Open || attendance.IsOpen()
+			sheet.Worked += worked
+			sheet.Open = sheet.Open || attendance.IsOpen()
+		}
+	}
+
+	return sheet
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+package timesheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/models"
+	"github.com/samber/lo"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseWindow(t *testing.T) {
+	Convey("Given the current time on a Thursday", t, func() {
+		nowTime := time.Date(2025, 5, 8, 15, 0, 0, 0, time.UTC)
+
+		Convey("The period should default to the current week starting on Monday", func() {
+			period, from, to, err := ParseWindow("", "", nowTime, time.UTC)
+			So(err, ShouldBeNil)
+			So(period, ShouldEqual, PeriodWeek)
+			So(from, ShouldEqual, time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC))
+			So(to, ShouldEqual, time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC))
+		})
+
+		Convey("A Sunday should belong to the week before", func() {
+			So(PeriodStart(PeriodWeek, time.Date(2025, 5, 11, 23, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC))
+		})
+
+		Convey("A month should run to the first day of the next month", func() {
+			period, from, to, err := ParseWindow("month", "", nowTime, time.UTC)
+			So(err, ShouldBeNil)
+			So(period, ShouldEqual, PeriodMonth)
+			So(from, ShouldEqual, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC))
+			So(to, ShouldEqual, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+		})
+
+		Convey("An explicit start should be used as is", func() {
+			_, from, to, err := ParseWindow("day", "2025-04-30", nowTime, time.UTC)
+			So(err, ShouldBeNil)
+			So(from, ShouldEqual, time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC))
+			So(to, ShouldEqual, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC))
+		})
+
+		Convey("The days should be the days of the zone", func() {
+			taipei, err := time.LoadLocation("Asia/Taipei")
+			So(err, ShouldBeNil)
+
+			// Already Friday in Taipei while still Thursday in UTC
+			_, from, to, err := ParseWindow("day", "", nowTime.Add(2*time.Hour), taipei)
+			So(err, ShouldBeNil)
+			So(from, ShouldEqual, time.Date(2025, 5, 9, 0, 0, 0, 0, taipei))
+			So(to, ShouldEqual, time.Date(2025, 5, 10, 0, 0, 0, 0, taipei))
+
+			// Sunday night in UTC is already the next week in Taipei
+			So(PeriodStart(PeriodWeek, time.Date(2025, 5, 11, 23, 0, 0, 0, time.UTC).In(taipei)), ShouldEqual, time.Date(2025, 5, 12, 0, 0, 0, 0, taipei))
+		})
+
+		Convey("An unknown period or a malformed start should be rejected", func() {
+			_, _, _, err := ParseWindow("year", "", nowTime, time.UTC)
+			So(err, ShouldNotBeNil)
+			_, _, _, err = ParseWindow("week", "05/05/2025", nowTime, time.UTC)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestAggregate(t *testing.T) {
+	Convey("Given a week of sessions", t, func() {
+		from := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 7)
+		nowTime := time.Date(2025, 5, 9, 11, 0, 0, 0, time.UTC)
+
+		closed := func(clockIn, clockOut time.Time) *models.EmployeeAttendance {
+			return &models.EmployeeAttendance{ClockIn: clockIn, ClockOut: lo.ToPtr(clockOut), Status: models.AttendanceStatusClosed}
+		}
+		attendances := []*models.EmployeeAttendance{
+			// Started the Sunday before the window
+			closed(from.Add(-2*time.Hour), from.Add(1*time.Hour)),
+			closed(from.Add(9*time.Hour), from.Add(17*time.Hour)),
+			// Night shift from Tuesday 22:00 to Wednesday 06:00
+			closed(from.AddDate(0, 0, 1).Add(22*time.Hour), from.AddDate(0, 0, 2).Add(6*time.Hour)),
+			// Voided sessions are not worked time
+			{ClockIn: from.AddDate(0, 0, 3).Add(9 * time.Hour), ClockOut: lo.ToPtr(from.AddDate(0, 0, 3).Add(17 * time.Hour)), Status: models.AttendanceStatusVoided},
+			// Still open on Friday
+			{ClockIn: from.AddDate(0, 0, 4).Add(8 * time.Hour), Status: models.AttendanceStatusOpen},
+		}
+
+		sheet := Aggregate(attendances, from, to, nowTime)
+
+		Convey("Then every day of the window should be listed", func() {
+			So(sheet.Days, ShouldHaveLength, 7)
+			So(sheet.Days[0].Date, ShouldEqual, from)
+			So(sheet.Days[6].Date, ShouldEqual, from.AddDate(0, 0, 6))
+		})
+
+		Convey("Then sessions should be clipped to the window", func() {
+			So(sheet.Days[0].Worked, ShouldEqual, 9*time.Hour)
+			So(sheet.Days[0].Sessions, ShouldEqual, 2)
+		})
+
+		Convey("Then sessions crossing midnight should be split", func() {
+			So(sheet.Days[1].Worked, ShouldEqual, 2*time.Hour)
+			So(sheet.Days[2].Worked, ShouldEqual, 6*time.Hour)
+		})
+
+		Convey("Then voided sessions should be ignored", func() {
+			So(sheet.Days[3].Worked, ShouldEqual, 0)
+			So(sheet.Days[3].Sessions, ShouldEqual, 0)
+		})
+
+		Convey("Then open sessions should count up to now and flag their day", func() {
+			So(sheet.Days[4].Worked, ShouldEqual, 3*time.Hour)
+			So(sheet.Days[4].Open, ShouldBeTrue)
+			So(sheet.Days[3].Open, ShouldBeFalse)
+			So(sheet.Open, ShouldBeTrue)
+		})
+
+		Convey("Then the period total should sum the days", func() {
+			So(sheet.Worked, ShouldEqual, 20*time.Hour)
+
+			resp := NewV1Response(1, PeriodWeek, sheet)
+			So(resp.Start, ShouldEqual, "2025-05-05")
+			So(resp.End, ShouldEqual, "2025-05-11")
+			So(resp.WorkedSeconds, ShouldEqual, int64(20*3600))
+			So(resp.WorkedHours, ShouldEqual, 20)
+			So(resp.Days[1].WorkedHours, ShouldEqual, 2)
+		})
+	})
+}
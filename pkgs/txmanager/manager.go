@@ -0,0 +1,39 @@
+package txmanager
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+type manager struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *manager {
+	return &manager{
+		db: db,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Do runs fn in a database transaction. The transaction is committed when fn
+// returns nil and rolled back otherwise. The side effects registered with
+// Tx.AfterCommit only run once the commit succeeded.
+func (m *manager) Do(ctx context.Context, fn func(tx *Tx) error) error {
+	tx := &Tx{}
+	if err := m.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		tx.DB = db
+		return fn(tx)
+	}); err != nil {
+		return err
+	}
+
+	for _, afterCommit := range tx.afterCommit {
+		afterCommit()
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package txmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/WangWilly/labs-hr-go/pkgs/testutils"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDo(t *testing.T) {
+	Convey("Given a transaction manager", t, func() {
+		gormDB, mockDB := testutils.GetMockDB(t)
+		manager := New(gormDB)
+
+		Convey("When the unit of work succeeds", func() {
+			mockDB.ExpectBegin()
+			mockDB.ExpectCommit()
+
+			calls := []string{}
+			err := manager.Do(t.Context(), func(tx *Tx) error {
+				So(tx.DB, ShouldNotBeNil)
+				tx.AfterCommit(func() { calls = append(calls, "first") })
+				tx.AfterCommit(func() { calls = append(calls, "second") })
+				calls = append(calls, "work")
+				return nil
+			})
+
+			Convey("Then it should commit and run the side effects in order", func() {
+				So(err, ShouldBeNil)
+				So(calls, ShouldResemble, []string{"work", "first", "second"})
+				So(mockDB.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+
+		Convey("When the unit of work fails", func() {
+			mockDB.ExpectBegin()
+			mockDB.ExpectRollback()
+
+			workErr := errors.New("work failed")
+			called := false
+			err := manager.Do(t.Context(), func(tx *Tx) error {
+				tx.AfterCommit(func() { called = true })
+				return workErr
+			})
+
+			Convey("Then it should roll back and skip the side effects", func() {
+				So(err, ShouldEqual, workErr)
+				So(called, ShouldBeFalse)
+				So(mockDB.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+
+		Convey("When the commit fails", func() {
+			mockDB.ExpectBegin()
+			mockDB.ExpectCommit().WillReturnError(errors.New("commit failed"))
+
+			called := false
+			err := manager.Do(t.Context(), func(tx *Tx) error {
+				tx.AfterCommit(func() { called = true })
+				return nil
+			})
+
+			Convey("Then it should return the error and skip the side effects", func() {
+				So(err, ShouldNotBeNil)
+				So(called, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package txmanager
+
+import "gorm.io/gorm"
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Tx is a unit of work. Repositories run against DB, while side effects that
+// must not outlive a rollback, such as cache writes, go to AfterCommit.
+type Tx struct {
+	DB *gorm.DB
+
+	afterCommit []func()
+}
+
+// AfterCommit registers fn to run after the transaction committed. The
+// functions run in the order they were registered.
+func (t *Tx) AfterCommit(fn func()) {
+	t.afterCommit = append(t.afterCommit, fn)
+}
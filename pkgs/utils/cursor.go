@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Cursor is an opaque keyset pagination position: the sort key of the last
+// returned row plus its primary key as a tie breaker.
+type Cursor struct {
+	Value string `json:"v,omitempty"`
+	ID    int64  `json:"id"`
+}
+
+func EncodeCursor(c Cursor) string {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return &c, nil
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCursor(t *testing.T) {
+	Convey("Given a cursor", t, func() {
+		cursor := Cursor{Value: "Jane Smith", ID: 42}
+
+		Convey("When encoding and decoding it", func() {
+			encoded := EncodeCursor(cursor)
+			decoded, err := DecodeCursor(encoded)
+
+			Convey("Then it should round trip", func() {
+				So(err, ShouldBeNil)
+				So(decoded, ShouldNotBeNil)
+				So(*decoded, ShouldResemble, cursor)
+			})
+		})
+
+		Convey("When decoding an empty string", func() {
+			decoded, err := DecodeCursor("")
+
+			Convey("Then it should return no cursor", func() {
+				So(err, ShouldBeNil)
+				So(decoded, ShouldBeNil)
+			})
+		})
+
+		Convey("When decoding garbage", func() {
+			decoded, err := DecodeCursor("not a cursor!")
+
+			Convey("Then it should return an error", func() {
+				So(err, ShouldNotBeNil)
+				So(decoded, ShouldBeNil)
+			})
+		})
+	})
+}
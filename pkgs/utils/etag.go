@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// NewETag returns a strong entity tag derived from the given parts.
+func NewETag(parts ...any) string {
+	values := make([]string, len(parts))
+	for i, part := range parts {
+		values[i] = fmt.Sprint(part)
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(values, "|")))
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// MatchIfMatch reports whether the If-Match header value allows a write on a
+// resource with the given entity tag. An empty header matches everything and
+// weak tags never match, as If-Match uses the strong comparison.
+func MatchIfMatch(ifMatch string, etag string) bool {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(candidate) == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestETag(t *testing.T) {
+	Convey("Given an entity tag", t, func() {
+		etag := NewETag(int64(3), "2025-05-16 11:11:12")
+
+		Convey("When deriving it from the same parts", func() {
+			Convey("Then it should be stable and quoted", func() {
+				So(NewETag(int64(3), "2025-05-16 11:11:12"), ShouldEqual, etag)
+				So(etag, ShouldStartWith, `"`)
+				So(etag, ShouldEndWith, `"`)
+			})
+		})
+
+		Convey("When deriving it from different parts", func() {
+			Convey("Then it should differ", func() {
+				So(NewETag(int64(4), "2025-05-16 11:11:12"), ShouldNotEqual, etag)
+			})
+		})
+
+		Convey("When matching If-Match headers", func() {
+			Convey("Then only the strong tag, a list containing it or a wildcard should match", func() {
+				So(MatchIfMatch("", etag), ShouldBeTrue)
+				So(MatchIfMatch("*", etag), ShouldBeTrue)
+				So(MatchIfMatch(etag, etag), ShouldBeTrue)
+				So(MatchIfMatch(`"other", `+etag, etag), ShouldBeTrue)
+				So(MatchIfMatch(`"other"`, etag), ShouldBeFalse)
+				So(MatchIfMatch("W/"+etag, etag), ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// HttpError carries the response of a failed request out of a unit of work,
+// so the handler can answer once the transaction has been rolled back.
+type HttpError struct {
+	Code    int
+	Message string
+}
+
+func NewHttpError(code int, message string) *HttpError {
+	return &HttpError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *HttpError) Error() string {
+	return e.Message
+}
+
+// RespondError answers with the HttpError wrapped in err, or with a 500 and
+// the fallback message for any other error.
+func RespondError(ctx *gin.Context, err error, fallback string) {
+	var httpErr *HttpError
+	if errors.As(err, &httpErr) {
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+}
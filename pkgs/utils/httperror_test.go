@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRespondError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	Convey("Given a gin context", t, func() {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+
+		Convey("When responding with a wrapped HttpError", func() {
+			err := fmt.Errorf("unit of work: %w", NewHttpError(http.StatusConflict, "already clocked in"))
+			RespondError(ctx, err, "fallback")
+
+			Convey("Then its code and message should be used", func() {
+				So(w.Code, ShouldEqual, http.StatusConflict)
+
+				var response map[string]string
+				So(json.Unmarshal(w.Body.Bytes(), &response), ShouldBeNil)
+				So(response["error"], ShouldEqual, "already clocked in")
+			})
+		})
+
+		Convey("When responding with any other error", func() {
+			RespondError(ctx, errors.New("commit failed"), "fallback")
+
+			Convey("Then a 500 with the fallback message should be used", func() {
+				So(w.Code, ShouldEqual, http.StatusInternalServerError)
+
+				var response map[string]string
+				So(json.Unmarshal(w.Body.Bytes(), &response), ShouldBeNil)
+				So(response["error"], ShouldEqual, "fallback")
+			})
+		})
+	})
+}
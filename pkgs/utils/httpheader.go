@@ -4,4 +4,8 @@ package utils
 const (
 	RequestIdHeader = "X-Request-ID"
 	SessionIdHeader = "X-Session-ID"
+	ETagHeader      = "ETag"
+	IfMatchHeader   = "If-Match"
+	// TimeFormatHeader picks the format of the timestamps of a response
+	TimeFormatHeader = "X-Time-Format"
 )
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const MergePatchContentType = "application/merge-patch+json"
+
+// MergePatch applies a JSON Merge Patch (RFC 7396) to the target document.
+// A null member in the patch removes the member from the target, an object
+// member is merged recursively, and any other value replaces the target member.
+func MergePatch(target []byte, patch []byte) ([]byte, error) {
+	var targetDoc any
+	if len(target) > 0 {
+		if err := json.Unmarshal(target, &targetDoc); err != nil {
+			return nil, fmt.Errorf("failed to decode target document: %w", err)
+		}
+	}
+
+	var patchDoc any
+	if err := json.Unmarshal(patch, &patchDoc); err != nil {
+		return nil, fmt.Errorf("failed to decode merge patch: %w", err)
+	}
+
+	result, err := json.Marshal(mergePatchValue(targetDoc, patchDoc))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode patched document: %w", err)
+	}
+
+	return result, nil
+}
+
+func mergePatchValue(target any, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = map[string]any{}
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatchValue(targetObj[key], value)
+	}
+
+	return targetObj
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMergePatch(t *testing.T) {
+	Convey("Given the examples of RFC 7396", t, func() {
+		cases := []struct {
+			target string
+			patch  string
+			result string
+		}{
+			{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+			{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+			{`{"a":"b"}`, `{"a":null}`, `{}`},
+			{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+			{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+			{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+			{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+			{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+			{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+			{`{"a":"b"}`, `["c"]`, `["c"]`},
+			{`{"a":"foo"}`, `null`, `null`},
+			{`{"a":"foo"}`, `"bar"`, `"bar"`},
+			{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+			{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+			{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		}
+
+		Convey("When applying each patch", func() {
+			Convey("Then the result should match the specification", func() {
+				for _, c := range cases {
+					result, err := MergePatch([]byte(c.target), []byte(c.patch))
+					So(err, ShouldBeNil)
+					So(string(result), ShouldEqual, c.result)
+				}
+			})
+		})
+
+		Convey("When the patch is not valid JSON", func() {
+			result, err := MergePatch([]byte(`{"a":"b"}`), []byte(`{"a":`))
+
+			Convey("Then it should return an error", func() {
+				So(err, ShouldNotBeNil)
+				So(result, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -1,8 +1,73 @@
 package utils
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+const v1TimeLayout = "2006-01-02 15:04:05"
 
 func FormatedTime(t time.Time) string {
 	// UTC+0
-	return t.UTC().Format("2006-01-02 15:04:05")
+	return t.UTC().Format(v1TimeLayout)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type TimeFormat string
+
+const (
+	// TimeFormatV1 renders UTC times without an offset, as FormatedTime does
+	TimeFormatV1 TimeFormat = "v1"
+	// TimeFormatRFC3339 renders times with the offset of their zone
+	TimeFormatRFC3339 TimeFormat = "rfc3339"
+)
+
+// TimeFormatter renders the timestamps of a response in the format the client
+// asked for.
+type TimeFormatter struct {
+	format TimeFormat
+	loc    *time.Location
+}
+
+// V1TimeFormatter is the formatter of the clients predating time formats, and
+// of the responses that are cached.
+var V1TimeFormatter = TimeFormatter{format: TimeFormatV1}
+
+func NewTimeFormatter(format TimeFormat) TimeFormatter {
+	return TimeFormatter{format: format}
+}
+
+// GetTimeFormatter returns the formatter asked for by the X-Time-Format header
+// of the request. A missing or unknown format falls back to V1.
+func GetTimeFormatter(ctx *gin.Context) TimeFormatter {
+	format := TimeFormat(strings.ToLower(strings.TrimSpace(ctx.GetHeader(TimeFormatHeader))))
+	if format != TimeFormatRFC3339 {
+		return V1TimeFormatter
+	}
+	return NewTimeFormatter(format)
+}
+
+func (f TimeFormatter) Format() TimeFormat {
+	return f.format
+}
+
+// In returns a formatter rendering the times in the zone. V1 times stay UTC.
+func (f TimeFormatter) In(loc *time.Location) TimeFormatter {
+	f.loc = loc
+	return f
+}
+
+func (f TimeFormatter) Time(t time.Time) string {
+	if f.format != TimeFormatRFC3339 {
+		return FormatedTime(t)
+	}
+	if f.loc == nil {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return t.In(f.loc).Format(time.RFC3339)
 }
@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -40,3 +43,37 @@ func TestFormatedTime(t *testing.T) {
 		})
 	})
 }
+
+func TestTimeFormatter(t *testing.T) {
+	Convey("Given a time", t, func() {
+		testTime := time.Date(2023, 6, 15, 12, 30, 45, 0, time.UTC)
+		taipei, err := time.LoadLocation("Asia/Taipei")
+		So(err, ShouldBeNil)
+
+		Convey("The V1 formatter should keep the UTC layout, even in a zone", func() {
+			So(V1TimeFormatter.Time(testTime), ShouldEqual, "2023-06-15 12:30:45")
+			So(V1TimeFormatter.In(taipei).Time(testTime), ShouldEqual, "2023-06-15 12:30:45")
+		})
+
+		Convey("The RFC 3339 formatter should render the offset of the zone", func() {
+			formatter := NewTimeFormatter(TimeFormatRFC3339)
+			So(formatter.Time(testTime.In(taipei)), ShouldEqual, "2023-06-15T12:30:45Z")
+			So(formatter.In(taipei).Time(testTime), ShouldEqual, "2023-06-15T20:30:45+08:00")
+		})
+
+		Convey("The format should be negotiated by the request header", func() {
+			newContext := func(format string) *gin.Context {
+				ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+				ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+				if format != "" {
+					ctx.Request.Header.Set(TimeFormatHeader, format)
+				}
+				return ctx
+			}
+
+			So(GetTimeFormatter(newContext("")).Format(), ShouldEqual, TimeFormatV1)
+			So(GetTimeFormatter(newContext("RFC3339")).Format(), ShouldEqual, TimeFormatRFC3339)
+			So(GetTimeFormatter(newContext("iso8601")).Format(), ShouldEqual, TimeFormatV1)
+		})
+	})
+}
@@ -0,0 +1,156 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// The smallest set of parts a spreadsheet application accepts as a workbook
+// with a single worksheet. Cells are inline strings, so no shared strings part.
+const (
+	contentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	relsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookXML = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+
+	workbookRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+
+	sheetHeaderXML = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	sheetFooterXML = `</sheetData></worksheet>`
+)
+
+// MIMEType is the content type of the documents written by Writer.
+const MIMEType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Writer streams the rows of a single worksheet, so the workbook never has to
+// be held in memory. Close must be called to complete the document.
+type Writer struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, fmt.Errorf("failed to escape sheet name: %w", err)
+	}
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", relsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, name.String())},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+	}
+	for _, part := range parts {
+		pw, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(pw, part.content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+
+	// The worksheet is the last part, it stays open until Close
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worksheet: %w", err)
+	}
+	sheet := bufio.NewWriter(sw)
+	if _, err := sheet.WriteString(sheetHeaderXML); err != nil {
+		return nil, fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	return &Writer{zip: zw, sheet: sheet}, nil
+}
+
+// WriteRow appends a row. Integers and floats are written as numbers, every
+// other value as text.
+func (w *Writer) WriteRow(values ...any) error {
+	w.rows++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.rows)
+	for i, value := range values {
+		ref := ColumnName(i) + strconv.Itoa(w.rows)
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case float32:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(float64(v), 'f', -1, 32))
+		case float64:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(w.sheet, []byte(fmt.Sprint(v))); err != nil {
+				return fmt.Errorf("failed to write cell %s: %w", ref, err)
+			}
+			w.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	if _, err := w.sheet.WriteString(`</row>`); err != nil {
+		return fmt.Errorf("failed to write row %d: %w", w.rows, err)
+	}
+
+	return nil
+}
+
+// Flush writes the buffered rows to the underlying writer.
+func (w *Writer) Flush() error {
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("failed to flush worksheet: %w", err)
+	}
+	return w.zip.Flush()
+}
+
+// Close completes the worksheet and the archive. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if _, err := w.sheet.WriteString(sheetFooterXML); err != nil {
+		return fmt.Errorf("failed to write worksheet: %w", err)
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("failed to flush worksheet: %w", err)
+	}
+	if err := w.zip.Close(); err != nil {
+		return fmt.Errorf("failed to close workbook: %w", err)
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ColumnName returns the letters of the zero based column, e.g. 0 is A and 26 is AA.
+func ColumnName(column int) string {
+	name := ""
+	for column >= 0 {
+		name = string(rune('A'+column%26)) + name
+		column = column/26 - 1
+	}
+	return name
+}
@@ -0,0 +1,63 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWriter(t *testing.T) {
+	Convey("Given a workbook written row by row", t, func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, "Roster & Co")
+		So(err, ShouldBeNil)
+
+		So(w.WriteRow("employee_id", "name", "salary"), ShouldBeNil)
+		So(w.WriteRow(int64(1), "<Will>", 4000.5), ShouldBeNil)
+		So(w.Flush(), ShouldBeNil)
+		So(w.Close(), ShouldBeNil)
+
+		reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		So(err, ShouldBeNil)
+
+		parts := map[string]string{}
+		for _, file := range reader.File {
+			rc, err := file.Open()
+			So(err, ShouldBeNil)
+			content, err := io.ReadAll(rc)
+			So(err, ShouldBeNil)
+			rc.Close()
+			parts[file.Name] = string(content)
+		}
+
+		Convey("Then it should contain every part of the package", func() {
+			So(parts, ShouldContainKey, "[Content_Types].xml")
+			So(parts, ShouldContainKey, "_rels/.rels")
+			So(parts, ShouldContainKey, "xl/_rels/workbook.xml.rels")
+			So(parts["xl/workbook.xml"], ShouldContainSubstring, `<sheet name="Roster &amp; Co" sheetId="1" r:id="rId1"/>`)
+		})
+
+		Convey("Then the worksheet should hold the rows", func() {
+			sheet := parts["xl/worksheets/sheet1.xml"]
+			So(sheet, ShouldContainSubstring, `<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">employee_id</t></is></c>`)
+			So(sheet, ShouldContainSubstring, `<c r="A2"><v>1</v></c>`)
+			So(sheet, ShouldContainSubstring, `<t xml:space="preserve">&lt;Will&gt;</t>`)
+			So(sheet, ShouldContainSubstring, `<c r="C2"><v>4000.5</v></c>`)
+			So(sheet, ShouldEndWith, `</sheetData></worksheet>`)
+		})
+	})
+}
+
+func TestColumnName(t *testing.T) {
+	Convey("Given zero based column indexes", t, func() {
+		So(ColumnName(0), ShouldEqual, "A")
+		So(ColumnName(25), ShouldEqual, "Z")
+		So(ColumnName(26), ShouldEqual, "AA")
+		So(ColumnName(27), ShouldEqual, "AB")
+		So(ColumnName(701), ShouldEqual, "ZZ")
+		So(ColumnName(702), ShouldEqual, "AAA")
+	})
+}